@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/algorand/go-algorand-sdk/crypto"
 	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
 	sdk_types "github.com/algorand/go-algorand-sdk/types"
 
@@ -26,12 +27,17 @@ type State struct {
 	rewardsLevel uint64
 
 	accountTypes accountTypeCache
+
+	// closedSinceRekey are the accounts closed in the round after their last
+	// rekey in the round.
+	closedSinceRekey map[types.Address]bool
 }
 
 // New creates a new State object.
 func New(defaultFrozenCache map[uint64]bool) *State {
 	result := &State{defaultFrozen: defaultFrozenCache}
 	result.Clear()
+	result.closedSinceRekey = make(map[types.Address]bool)
 	return result
 }
 
@@ -43,6 +49,7 @@ func (accounting *State) InitRound(blockHeader *types.BlockHeader) error {
 // InitRoundParts are the specific parts from a block needed to initialize accounting. Used for testing, normally you would pass in the block.
 func (accounting *State) InitRoundParts(round uint64, feeSink, rewardsPool sdk_types.Address, rewardsLevel uint64) error {
 	accounting.RoundUpdates.Clear()
+	accounting.closedSinceRekey = make(map[types.Address]bool)
 	accounting.feeAddr = feeSink
 	accounting.rewardAddr = rewardsPool
 	accounting.rewardsLevel = rewardsLevel
@@ -111,6 +118,32 @@ func (accounting *State) updateAccountType(addr types.Address, ktype string) {
 	accounting.AccountTypes[addr] = ktype
 }
 
+// MakeAccountSigner returns the multisig composition or the logic sig program
+// of a transaction signed with ktype, ok is false for other signature types.
+func MakeAccountSigner(stxn types.SignedTxnWithAD, ktype string) (signer idb.AccountSigner, ok bool) {
+	switch ktype {
+	case "msig":
+		// A delegated logic sig is signed by the multisig.
+		msig := stxn.Msig
+		if msig.Blank() {
+			msig = stxn.Lsig.Msig
+		}
+		signer.MultisigVersion = msig.Version
+		signer.MultisigThreshold = msig.Threshold
+		signer.MultisigKeys = make([][]byte, len(msig.Subsigs))
+		for i, subsig := range msig.Subsigs {
+			signer.MultisigKeys[i] = append([]byte(nil), subsig.Key[:]...)
+		}
+	case "lsig":
+		signer.Program = stxn.Lsig.Logic
+		hash := crypto.AddressFromProgram(stxn.Lsig.Logic)
+		signer.ProgramHash = hash[:]
+	default:
+		return signer, false
+	}
+	return signer, true
+}
+
 func (accounting *State) updateAccountData(addr types.Address, key string, field interface{}) {
 	au, ok := accounting.AccountDataUpdates[addr]
 	if !ok {
@@ -214,6 +247,18 @@ func (accounting *State) freezeAsset(addr types.Address, assetID uint64, frozen
 	accounting.addAssetAccounting(addr, update, false)
 }
 
+func (accounting *State) countAssetTransfer(assetID uint64, amount uint64) {
+	tally, ok := accounting.AssetTransfers[assetID]
+	if !ok {
+		tally = new(idb.AssetTransferTally)
+		accounting.AssetTransfers[assetID] = tally
+	}
+	var xa big.Int
+	xa.SetUint64(amount)
+	tally.Count++
+	tally.Volume.Add(&tally.Volume, &xa)
+}
+
 func (accounting *State) destroyAsset(assetID uint64) {
 	accounting.AssetDestroys = append(accounting.AssetDestroys, assetID)
 }
@@ -273,6 +318,11 @@ func (accounting *State) AddTransaction(txnr *idb.TxnRow) (err error) {
 	if isNew {
 		accounting.updateAccountType(stxn.Txn.Sender, ktype)
 	}
+	// The signer may change while the signature type doesn't, the database
+	// skips the writes which don't change it.
+	if signer, ok := MakeAccountSigner(stxn, ktype); ok {
+		accounting.AccountSigners[stxn.Txn.Sender] = signer
+	}
 
 	accounting.updateAlgo(stxn.Txn.Sender, -int64(stxn.Txn.Fee))
 	accounting.updateAlgo(accounting.feeAddr, int64(stxn.Txn.Fee))
@@ -282,11 +332,20 @@ func (accounting *State) AddTransaction(txnr *idb.TxnRow) (err error) {
 	}
 
 	if !stxn.Txn.RekeyTo.IsZero() {
+		rekey := idb.RekeyUpdate{
+			Address:    stxn.Txn.Sender,
+			Intra:      intra,
+			Txid:       crypto.TransactionIDString(stxn.Txn),
+			AfterClose: accounting.closedSinceRekey[stxn.Txn.Sender],
+		}
+		delete(accounting.closedSinceRekey, stxn.Txn.Sender)
 		if stxn.Txn.RekeyTo == stxn.Txn.Sender {
 			accounting.removeAccountData(stxn.Txn.Sender, "spend")
 		} else {
 			accounting.updateAccountData(stxn.Txn.Sender, "spend", stxn.Txn.RekeyTo)
+			rekey.AuthAddr = stxn.Txn.RekeyTo
 		}
+		accounting.Rekeys = append(accounting.Rekeys, rekey)
 	}
 
 	switch stxn.Txn.Type {
@@ -310,6 +369,7 @@ func (accounting *State) AddTransaction(txnr *idb.TxnRow) (err error) {
 		// The sender account is being closed.
 		if AccountCloseTxn(stxn.Txn.Sender, stxn) {
 			accounting.closeAccount(stxn.Txn.Sender)
+			accounting.closedSinceRekey[stxn.Txn.Sender] = true
 		}
 	case sdk_types.KeyRegistrationTx:
 		// see https://github.com/algorand/go-algorand/blob/master/data/transactions/keyreg.go
@@ -365,6 +425,7 @@ func (accounting *State) AddTransaction(txnr *idb.TxnRow) (err error) {
 		if stxn.Txn.AssetAmount != 0 {
 			accounting.updateAsset(sender, assetID, 0, stxn.Txn.AssetAmount, defaultFrozen)
 			accounting.updateAsset(stxn.Txn.AssetReceiver, assetID, stxn.Txn.AssetAmount, 0, defaultFrozen)
+			accounting.countAssetTransfer(assetID, stxn.Txn.AssetAmount)
 		}
 		if AssetOptInTxn(stxn) {
 			// mark receivable accounts with the send-self-zero txn
@@ -3,8 +3,11 @@ package accounting
 import (
 	"testing"
 
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
 	sdk_types "github.com/algorand/go-algorand-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/indexer/idb"
 	"github.com/algorand/indexer/util/test"
@@ -178,3 +181,159 @@ func TestCreateAssetWithTotalZero(t *testing.T) {
 	assert.True(t, state.RoundUpdates.AssetUpdates[0][test.AccountA][0].Config.IsNew)
 	assert.Equal(t, state.RoundUpdates.AssetUpdates[1][test.AccountA][0].Transfer.Delta.Int64(), int64(0))
 }
+
+// TestAssetTransferTally checks that asset transfers are counted even though their deltas are merged.
+func TestAssetTransferTally(t *testing.T) {
+	assetid := uint64(2222)
+
+	///////////
+	// Given // Two transfers between the same accounts and an opt in.
+	///////////
+	state := GetAccounting()
+	_, pay1 := test.MakeAssetTxnOrPanic(test.Round, assetid, 100, test.AccountA, test.AccountB, sdk_types.ZeroAddress)
+	_, pay2 := test.MakeAssetTxnOrPanic(test.Round, assetid, 50, test.AccountA, test.AccountB, sdk_types.ZeroAddress)
+	_, optin := test.MakeAssetTxnOrPanic(test.Round, assetid, 0, test.AccountC, test.AccountC, sdk_types.ZeroAddress)
+
+	//////////
+	// When // The transactions are added.
+	//////////
+	for _, txn := range []*idb.TxnRow{pay1, pay2, optin} {
+		state.AddTransaction(txn)
+	}
+
+	//////////
+	// Then // Only the transfers with an amount are counted.
+	//////////
+	assert.Len(t, state.RoundUpdates.AssetUpdates[0][test.AccountB], 1)
+	assert.Len(t, state.RoundUpdates.AssetTransfers, 1)
+	tally := state.RoundUpdates.AssetTransfers[assetid]
+	assert.Equal(t, uint64(2), tally.Count)
+	assert.Equal(t, int64(150), tally.Volume.Int64())
+}
+
+func TestAccountSigners(t *testing.T) {
+	///////////
+	// Given // A multisig payment from A, a logic sig payment from B, and a single sig payment from C.
+	///////////
+	key := func(b byte) []byte {
+		k := make([]byte, 32)
+		k[0] = b
+		return k
+	}
+	state := GetAccounting()
+	msigTxn, msigRow := test.MakePayTxnRowOrPanic(test.Round, 0, 0, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	msigTxn.Msig = sdk_types.MultisigSig{
+		Version:   1,
+		Threshold: 2,
+		Subsigs:   []sdk_types.MultisigSubsig{{Key: key(1)}, {Key: key(2)}, {Key: key(3)}},
+	}
+	msigRow.TxnBytes = msgpack.Encode(msigTxn)
+	lsigTxn, lsigRow := test.MakePayTxnRowOrPanic(test.Round, 0, 0, 0, 0, 0, 0, test.AccountB, test.AccountA, sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	lsigTxn.Lsig.Logic = []byte{1, 32, 1, 1, 34}
+	lsigRow.TxnBytes = msgpack.Encode(lsigTxn)
+	sigTxn, sigRow := test.MakePayTxnRowOrPanic(test.Round, 0, 0, 0, 0, 0, 0, test.AccountC, test.AccountA, sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	sigTxn.Sig[0] = 1
+	sigRow.TxnBytes = msgpack.Encode(sigTxn)
+
+	//////////
+	// When // The transactions are added.
+	//////////
+	for _, txn := range []*idb.TxnRow{msigRow, lsigRow, sigRow} {
+		assert.NoError(t, state.AddTransaction(txn))
+	}
+
+	//////////
+	// Then // The multisig composition and the program are recorded.
+	//////////
+	assert.Len(t, state.AccountSigners, 2)
+	msig := state.AccountSigners[test.AccountA]
+	assert.Equal(t, uint8(1), msig.MultisigVersion)
+	assert.Equal(t, uint8(2), msig.MultisigThreshold)
+	assert.Equal(t, [][]byte{key(1), key(2), key(3)}, msig.MultisigKeys)
+	assert.Nil(t, msig.Program)
+	lsig := state.AccountSigners[test.AccountB]
+	assert.Equal(t, lsigTxn.Lsig.Logic, lsig.Program)
+	hash := crypto.AddressFromProgram(lsigTxn.Lsig.Logic)
+	assert.Equal(t, hash[:], lsig.ProgramHash)
+	assert.Nil(t, lsig.MultisigKeys)
+}
+
+func TestAccountSignerChanges(t *testing.T) {
+	///////////
+	// Given // Two logic sig payments from B with different programs.
+	///////////
+	state := GetAccounting()
+	firstTxn, firstRow := test.MakePayTxnRowOrPanic(test.Round, 0, 0, 0, 0, 0, 0, test.AccountB, test.AccountA, sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	firstTxn.Lsig.Logic = []byte{1, 32, 1, 1, 34}
+	firstRow.TxnBytes = msgpack.Encode(firstTxn)
+	secondTxn, secondRow := test.MakePayTxnRowOrPanic(test.Round, 0, 0, 0, 0, 0, 0, test.AccountB, test.AccountA, sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	secondTxn.Lsig.Logic = []byte{1, 32, 1, 0, 34}
+	secondRow.TxnBytes = msgpack.Encode(secondTxn)
+
+	//////////
+	// When // The transactions are added.
+	//////////
+	for _, txn := range []*idb.TxnRow{firstRow, secondRow} {
+		assert.NoError(t, state.AddTransaction(txn))
+	}
+
+	//////////
+	// Then // The signer is the latest program, although the account type was already known.
+	//////////
+	require.Len(t, state.AccountSigners, 1)
+	lsig := state.AccountSigners[test.AccountB]
+	assert.Equal(t, secondTxn.Lsig.Logic, lsig.Program)
+	hash := crypto.AddressFromProgram(secondTxn.Lsig.Logic)
+	assert.Equal(t, hash[:], lsig.ProgramHash)
+}
+
+func TestAccountRekeys(t *testing.T) {
+	///////////
+	// Given // A rekey of A to B, and a rekey of A back to itself.
+	///////////
+	state := GetAccounting()
+	toBTxn, toB := test.MakePayTxnRowOrPanic(test.Round, 0, 0, 0, 0, 0, 0, test.AccountA, test.AccountA, sdk_types.ZeroAddress, test.AccountB)
+	toATxn, toA := test.MakePayTxnRowOrPanic(test.Round, 0, 0, 0, 0, 0, 0, test.AccountA, test.AccountA, sdk_types.ZeroAddress, test.AccountA)
+	toA.Intra = 1
+
+	//////////
+	// When // The transactions are added.
+	//////////
+	for _, txn := range []*idb.TxnRow{toB, toA} {
+		assert.NoError(t, state.AddTransaction(txn))
+	}
+
+	//////////
+	// Then // Both rekeys are recorded, the rekey back has no authorized address.
+	//////////
+	assert.Equal(t, []idb.RekeyUpdate{
+		{Address: test.AccountA, Intra: 0, Txid: crypto.TransactionIDString(toBTxn.Txn), AuthAddr: test.AccountB},
+		{Address: test.AccountA, Intra: 1, Txid: crypto.TransactionIDString(toATxn.Txn)},
+	}, state.Rekeys)
+}
+
+func TestAccountRekeysAfterClose(t *testing.T) {
+	///////////
+	// Given // A rekey of A to B, a close of A, and a rekey of A to C in the same round.
+	///////////
+	state := GetAccounting()
+	_, toB := test.MakePayTxnRowOrPanic(test.Round, 0, 0, 0, 0, 0, 0, test.AccountA, test.AccountA, sdk_types.ZeroAddress, test.AccountB)
+	_, closeA := test.MakePayTxnRowOrPanic(test.Round, 0, 0, 0, 0, 0, 0, test.AccountA, test.AccountD, test.AccountD, sdk_types.ZeroAddress)
+	closeA.Intra = 1
+	_, toC := test.MakePayTxnRowOrPanic(test.Round, 0, 0, 0, 0, 0, 0, test.AccountA, test.AccountA, sdk_types.ZeroAddress, test.AccountC)
+	toC.Intra = 2
+
+	//////////
+	// When // The transactions are added.
+	//////////
+	for _, txn := range []*idb.TxnRow{toB, closeA, toC} {
+		assert.NoError(t, state.AddTransaction(txn))
+	}
+
+	//////////
+	// Then // Only the rekey following the close is marked, A signed for itself before it.
+	//////////
+	require.Len(t, state.Rekeys, 2)
+	assert.False(t, state.Rekeys[0].AfterClose)
+	assert.True(t, state.Rekeys[1].AfterClose)
+}
@@ -77,6 +77,9 @@ func AccountAtRound(account models.Account, round uint64, db idb.IndexerDb) (acc
 	}
 
 	acct = account
+	// The holdings and applications of the account at the round are not known, so neither is its minimum balance.
+	acct.MinBalance = nil
+	acct.AvailableBalance = nil
 	var addr types.Address
 	addr, err = sdk_types.DecodeAddress(account.Address)
 	if err != nil {
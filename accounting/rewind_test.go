@@ -17,12 +17,15 @@ import (
 func TestBasic(t *testing.T) {
 	var a sdk_types.Address
 	a[0] = 'a'
+	minBalance, available := uint64(10), uint64(90)
 
 	account := models.Account{
 		Address:                     a.String(),
 		Amount:                      100,
 		AmountWithoutPendingRewards: 100,
 		Round:                       8,
+		MinBalance:                  &minBalance,
+		AvailableBalance:            &available,
 	}
 
 	txnBytes := msgpack.Encode(sdk_types.SignedTxnWithAD{
@@ -54,6 +57,8 @@ func TestBasic(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, uint64(98), account.Amount)
+	assert.Nil(t, account.MinBalance)
+	assert.Nil(t, account.AvailableBalance)
 }
 
 // Test that when idb.Transactions() returns stale data the first time, we return an error.
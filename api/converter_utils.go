@@ -114,9 +114,31 @@ var sigTypeEnumMap = map[string]int{
 // SigTypeEnumString is used in error messages to list valid sig type values.
 var SigTypeEnumString string
 
+var formatEnumMap = map[string]bool{
+	string(formatJSON):    true,
+	string(formatMsgpack): true,
+	string(formatNDJSON):  true,
+}
+
+// FormatEnumString is used in error messages to list valid response format values.
+var FormatEnumString string
+
+// statusEnumMap maps the account status filter to the participation status
+// of the account data.
+var statusEnumMap = map[string]int{
+	"offline":           0,
+	"online":            1,
+	"not-participating": 2,
+}
+
+// StatusEnumString is used in error messages to list valid account status values.
+var StatusEnumString string
+
 func init() {
 	SigTypeEnumString = util.KeysStringInt(sigTypeEnumMap)
 	AddressRoleEnumString = util.KeysStringBool(addressRoleEnumMap)
+	FormatEnumString = util.KeysStringBool(formatEnumMap)
+	StatusEnumString = util.KeysStringInt(statusEnumMap)
 }
 
 func decodeBase64Byte(str *string, field string, errorArr []string) ([]byte, []string) {
@@ -143,6 +165,117 @@ func decodeSigType(str *string, errorArr []string) (string, []string) {
 	return "", errorArr
 }
 
+// decodeStatus validates the account status and converts it to the
+// participation status if present, or appends an error to errorArr
+func decodeStatus(str *string, errorArr []string) (*int, []string) {
+	if str != nil {
+		statusLc := strings.ToLower(*str)
+		if status, ok := statusEnumMap[statusLc]; ok {
+			return &status, errorArr
+		}
+		return nil, append(errorArr, fmt.Sprintf("%s: '%s'", errUnknownStatus, statusLc))
+	}
+	// Pass through
+	return nil, errorArr
+}
+
+// decodeFormat validates the response format and dereferences it if present, or appends an error to errorArr
+func decodeFormat(str *string, errorArr []string) (responseFormat, []string) {
+	if str != nil {
+		formatLc := strings.ToLower(*str)
+		if _, ok := formatEnumMap[formatLc]; ok {
+			return responseFormat(formatLc), errorArr
+		}
+		return "", append(errorArr, fmt.Sprintf("%s: '%s'", errUnknownFormat, formatLc))
+	}
+	// Default to json
+	return formatJSON, errorArr
+}
+
+// decodeOrder validates the input string and returns true if it requests the
+// amount order of a search, or appends an error to errorArr
+func decodeOrder(str *string, amountOrder string, errorArr []string) (bool, []string) {
+	if str == nil {
+		// Default to address order
+		return false, errorArr
+	}
+	if strings.ToLower(*str) != amountOrder {
+		return false, append(errorArr, fmt.Sprintf("%s: '%s' [valid orders: %s]", errUnknownOrder, *str, amountOrder))
+	}
+	return true, errorArr
+}
+
+// encodeAmountCursor returns the next token of results ordered by amount.
+func encodeAmountCursor(amount uint64, address string) string {
+	return fmt.Sprintf("%d:%s", amount, address)
+}
+
+// decodeAmountCursor parses the next token of results ordered by amount.
+func decodeAmountCursor(next string) (*idb.AmountCursor, error) {
+	parts := strings.SplitN(next, ":", 2)
+	if len(parts) != 2 {
+		return nil, errors.New(errUnableToParseNext)
+	}
+	amount, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk_types.DecodeAddress(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	return &idb.AmountCursor{Amount: amount, Address: addr[:]}, nil
+}
+
+// encodeRekeyCursor returns the next token of rekeys, the position of the
+// last rekey.
+func encodeRekeyCursor(rekey idb.AccountRekey) string {
+	return fmt.Sprintf("%d:%d", rekey.Round, rekey.Intra)
+}
+
+// decodeRekeyCursor parses the next token of rekeys.
+func decodeRekeyCursor(next string) (round uint64, intra uint64, err error) {
+	parts := strings.SplitN(next, ":", 2)
+	if len(parts) != 2 {
+		return 0, 0, errors.New(errUnableToParseNext)
+	}
+	round, err = strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	intra, err = strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return round, intra, nil
+}
+
+// encodeAssetCursor returns the next token after an asset, assets searched
+// with a query are ordered by score.
+func encodeAssetCursor(asset generated.Asset) string {
+	if asset.Score != nil {
+		return fmt.Sprintf("%s:%d", strconv.FormatFloat(*asset.Score, 'f', -1, 64), asset.Index)
+	}
+	return strconv.FormatUint(asset.Index, 10)
+}
+
+// decodeScoreCursor parses the next token of assets searched with a query.
+func decodeScoreCursor(next string) (score float64, assetID uint64, err error) {
+	parts := strings.SplitN(next, ":", 2)
+	if len(parts) != 2 {
+		return 0, 0, errors.New(errUnableToParseNext)
+	}
+	score, err = strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	assetID, err = strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return score, assetID, nil
+}
+
 // decodeType validates the input string and dereferences it if present, or appends an error to errorArr
 func decodeType(str *string, errorArr []string) (t int, err []string) {
 	if str != nil {
@@ -478,14 +611,167 @@ func txnRowToTransaction(row idb.TxnRow) (generated.Transaction, error) {
 	return txn, nil
 }
 
+// assetRowToAsset converts an asset row into a generated.Asset object.
+func assetRowToAsset(row idb.AssetRow) (generated.Asset, error) {
+	if row.Error != nil {
+		return generated.Asset{}, row.Error
+	}
+
+	creator := sdk_types.Address{}
+	if len(row.Creator) != len(creator) {
+		return generated.Asset{}, fmt.Errorf(errInvalidCreatorAddress)
+	}
+	copy(creator[:], row.Creator[:])
+
+	mdhash := make([]byte, 32)
+	copy(mdhash, row.Params.MetadataHash[:])
+
+	asset := generated.Asset{
+		Index:            row.AssetID,
+		CreatedAtRound:   row.CreatedRound,
+		DestroyedAtRound: row.ClosedRound,
+		Deleted:          row.Deleted,
+		Params: generated.AssetParams{
+			Creator:       creator.String(),
+			Name:          strPtr(row.Params.AssetName),
+			UnitName:      strPtr(row.Params.UnitName),
+			Url:           strPtr(row.Params.URL),
+			Total:         row.Params.Total,
+			Decimals:      uint64(row.Params.Decimals),
+			DefaultFrozen: boolPtr(row.Params.DefaultFrozen),
+			MetadataHash:  bytePtr(mdhash),
+			Clawback:      strPtr(row.Params.Clawback.String()),
+			Reserve:       strPtr(row.Params.Reserve.String()),
+			Freeze:        strPtr(row.Params.Freeze.String()),
+			Manager:       strPtr(row.Params.Manager.String()),
+		},
+		Score: row.Score,
+	}
+
+	return asset, nil
+}
+
+// assetBalanceRowToHolding converts an asset balance row into a generated.MiniAssetHolding object.
+func assetBalanceRowToHolding(row idb.AssetBalanceRow) (generated.MiniAssetHolding, error) {
+	if row.Error != nil {
+		return generated.MiniAssetHolding{}, row.Error
+	}
+
+	addr := sdk_types.Address{}
+	if len(row.Address) != len(addr) {
+		return generated.MiniAssetHolding{}, fmt.Errorf(errInvalidCreatorAddress)
+	}
+	copy(addr[:], row.Address[:])
+
+	bal := generated.MiniAssetHolding{
+		Address:         addr.String(),
+		Amount:          row.Amount,
+		IsFrozen:        row.Frozen,
+		OptedInAtRound:  row.CreatedRound,
+		OptedOutAtRound: row.ClosedRound,
+		Deleted:         row.Deleted,
+	}
+
+	return bal, nil
+}
+
+// accountChangeRowToAccountChange converts an account change row into a generated.AccountChange object.
+// accountRekeyToAccountRekey converts a rekey of an account, the authorized
+// addresses which are not set are the account itself.
+func accountRekeyToAccountRekey(accountID string, rekey idb.AccountRekey) generated.AccountRekey {
+	authAddr := func(addr []byte) string {
+		if len(addr) == 0 {
+			return accountID
+		}
+		var a sdk_types.Address
+		copy(a[:], addr)
+		return a.String()
+	}
+	return generated.AccountRekey{
+		Round:       rekey.Round,
+		Txid:        rekey.Txid,
+		OldAuthAddr: authAddr(rekey.OldAuthAddr),
+		NewAuthAddr: authAddr(rekey.NewAuthAddr),
+	}
+}
+
+func accountChangeRowToAccountChange(row idb.AccountChangeRow) (generated.AccountChange, error) {
+	if row.Error != nil {
+		return generated.AccountChange{}, row.Error
+	}
+
+	change := generated.AccountChange{
+		Round:           row.Round,
+		BalanceIncrease: uint64PtrOrNil(row.Change.BalanceIncrease),
+		BalanceDecrease: uint64PtrOrNil(row.Change.BalanceDecrease),
+		Rewards:         uint64PtrOrNil(row.Change.Rewards),
+		Closed:          boolPtrOrNil(row.Change.Closed),
+		SigType:         strPtr(row.Change.SigType),
+		Rekeyed:         boolPtrOrNil(row.Change.Rekeyed),
+		AuthAddr:        addrPtr(row.Change.AuthAddr),
+		Status:          strPtr(row.Change.Status),
+	}
+
+	if len(row.Change.Assets) > 0 {
+		assets := make([]generated.AccountAssetChange, 0, len(row.Change.Assets))
+		for _, asset := range row.Change.Assets {
+			assets = append(assets, generated.AccountAssetChange{
+				AssetId:        asset.AssetID,
+				AmountIncrease: uint64PtrOrNil(asset.AmountIncrease),
+				AmountDecrease: uint64PtrOrNil(asset.AmountDecrease),
+				OptedIn:        boolPtrOrNil(asset.OptedIn),
+				Closed:         boolPtrOrNil(asset.Closed),
+				CloseTo:        addrPtr(asset.CloseTo),
+			})
+			if asset.Freeze {
+				frozen := asset.Frozen
+				assets[len(assets)-1].IsFrozen = &frozen
+			}
+		}
+		change.Assets = &assets
+	}
+
+	if len(row.Change.Apps) > 0 {
+		apps := make([]generated.AccountAppChange, 0, len(row.Change.Apps))
+		for _, app := range row.Change.Apps {
+			apps = append(apps, generated.AccountAppChange{
+				AppId:        app.AppID,
+				OptedIn:      boolPtrOrNil(app.OptedIn),
+				ClosedOut:    boolPtrOrNil(app.ClosedOut),
+				StateChanged: boolPtrOrNil(app.StateChanged),
+			})
+		}
+		change.Apps = &apps
+	}
+
+	return change, nil
+}
+
 func assetParamsToAssetQuery(params generated.SearchForAssetsParams) (idb.AssetsQuery, error) {
 	creator, errorArr := decodeAddress(params.Creator, "creator", make([]string, 0))
 	if len(errorArr) != 0 {
 		return idb.AssetsQuery{}, errors.New(errUnableToParseAddress)
 	}
+	manager, errorArr := decodeAddress(params.Manager, "manager", errorArr)
+	reserve, errorArr := decodeAddress(params.Reserve, "reserve", errorArr)
+	freeze, errorArr := decodeAddress(params.Freeze, "freeze", errorArr)
+	clawback, errorArr := decodeAddress(params.Clawback, "clawback", errorArr)
+	metadataHash, errorArr := decodeBase64Byte(params.MetadataHash, "metadata-hash", errorArr)
+	if len(errorArr) != 0 {
+		return idb.AssetsQuery{}, errors.New(errorArr[0])
+	}
 
+	search := strOrDefault(params.Query)
 	var assetGreaterThan uint64 = 0
-	if params.Next != nil {
+	var prevScore *float64
+	if params.Next != nil && search != "" {
+		score, agt, err := decodeScoreCursor(*params.Next)
+		if err != nil {
+			return idb.AssetsQuery{}, fmt.Errorf("%s: %v", errUnableToParseNext, err)
+		}
+		assetGreaterThan = agt
+		prevScore = &score
+	} else if params.Next != nil {
 		agt, err := strconv.ParseUint(*params.Next, 10, 64)
 		if err != nil {
 			return idb.AssetsQuery{}, fmt.Errorf("%s: %v", errUnableToParseNext, err)
@@ -499,7 +785,16 @@ func assetParamsToAssetQuery(params generated.SearchForAssetsParams) (idb.Assets
 		Creator:            creator,
 		Name:               strOrDefault(params.Name),
 		Unit:               strOrDefault(params.Unit),
-		Query:              "",
+		Query:              search,
+		PrevScore:          prevScore,
+		Manager:            manager,
+		Reserve:            reserve,
+		Freeze:             freeze,
+		Clawback:           clawback,
+		URLPrefix:          strOrDefault(params.UrlPrefix),
+		MetadataHash:       metadataHash,
+		Decimals:           params.Decimals,
+		DefaultFrozen:      params.DefaultFrozen,
 		IncludeDeleted:     boolOrDefault(params.IncludeAll),
 		Limit:              min(uintOrDefaultValue(params.Limit, defaultAssetsLimit), maxAssetsLimit),
 	}
@@ -573,3 +868,29 @@ func transactionParamsToTransactionFilter(params generated.SearchForTransactions
 
 	return
 }
+
+func transactionStatsRowToTransactionStats(row idb.TransactionStatsRow) generated.TransactionStats {
+	return generated.TransactionStats{
+		Start:    row.Day.UTC(),
+		MinRound: row.MinRound,
+		MaxRound: row.MaxRound,
+		Counts: generated.TransactionTypeCounts{
+			Pay:    row.Counts[idb.TypeEnumPay],
+			Keyreg: row.Counts[idb.TypeEnumKeyreg],
+			Acfg:   row.Counts[idb.TypeEnumAssetConfig],
+			Axfer:  row.Counts[idb.TypeEnumAssetTransfer],
+			Afrz:   row.Counts[idb.TypeEnumAssetFreeze],
+			Appl:   row.Counts[idb.TypeEnumApplication],
+		},
+		Fees:            row.Fees,
+		ActiveAddresses: row.ActiveAddresses,
+	}
+}
+
+func participationTotalsToGenerated(totals idb.ParticipationTotals) generated.ParticipationTotals {
+	return generated.ParticipationTotals{
+		Money:       totals.Money,
+		RewardUnits: totals.RewardUnits,
+		Accounts:    totals.Accounts,
+	}
+}
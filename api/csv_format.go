@@ -0,0 +1,154 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/indexer/api/generated/v2"
+)
+
+const mimeTextCSV = "text/csv"
+
+// csvColumn is a single scalar field of a (possibly nested) response object.
+type csvColumn struct {
+	// name is the dotted path of json field names, i.e. "payment-transaction.amount".
+	name string
+
+	// index is the sequence of struct field indexes leading to the value.
+	index []int
+}
+
+// transactionCSVColumns is the column layout of exported transactions.
+var transactionCSVColumns = makeCSVColumns(reflect.TypeOf(generated.Transaction{}))
+
+// assetHoldingCSVColumns is the column layout of exported asset balances.
+var assetHoldingCSVColumns = makeCSVColumns(reflect.TypeOf(generated.MiniAssetHolding{}))
+
+// makeCSVColumns derives the CSV columns of a struct from its json tags. Nested
+// structs are flattened, any other non-scalar value is written as JSON.
+func makeCSVColumns(t reflect.Type) []csvColumn {
+	return appendCSVColumns(nil, t, "", nil)
+}
+
+func appendCSVColumns(columns []csvColumn, t reflect.Type, prefix string, index []int) []csvColumn {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fieldIndex := make([]int, len(index), len(index)+1)
+		copy(fieldIndex, index)
+		fieldIndex = append(fieldIndex, i)
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct {
+			columns = appendCSVColumns(columns, fieldType, prefix+name+".", fieldIndex)
+			continue
+		}
+
+		columns = append(columns, csvColumn{
+			name:  prefix + name,
+			index: fieldIndex,
+		})
+	}
+	return columns
+}
+
+// csvValue formats the column of a struct value, empty values are written as
+// an empty string.
+func csvValue(v reflect.Value, column csvColumn) (string, error) {
+	for _, i := range column.index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return "", nil
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			// Match the base64 encoding used by the JSON responses.
+			return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+		}
+		if v.Len() == 0 {
+			return "", nil
+		}
+	}
+
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// csvFormat writes a header row followed by one row per result.
+type csvFormat struct {
+	columns []csvColumn
+	w       *csv.Writer
+}
+
+func (f *csvFormat) contentType() string {
+	return mimeTextCSV
+}
+
+func (f *csvFormat) start(w io.Writer) error {
+	f.w = csv.NewWriter(w)
+	header := make([]string, len(f.columns))
+	for i, column := range f.columns {
+		header[i] = column.name
+	}
+	return f.w.Write(header)
+}
+
+func (f *csvFormat) write(obj interface{}) error {
+	v := reflect.ValueOf(obj)
+	record := make([]string, len(f.columns))
+	for i, column := range f.columns {
+		value, err := csvValue(v, column)
+		if err != nil {
+			return err
+		}
+		record[i] = value
+	}
+	return f.w.Write(record)
+}
+
+// writeError uses a trailer, an error row would be mistaken for data.
+func (f *csvFormat) writeError(header http.Header, err string) error {
+	header.Set(headerError, err)
+	return nil
+}
+
+func (f *csvFormat) flush() error {
+	f.w.Flush()
+	return f.w.Error()
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/idb/mocks"
+)
+
+func columnNames(columns []csvColumn) []string {
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		names = append(names, column.name)
+	}
+	return names
+}
+
+func TestTransactionCSVColumns(t *testing.T) {
+	names := columnNames(transactionCSVColumns)
+	assert.Contains(t, names, "id")
+	assert.Contains(t, names, "payment-transaction.receiver")
+	assert.Contains(t, names, "asset-config-transaction.params.total")
+	assert.Contains(t, names, "signature.multisig.threshold")
+	assert.Contains(t, names, "global-state-delta")
+	assert.NotContains(t, names, "payment-transaction")
+	assert.NotContains(t, names, "signature")
+}
+
+func TestCSVValue(t *testing.T) {
+	holding := generated.MiniAssetHolding{
+		Address:        "addr",
+		Amount:         12,
+		IsFrozen:       true,
+		OptedInAtRound: uint64Ptr(3),
+	}
+
+	values := make(map[string]string)
+	for _, column := range assetHoldingCSVColumns {
+		value, err := csvValue(reflect.ValueOf(holding), column)
+		require.NoError(t, err)
+		values[column.name] = value
+	}
+
+	assert.Equal(t, "addr", values["address"])
+	assert.Equal(t, "12", values["amount"])
+	assert.Equal(t, "true", values["is-frozen"])
+	assert.Equal(t, "3", values["opted-in-at-round"])
+	assert.Equal(t, "", values["opted-out-at-round"])
+}
+
+func TestExportTransactionsCSVDisabled(t *testing.T) {
+	si := ServerImplementation{db: &mocks.IndexerDb{}}
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	require.NoError(t, si.ExportTransactionsCSV(ctx, generated.ExportTransactionsCSVParams{}))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestExportTransactionsCSV(t *testing.T) {
+	txnBytes := loadResourceFileOrPanic("test_resources/payment.txn")
+
+	tests := []struct {
+		name     string
+		rowLimit uint64
+		next     string
+	}{
+		{
+			name:     "Complete",
+			rowLimit: 0,
+			next:     "",
+		},
+		{
+			name:     "Row limit",
+			rowLimit: 2,
+			next:     idb.TxnRow{Round: 1, Intra: 3}.Next(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ch := make(chan idb.TxnRow, 2)
+			ch <- idb.TxnRow{Round: 1, Intra: 2, TxnBytes: txnBytes}
+			ch <- idb.TxnRow{Round: 1, Intra: 3, TxnBytes: txnBytes}
+			close(ch)
+			var outCh <-chan idb.TxnRow = ch
+
+			db := &mocks.IndexerDb{}
+			// Exports are guarded separately from searches.
+			isExport := mock.MatchedBy(func(filter idb.TransactionFilter) bool { return filter.Export })
+			db.On("Transactions", mock.Anything, isExport).Return(outCh, uint64(7))
+			si := ServerImplementation{
+				EnableExport:   true,
+				ExportRowLimit: test.rowLimit,
+				db:             db,
+			}
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+			require.NoError(t, si.ExportTransactionsCSV(ctx, generated.ExportTransactionsCSVParams{}))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, mimeTextCSV, rec.Header().Get(echo.HeaderContentType))
+			assert.Equal(t, test.next, rec.Header().Get(headerNextToken))
+
+			records, err := csv.NewReader(rec.Body).ReadAll()
+			require.NoError(t, err)
+			require.Len(t, records, 3)
+			assert.Equal(t, columnNames(transactionCSVColumns), records[0])
+			for _, record := range records[1:] {
+				assert.Len(t, record, len(transactionCSVColumns))
+			}
+		})
+	}
+}
@@ -28,14 +28,34 @@ const (
 	errTransactionSearch         = "error while searching for transaction"
 	errSpecialAccounts           = "indexer doesn't support fee sink and rewards pool accounts, please refer to algod for relevant information"
 	errFailedLoadSpecialAccounts = "failed to retrieve special accounts"
+	errExportDisabled            = "export is only available on servers configured with an API token"
+	errStreamingUnavailable      = "transaction streaming is not available on this server"
+	errStreamFailed              = "error while streaming transactions"
+	errAccountChanges            = "error while looking up account changes"
+	errUnknownOrder              = "unknown order"
+	errOrderWithRound            = "order is not supported when searching for accounts at a round"
+	errAssetStats                = "error while looking up asset stats"
+	errTransactionStats          = "error while looking up transaction stats"
+	errNetworkTotals             = "error while looking up network totals"
+	errStakeTotals               = "error while looking up stake totals"
+	errNoStakeTotalsFound        = "no stake totals found for round"
+	errUnknownInterval           = "unknown interval [valid intervals: day]"
+	errParticipationWithRound    = "status and vote-last-valid filters are not supported when searching for accounts at a round"
+	errMinBalanceWithRound       = "min-balance filters are not supported when searching for accounts at a round"
+	errClosedOrRekeyedWithRound  = "closed-round, is-rekeyed and auth-addr-history filters are not supported when searching for accounts at a round"
+	errAccountRekeys             = "error while looking up account rekeys"
 )
 
 var errUnknownAddressRole string
 var errUnknownTxType string
 var errUnknownSigType string
+var errUnknownFormat string
+var errUnknownStatus string
 
 func init() {
 	errUnknownAddressRole = fmt.Sprintf("unknown address role [valid roles: %s]", AddressRoleEnumString)
 	errUnknownTxType = fmt.Sprintf("unknown tx-type [valid types: %s]", importer.TypeEnumString)
 	errUnknownSigType = fmt.Sprintf("unknown sig-type [valid types: %s]", SigTypeEnumString)
+	errUnknownFormat = fmt.Sprintf("unknown format [valid formats: %s]", FormatEnumString)
+	errUnknownStatus = fmt.Sprintf("unknown status [valid statuses: %s]", StatusEnumString)
 }
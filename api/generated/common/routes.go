@@ -71,148 +71,180 @@ func RegisterHandlers(router interface {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/W/cNrbov0LMu0CTfSM7Te9eoAEWF9lkgw027Qax2wVe3IfLkc7MsJZIlaRsT/P8",
-	"vz/wHFKiJErzYSdtgf0p8Ygfh+T54vnip0WuqlpJkNYsXnxa1FzzCixo/IvnuWqkzUTh/irA5FrUVii5",
-	"eBG+MWO1kJvFciHcrzW328VyIXkFXRvXf7nQ8EsjNBSLF1Y3sFyYfAsVdwPbXe1a+5Hu75cLXhQajBnP",
-	"+k9Z7piQedkUwKzm0vDcfTLsVtgts1thmO/MhGRKAlNrZre9xmwtoCzMWQD6lwb0LoLaTz4N4nJxl/Fy",
-	"ozSXRbZWuuJ28WLx0ve73/vZz5BpVcJ4ja9UtRISwoqgXVB7OMwqVsAaG225ZQ46t87Q0CpmgOt8y9ZK",
-	"71kmARGvFWRTLV58XBiQBWg8uRzEDf53rQF+hcxyvQG7+GmZOru1BZ1ZUSWW9tafnAbTlNYwbItr3Igb",
-	"kMz1OmPfNcayFTAu2Yc3r9g333zzLaNttFB4hJtcVTd7vKb2FApuIXw+5FA/vHmF81/4BR7aitd1KXLu",
-	"1p0kn5fdd/b29dRi+oMkEFJICxvQtPHGQJpWX7ovM9OEjvsmaOw2c2gzfbCe4g3LlVyLTaOhcNjYGCDa",
-	"NDXIQsgNu4bd5BG203w+ClzBWmk4EEup8aOiaTz/b4qneaM1yHyXbTRwJJ0tl+Mt+eC3wmxVUxZsy29w",
-	"3bxCGeD7MteXzvmGl43bIpFr9bLcKMO438EC1rwpLQsTs0aWjme50TweMmFYrdWNKKBYOjZ+uxX5luXc",
-	"0BDYjt2KsnTb3xgoprY5vbo9aN52cnCdtB+4oN/vZnTr2rMTcIeEkOWlMpBZtUdWBfHDZcFi6dIJLnOc",
-	"5GKXW2A4uftAUhv3TjqELssds3iuBeOGcRbk1JKJNdupht3i4ZTiGvv71bhdq5jbNDycnlB1msnU9o02",
-	"I7F5K6VK4BI3z2spGS/LGX5ZlkxYqIxXahxrxAmKlpUuWQEl4CI7cYC/GqvVDhdvwLVTtYUiU431SLFV",
-	"pRvQLPFEaFj6HAmfUuW8NJZbmFSI4pXsWXQpKmHHy/2O34mqqZhsqhVod+CBt1rFNNhGy6nJacQ9iFrx",
-	"u0yrRhYHqByWKR2zdFNDLtYCCtaOMgVLN80+eIQ8Dp5OEYrACYNMgtPOsgccCXeJQ3HE5b6wmm8gOpMz",
-	"9oPnLfjVqmuQLQtiqx1+qjXcCNWYttMEjDj1vLIvlYWs1rAWd2MgL/x2OPqmNp4BVl765kpaLiQUjjci",
-	"0MoC8YpJmKIJj1UxVtzAf/3nlHztvmq4hl2SZQ4RgJbT3mm27gv1nV9FO8MekjwQD9dqiH+zuHcQ3mGj",
-	"jIg+IUPdV88S0vfHXv8DbpDx3EZsMvp5hFJic+nEzlqUKJJ+dpgUtqExjgX3NyIIKSM2kttGw4sr+Sf3",
-	"F8vYheWy4Lpwv1T003dNacWF2LifSvrpndqI/EJsJjazhTV5DcNuFf3jxktfu+xdu9zUFOFzaoaau4bX",
-	"sNPg5uD5Gv+5W+Ou87X+dUEXmqmZU3eOd0pdN3W8k3nvDr7asbevp7ALh5zjGkhhplbSAFoJXpKw/OB/",
-	"cz85xgAS+V4k785/Ngr1uW7sWqsatBUQ2zzcf/9Dw3rxYvG/zjsbyTl1M+d+wk6FtlMMn9CcW0/oROCe",
-	"9EE7BlbVjSW1LUVDLdJ/bGEbztkdi1r9DLmlDeqD8QSq2u6eOoA97Obxdgv/j3rMEfvmQeZa891n3kcS",
-	"gRmKsvHIPzh1y/G/mm+ExIUv2e0WJKv4tWMHXCq7Bc3cWYCxQRiSAknysTXWeInqlcqzRYpiEmdqHnyo",
-	"3ak9xrl2bfeeaNT0i1LDY22Xedz9OoIW+jv3b3pAeoh38qE04a5Bf+Ullzk8ximv/FAHn/B3QgoE4u90",
-	"Ffv3MYdjbrfyMY74MQjYjbOXYLHRlxX5OOVjbJJ5rF06gsGF/fo3zrdn+WCM/2up8uuTznLuqHDUPTP/",
-	"HXhpt6+28Bnmj8beA8Vld4l4BIz+rJgY3Xf2rT9a1R5Fpz/skcgTTWN+77v3+6Hj3pYfzv56Zzpkgoef",
-	"sTnukO/DvTm+GCd8gt5/LyRZr9ydnFvGvYuLjD9X8kq+hrWQwn1/cSULbvn5ihuRm/PGgPbK1dlGsRfM",
-	"D/maW34lF8uh7Jjy6aMXw0NTN6tS5OwadqlTIPfKeISrq4+83Kirq5+YVZaXkZ05crp4+2B3iR6jHE2Q",
-	"OcxQjc28szLTcMt1kQDdtNZJHJm8P3OzLpkfm4yo3hnqx0+TAa9rk6GVPkMzfXr5dV265cfaM5n2mTsy",
-	"ZqzSwUQqTIAGz/d7Zb3Zkd8ywi/WGDDsfypefxTS/sSyq+bZs2+Avazrd27MCwfH/3iToaOnXU1ukyNv",
-	"Pd1gKSUBF47nmcGd1Tyr+QZMcvkWeI2nvwVmmgo9SmXJsFvP21FrtdG8QpO36RYQ9mP6AAiOw2RZtEJc",
-	"3AX1Ci769BLwEx4htmFbKL2x/QHnFV09Tj6uPdeXmaCAq6uP6O8PJ9P6BzdcSBOkghEb6YjAu1JXwHKn",
-	"BUBxxt6uGXK1Za+7D+jxHLNlHcKQ95NdujWi6ZzlXKJXtC7QSygk43I3NEMasDYYfT/ANewuI8v7kWEH",
-	"3snG94jEonHDtWKxO2F2yw2rFBqkc5C23Hm/XQI108A0QlpyQeTkG80c/k4xDaSayD3rCCdmIX6MISJG",
-	"3kpe12xTqpXnNC2KvmhxNPSZZirvHQDmERhK8q4RtmGG9mquExtBhDixBScs1I33IDKcXd7JKLcW2qBP",
-	"GLiXETwmkRMwzzusx6D8awuolSnNpLIDlDKBpFNI33q0louaaytyUR9mnaTR3/f6uEH2ifakMFfrocwe",
-	"idSkCKHG2YqbtPgG98VhYGMomMGtMTC6MBNpy7iCM4aBkJ5UVyXGN7SxV3TGXGPgRVg2xSJNgZamC9Cy",
-	"06kCGP0diZW3LTchBgNDVQKLOEjNmUDeS7cBiMCObiLsjfVW4eYt4YZP7f+0M/CtLBzvANOPR2ldfUGs",
-	"DMl/2TqgKcY0uASDHzA4/xbLoxx5y4XT8Zr0cSiJOp6jrg0tnBoHRPGgfWWiA3Jw/HO9LoUEljHRrtbi",
-	"ail+SOWCgmg6SvRzgLsC/Ik5bHMDHDxCCo0jsGulShqYfa9i2pSbY4CUIJCb8DA2spXobzjAJtMG+/rL",
-	"xd5LwJh3dES07PzidIzjm1vrfns/ZGPJ+1mvFaMmK3/fiMRVCkUda8rdBV+aBmPIrMpVeTa6mBkoATl9",
-	"1uOsmbuEJXU6QDS8CN2iSxt7ItZOxXoasXING2EsaH9hRwjb0IIucmJnwUHGrQXtJvq/T/77xceX2f/h",
-	"2a/Psm//9/lPn/7z/umfRj8+v//LX/5f/6dv7v/y9L//I3V/vFEWMhR32Q0vU17rq6uPrtEbg6r4G5SM",
-	"SfbT2ypGQX5iwpCB017DLitE2aRP28/7j9du2u/b26tpVtewQyEDPN+yFbf5FqVQb3rXZmbqku9d8Dta",
-	"8Dv+aOs9DJdcUzexVsoO5viDYNWAn8wRUwIBU8gxPrXJLZ1hL3jzfA2l5fPB52hTcAzT8rM5m82ImIow",
-	"9pz6FUExzXlppORa+m7o6VUIWcAdhjkKG8V0mtGKDlWX0ZZI3DSaxt3O/AifXS2OVxerxn6UtG7sPz5g",
-	"eePhD13eBHvhdS2Ku4Fxig4szT7w9I659dH1cYRgSDh+sD3IFRmixuFiVmkIxjSilkgdocBnGa9tTEZd",
-	"6O1hBxMEuI8EVk2rRA2m+WwICOMYYb/2FC6ytVYVUt74FhQhp5jQ73so2Imcwaw+lWmML455Yoj9Xns8",
-	"8PIfsPvRtcVTdb0paFrIQ0mmu+5gTyakVY9wNA+zLKYw34+4B/Pft8SWxHrMeSHrTs9RcCQB8LrW6oaX",
-	"mbe/TjEKrW48o8DmwVz7hWV6+qwu//by3XsPPlr6gGuyyM+uCtvVf5hVOeGm9ASdhiQNdy0LZrGhEPH2",
-	"V2F6NtvbLfhw++jS4sS1Ry6i8s4eH3EEb8NdB+XuSIusdx3QEmdcCFC3HoTO9EMOhL7TgN9wUQabS4A2",
-	"zZlocZ3b5mjmFA/wYOdD5EPKHpXdjKg7TR17OFE8w0waQEWpJIYpH+7fXpbwhoQGHETQiu8c3pDna8yS",
-	"ZFNljugyU4o8bZWTK+NQQpJDyTVm2HjiruVGdAw9PVYjorFcM3NA9M8AyGiO5GaGGKapvVsp7/FupPil",
-	"ASYKkNZ90kiLA/J01BgS0U7WoxNmZ0pY+4KaNE54jA7tE6setLh2lFM0aaccjyf1p+bX057dQ5RoN9SU",
-	"+oxAzGvQsW9wBO7r1lgVsKh1anLZc6McEWIQzzjSMmbCAzzxeVbRSOFdrCeczv4866Ct+wS8NLuYFLUv",
-	"p8WsG/8IAdvJUwQslqSUE8hLoxLDNPKWSxsyC/1u+d4GyLLoet0qbSymoiaDZo66bsQZiw+6ZJhsrdWv",
-	"kDayrR0e3I6njyam3unBD74sDDjDxKWhPZlpRNmHjG3O50NBai+ZDwZqqB20dvWuzEDA/fi4JhnM1BUl",
-	"+sj6gTgTQgx5TeTuxRtdcFFwSczlFRYu6DlA0ywqjtA6p/E7FuVhHhsC+O2K59fpm4KD6WUX5NBzpljF",
-	"Quc2r7d/Xmcsipdo2wqDOF6DroTti7yOUE/V+v9o7CgXFS/T6n+Bu3/ZUygLsRGUotwYiFJ0/UCsVkJa",
-	"wqJCmLrkOwoj6bbm7Zo9W0b8zZ9GIW6EEasSsMXX1GLFDSpmna0ndHHLA2m3Bps/P6D5tpGFhsJufe63",
-	"Uay9maGppPVersDeAkj2DNt9/S17gn5bI27gqdtFr24vXnz9LaY10x/PUgLNFzOYY78F8t/A/tN4jI5r",
-	"GsOpCn7UND+mcjTTnH6GmqjrIbSELb1w2E9LFZd8A+loqGoPTNQXTxPdPoN9kQWVT0DFkgmbnh8sd/wp",
-	"23KzTetCBAbLVVUJWzkCsooZVTl86rJeadIwHNViIF7fwhU+opO8ZmlD2Jd18VECaWrVGMrwPa+gv61L",
-	"xg0zjYO5y273DDG5wRoM6Jv0JHrigIN64fuyJ1LJrHK0Uzz1/KyPf8kYZGV5mQ5/DLxrGP06P/ShOoYb",
-	"JZvc2Ka3sTziSSdvcaPT6+SNm+qHD++8YKiUhr5dchVCa3siRoPVAm6SFDuMw241k1ZchJ1PKSiUNjGC",
-	"FX+OIZu65ih1fQ1QC7k5X7k+pELQqEPlYQMSjDDThL3Zuu1xnx0pRrdSHJqtoFRyY748TQbAJxxEG0AM",
-	"evt6H9SjgUMRigybTm+Ma+emeB+KVtDQrv2X340o4GpvQs4H33Y6PsoxHYqwfeXjYcl933el0HpvOdoq",
-	"QRYkbpAMt1zIiaApgGIiAARwxgulrSAnMsBvEM5hRQXG8qpOM0U03hElIlU7QNsuTksykCtZGGaEzIFB",
-	"rcx2XxrPRPj5ncTJSmGI9cWFCXOlqVQBSgCrBikWhwaAziaT9GHMtFJ2ClAUFXEWkFKW8cZuQdo27Aqw",
-	"PtJwJRQiipoQKdzEsth3jg2HIg+8LHdLJuxXNA4GdqBcqEBfl8CsBmC3W2WAlcBvoCuGhaN9ZdjlnSgM",
-	"lroq4U7kaqN5vRU5U7oAfcbe+EIlqJ1RJz/fszPmg+N92NjlncTlFQpIdYvXScsMcX6tPTle8ZIpWe5G",
-	"P2MFKQPlDZgzdnmrCAjTJRQZJwx7PVaNpcDaQqzXgHSKy0GlDvt1HyKYsKwXFhdrh/Vr+g2o7U5mqM1M",
-	"KLeWblB38hU1Yj4atW+kH5BGRZp0QKgSig3oJZl6cNtFBV0CmdMhlLbdRXINFKTpOJuQVquiyYHSli56",
-	"+BiBJUYgtZWOorwAxKFQVa2DM1wCA091FwW8dD2je6BU/RXi2cENaLZyt6xuoCfEdCK4jOUa48wBsyFo",
-	"qVA8TTPnpt5oXsBhviVkgj9QjzbdJoxwo44b4EfXfqg29XSTnsRPS+koUNJJmZiXp3jZpOr1YSp6+Q0V",
-	"i9NQUlgp1hnDtsuRYrUGyIyQaavMGgB5O89zqB06x3VkARyjIj0TWQXmuwTZ6k5YWnEDFPA6owxkOS/z",
-	"pqTArhlJf5vzUvdN2SWsrXIIFpcX7EwVws21wsAyKvFF82nHAKMejqIcmu58C9LiQ0UtRxx64H8dh5Bn",
-	"JdxAWnEHTpHkf1e37pK7a8/CTdGBsSR6QVJpISddBZ17dNo/+AtGBD4Rk8e6eSDdUUxsbhGfcw1aqELk",
-	"TMifwVNzy5YCxlBhPSWtkA3WI9TQwU1ygmFQ/DDwfYwBeiq1z33oR4VKuO2ddhHpc/0YSmP5NRDYIXzf",
-	"i8ZDz1SDEUUzYWLRPO9DdhwyeuL9wC2c6/ZozSPh5YBDtUQ+R3RDXB6gzeC0xrs0yad6zPcQZsXbgG3m",
-	"GXUirMznDIeWE3cfZVWwD4ScuXbsG9CmH7AUmVLgbs/YrkVvfMqk1qrGuLXjZ8lCKIGZnG9H7LjDuaB8",
-	"UdIL9gfvy07s4ESaeQuAuRU232YTMdquLbVwMHwY3rTGU5IKgVQI6zXk9hAYMNiXKlROQkGfHRSvgReY",
-	"ndHFbVPE9hCUJ98r5oY2kV4jjUAttFNrcJSnR5RfajFkH/L/qA7E/RuF/0PXzQFkEBQZf/ZpIxW18cjT",
-	"Jf1wtgODu9IWQIxopFaGl2nLc5i0gJLv5qbEBv1JW8U2GN9J5nAnw5xAgTvIm4k4wmhqT2dzk7smwwW3",
-	"5Dmmirio3/Ak/6a10nHJiIEzTjJwLVgoy0e3GoXfQxZ6m1XbP0D3LQox7+aswBi+gXTZ0BgXQ8MUCv7t",
-	"hpcTcfAfoNZgnKbLOLv828t33jkyFQ2fTyZvcOszsyxnk2mT90u8qaV5G8Ua4XdfMDppGZ2KL6LwIvd5",
-	"1Ps0r+1UeZFoQ0O42higf4SQXFZz4T1/XSrAeGd9esg4YeeQsN7ugIeL8EkXOEhqJXHRmTFGsy1+pnT0",
-	"Fq+PQN9ilbXBgqnirMsFkky/oMj43j2w9AiTVWKjkVumR50mm8iMuIe792AfTNrNEMZLbe6o9llih42o",
-	"6pLcTV5HcBI97sWOyknpIoA+f0DZY8eqfPZoEzjZAfT4QSanwrI/e3M+oOSf8pWq6hKmGXlNjkIqUk+y",
-	"GjODeVEIL8uCcUfleaM7q98wZORHXgoqHmwwO1gqVbt/nUyU7j+Y3qEaS/8Hrt1/qFZF/3+EVVEqsRtq",
-	"geci5MJXnVCNDYG3C6ckFHRF8X1TqcYnpogdZK4eC4kEK5sN+e0JZzyZkozsXRizo0r8ssEvcbQ0I0DQ",
-	"bW3CX4YVYEFXTlveqltWNfkWA4T5BkK8MPri0VQ7mKg3eggr6se9e4+kqXlOA1GoRsn1BjTz0RPMlz5s",
-	"QzAqLgYlzIduY7w885Tg3BfFPC68j2pOFMucCJYOYFzD7pykOP5+AuOYDomeAAwDoz8jSA+Kr45D9Pfg",
-	"63VPAaLCM72shhb8R1SEHHye1o5UhMbJB4cuD9eB5NAYGK/zcPdWvLcJVtGt7VAtfry508q3XR2ifKcr",
-	"SLjuqP3ThoSqLol725fS3Wmdfgw/b/LU++UJhy+7IFMyWEjLP72Sq6pSEs1TZTnwDcqCYWyLwbdYJAN5",
-	"A6WqIdkaN+mAsEojNhIKeycpLuIC/7y8k6m2sfjF1tHyUuXoore1TqvTOKg7ROGt9O7VqSN2AajdiOHJ",
-	"tdNHfENRcu2IONQa9EPGvPRjHFACbCM1ZVZRmKh/6cK7PemEB0/5hUzLUBoshIO2flz4peGl91NL9Apf",
-	"Ykhkfg2Sqn61L45ZxUCaRnu3sIMVx3Og+GFULHRN1+TU+l/ZXE0djSbz1hrvg6IwvJe6OnWgcIej5msK",
-	"ufZCbrKZrIcc0x58w5DWhnau2fJObnCHhLqC4sCc2Ngrhqk9of9M7gOVJuseuEsnvUQPwMhxBjl78vb1",
-	"U4blIaYS9aOXIfcvO64VdhhEFHU7gmWY5HQMFGuAKVfkIHqDrWFC2OyrcrK+6QqcYKuh+XgvlAeGo/2d",
-	"G6xY4pt7t/nvNAatB6R/n2Q8VJyUeXQVjOVio1WTDlnaUKLwX/EVIQYyV/TikgWGihAF0pgt//PXz8+f",
-	"//m/WCE2YOwZ+xdmMpAWNK6f1D9NJrq6TL1CbwwBazMBSZ3x0RLRnFt/oKOoGOGjJnCYL3/CyeoC0erw",
-	"+ctxL2k1JyaXqfU6mUD5T/y9M6PowPs0jHf3AO5HL+2cKH3/Qc/03C8Xe8r6lDdtRZ/TCLyEqXJ15V0C",
-	"Tb95nnWYesbeud4M5Fppd8usGutkLT6iF+x8MfZQxL3tSndisL38FbTCS7Rkyt2Zh7JGRJuNkRg8Rz3Y",
-	"+HAiB0ObKdnGHj+5QK1hSUA+pTta4p3gRlpBaobbxh+jXawdg3dA/2srygQW1Mp9NzEcSyYVo6LUcUuK",
-	"m+syRwhmH7jcQ6QvS05xtniRthE5TMCYiXdRpY7uhp5vueyq7PbLfFCQEzm6osplA5w85kWhPo8dXh+l",
-	"moiukL4AldORMb2hNbR82e2u+a4CaU9kCu+pNwVu0COY80qonlBCQ+995SynHrdzY7uPbXpdq+2jSY0Y",
-	"UbTG5YTq3T1o6kv3duoTIZeTUusGg/+ieMlgUvO3itY0ew07poOZIK6UR5r7CYo+SYz0+8GXooJONSZd",
-	"IiWFxUHSwj/DnbxaUeQ3cbOvZpbTDjOPFWYCK6jvPE60p3AE2l60ffoP2I0NLLsa+n7sXrXOfuAmXjPP",
-	"2Os2oBZN8BRa1kXZ+jfgB4Z6SpdrsxeFjl+dJVMk2vKvrj7W5NZPEK5vQGLetRkLfN+E5+tNW/M7YTsI",
-	"ze7WoLt2qft7aLnWv3YNx6aD0GxcLr7HeZaP8TZgmob8MWc4QSJIa9G/uyypmFGvGF77MH2Hcx367DF0",
-	"zVaU87EoaNyPhFVPTzkkGTiyf1JKcPfDK16Wl3eSZkpEGHTP6aVcU1Sk0WcZtFzTsVbvnQrGDE+xsSGd",
-	"57nTSIouijGC8yvDhlVc/KPBozouPcF8JNdMlPhv8Y/rzeS60Y4x1ppEzrjeNBXZfj//+vasYLIAnih8",
-	"gtO4ipvXhKLn8pX2qQ1i7fNWpipIHFhVi55GwCdIO42rC6ycwPSl09Wh9vnNSmZ56zh1sgvfz1bsihyO",
-	"V4sz9pbCoDXwgpioFhZS9Z1668fcwFsoSzQbE0Zn7elGJeDOHBX16mcZxGwN+ALC0AH7B64YxmvTTJzY",
-	"FFfywVa9Q/oNTuiVm8mP1B5SzqVU9g90TkdWDBu8AROFCdR1WzqsBBmeIiJdGIedMN0pDWIj595tWPMg",
-	"CMzwuJLioM+lfPpVfPBmJCVaFfk0JooGeRqMyrPzIlOy3KW4a5xqN2Cv7V7MPt7QJt+ZLrTE+FVG9ScO",
-	"W2JgM++jFSJi4w3z/eOu74QCbw+u6jYYoMc19vXtxc/MPN1JmT/9ofdpZpHza1Yzo2IIpVs48ScNWZCf",
-	"gWPJguokNF04zpV8yX4FrfwFsh3KEURnMvV54z5f9CzRqS1qYkbdhlMeWTSGFj+jHU4Wnrq6+njHR1oG",
-	"wvQA/eK0GmJ7z/jNRNGO+IyDB8VX6XhgNR6acWZjp54mu7r6uOZFMajqEIfoEJNpa6/QbvvqJYgs/Hai",
-	"UMjsaa5nT3Nm/F5SwW24Ac48KhFujJS+cRt2nHqkwhanQ/C6+k7jqQ8h/tanfBBqhFvwQ5EjzDqDHjN1",
-	"5XiFd7KXbclQD5xq4TtjnoV4/2v4XQfbSrkO3Cy4bIJTcfCqBz3jyipeP2rVur3MI4J42hUNk47oLlXH",
-	"C+YwXlSFAAfoPN7Dt0Me9hxRGD19gvh1mKDB4yoi3ctkGirMLuqumInD8SWXWrWwq4VFzn30xcchxCaa",
-	"Id5rxt66kXl5y3cm2E47xJoeLuwq1TJJ2O3i9EMy+Kb3RufoRPoAuagFPrbW54Itjk9bHCceuyPLpWM6",
-	"lBclblqjhY8h5l0Rs76jKPiJfDkmHgnopd9mXvatBTRwsA67Nq/C2GFF7ZFG8uyA52MSxe3aLd3D87wn",
-	"b5bZedPhsTyOehGTo2mmuZscvlUx4SeRrpE7tO+4vu7JQG76D01RsHxv1J6KEYW4n/D2jPcuvO+eB8GQ",
-	"3dbW/yNocvZ94LJQFXvTSMKCJz9+ePPUP0AbkCwk5Dvk85D8jp+lWY+fpUk8zuK25LEepLkufqMHacrR",
-	"gzSnr/Twp2gCbk09RBOCw8mftBHG6oSJ+Mu/QDPHZoJvcJ7PeDfGsYzGdyNO42c6TZEiPWri4V7b1iwa",
-	"iMgHqSO9Z+y4pTepja+H16kl/ZC8rjKlbCPrIov73pC9/ngTTwZ4jQQnwQJqiTfRjH9VL3Dh6P1UejaE",
-	"KmqWkZqwbmRhBlvYVbGfcR7OagleSQhtZv2QU+LzUJl5EXsZ+5CgF88H17ev9w0fqsAqh1TPEF9QpMf7",
-	"hqWAuq30z4YnklZLtRG5IVvFse7Od6Hv/XJRNaUVJ47zXehL/te0xBToYbywXBZcFwyK53/+89ffdsv9",
-	"nbGr8SYl4078srw5jluR9zW+dnUHMLFwlGcbNWZZk14pvemM9K0Xaol1WbuoqOOcSQhIer3RYkN0w2rH",
-	"eITqyim4pRXdT0v325abbcc6+88oc8mZ51fDaC7Mo/htHiqJiCJ7UFTBgDymGEdHJL8H2ojZI+HDoSzx",
-	"u4iTjEvP+iWSgdLhS0guw72uS3C6XccDx3ST611t1Xk4GhL5Yc4LMS7HH4+X3nVsgDUrldNEKFfcKZOd",
-	"xoVX6Q6qE6rljfbnIoYrVUpvq8E4iNKhKFt9dfVTWtmkFOa0dpnudH/k2V4M9rS/47RvkxpufU1AfFla",
-	"3oMDXx6k8Z7fYyDwGrWxXEnLc9QbqeTt4qU3LS18YdrF1travDg/v729PQt2p7NcVecbTBrIrGry7XkY",
-	"iF4UiVNrfRdf7c5x4XJnRW7Yy/dvUWcStgR6mxju0L7VYtbi+dkzysgGyWuxeLH45uzZ2de0Y1tEgnMq",
-	"W0AVY3EdDkVQMXpbYOblNcSFD7CiMZY2wO7Pnz0L2+BvDZFb5/xnQ/h9mKcpngY3ub8RT9AP8TSqHT5G",
-	"kR/ktVS3kmH5ETw701QV1ztM/LONloY9f/aMibUv14AeOMud1P64oIS1xU+u3/nN8/Movmbwy/mn4NoW",
-	"xf2ez+eDgqChbeSETf96/qnvIosnCg7O3t/nn4Jd6X7m07nPKJ7rPgEzFU86/0ThjHT7iqZKd+opT5/s",
-	"nYcOzTnaoerixcdPA1qBO17VJSCZLO5/ao+opTJ/VPfL9pdSqeumjn8xwHW+Xdz/dP//AwAA//+0RMn7",
-	"uKYAAA==",
+	"H4sIAAAAAAAC/+x9f4/cNrLgVyH6HrD2u9aM47x9QAwsHrz2GutbJzE8Th5wmRweR2J3c0citSQ1Mx3f",
+	"fPdDVZESJVFqdc/YSe7tXx63+KNYVSwW6xc/rXJd1VoJ5ezqxadVzQ2vhBMG/8fzXDfKZbKA/xXC5kbW",
+	"Tmq1ehG+MeuMVNvVeiXh15q73Wq9UrwSqxdx//XKiH800ohi9cKZRqxXNt+JisPAbl9Daz/S/f06dLSZ",
+	"NoUw48m/h5+Z3jC3E8wI25TOrtnVnhViw5vSsTAA4wYauMYoUTCpGC8KI6xlOPDZpfpXdsVLrnKRwQws",
+	"YyU3W2Fd+JltpLFuzcRdXjaFVFtWC4X/GnHLTWHDyv/RCLPvlk6Ax6sUqqlWL35axfOtfl6nVk8wJpat",
+	"yj2TCiARzBmuLM/hk2W30u2Y20nbLlAqppUIOIoas40UZWHPJgAPk08TaL26y3i51YarIttoU3G3erF6",
+	"6fvdH/zsZ8iMLsV4ja90dSWVCCsS7YJa1mROA52x0Y47BtDBOkNDp5kV3OQ7ttHmwDIJiBSZrFBEQSNy",
+	"IW/wz40R4heROWARN0G7jRMmc7JKLO2tp5xnWIZtcY1beSMUg15n7NsGuE8wrtiHN6/Y119//Q0jNDpR",
+	"+O02uapu9nhNLRUK7kT4vISoH968wvkv/AKXtuJ1Xcqcw7qTwuNl9529fT21mP4gCYaUyomtMIR4a0Va",
+	"Ur2ELzPThI6HJmjcLgO2mSZsK3VyrTZy2xhRADc2VtDetEF2XIv9JAnbaT7fDvQi6HTxGgZYIF55hUfA",
+	"QLrSryRcj5ag0ZDpTXglNtqIhbuQGj/qNozn/1X3Yd4YI1S+z7ZGcBQNO67GKPngUWF3uikLtuM3uG5P",
+	"JN+XQV/i4xteNoAimRv9stxq4gPAYGCQMDFrVAn8AKP5fcakZbXRN7IQxRp45nYn8x3LuaUhsB27lWUJ",
+	"6G+sKKbQnF7dgW3cdgK4TsIHLui3i4xuXQcwQSqNyPJSW5E5feAsDlubq4LFp2d3MNvjTmb2cScYTg4f",
+	"SCtB3Clg6LLcM4d0LRi3jLNwDq+Z3LC9btgtEqeU19jfrwawVjFAGhKnpzSA3jmFvhEyEsi70roUXCHy",
+	"wqYbo8xLfhuEZ62VFUyoXIPoX7PKCxbSzl6AjPy71YpljDMr1bYU7H9dfP8dK3TeVEI59sTz0VNoWtlt",
+	"zfPruHX4KXSAZqrwYypxWwI9ClHKSgIyYfB1oEOrihjBLKC7EgVBdvV3kTtWC8OwP8f17L3A5wXbGF0R",
+	"l3PHr7gVE4j1iErJcQBxtV55+KELQp2W6V7tzXhZzhzAZcmkE5X1WjKctUjRoj2b14AKgVzV6Rf4q3VG",
+	"70VBe86uma6dKDLdOPqF7XQJA9o1bgEalj53A7FS57y0jjsxqWHHKznAZUiz8XK/5XeyaiqmmuqKDupA",
+	"R6f9cTw1OY14QDJU/C4zulHFAh3WMW3iM9TWIpcbKQrWjjIFSzfNIXikOg6eTrOOwJHqADhSLQNHibsE",
+	"UUCawRdW862IaHLGfvDCHL86fS1UK/NBqYJPtRE3Uje27TQBI049f3dW2omsNmIj78ZAXnh0gEClNv7E",
+	"CVIp18px6bU5BFo7QcJ5EqZowmN1VhAc//5vq/tDX424FvvkGTVkAFpOe0lGGUx951fRznBgSy7kw40e",
+	"8t8s7y3iO2yU0aZPKC3w1YuEtDmm13+BQSae28ptRj+PWEpuP8I5v5El6gB/B04KaGjgjBogImgFVm4V",
+	"d40ReAZauWUZu3BcFdwUdNThT982pZMXcgs/lfTTO72V+YXcTiCzhTV5r8duFf0D46WPG3fXLjc1hbub",
+	"nqHm0PBa7I2AOXi+wX/uNoh1vjG/rOiGPDVz6hL7Tuvrpo4xmfeMOld79vb1FHfhkHNSA3cYaSpodnpJ",
+	"h+WrHVdbYT/4T/AF5INQKP6iY+8cz+0Xn6IpaqNrYZykAXMaCf7E8xn++BcjNqsXq/9x3pkgz6m/Pe8B",
+	"AALAQ8yN4fvuZuOmjgXaDNx5cRDdY9mtMCDmqrpxpE0PuZ0EfIaCejzyD1YUuLtrvpUKV79mtzuhWMWv",
+	"gdm50m4nDIPtJawLop70URy0s23588LrqGerFD902/SnFo3D9XeMRHobkbQP+BNR1W7/FNbnsfsBRN6j",
+	"UPe/BzH8MXQ0FyOex0w8pG0Pie1cp5L2wUT1CvPCNX7mPTnAVYDtcfaBfTxsHc0b/5RtA5o+XLh1VHsM",
+	"unZtD1I0avpFd8Njocs+Lr6O2At9zP1zP+B+iDH50D1hrXB/9kb7R6BysP8vpvC3UkkE4q9kRPknmQOZ",
+	"W1Q+BokfYwPDOAc3LDb6skc+TvkYSLpw3P3mtV4LQC4iAy7noDZJ452CLvtYTHXEeRDY658iomX9BwuI",
+	"P5c6vz6JlnOkwlEPzPxXwUu3e7UTn2H+aOwDUHwn3K021x+14+Vvfvs7hPLQ4ntLOigC/JhHss2F49fi",
+	"/yekRQv6TCj72NnlfvMo60A9hLdoVYfxFrU9HXm/i4Ma/jQ3vFx+ug1XmDrofrsWyAGlu+WfTud/miCP",
+	"MEFGW+skljvaDtmb8Cgi3weXQ+xTSMTn0QcmFTn+pFZAKe7Dschvdqku1WuxkUrC9xeXquCOn19xK3N7",
+	"3lhh/O32bKvZC+aHfM0dv1Sr9VAbnYqvBRKEuOa6uSplDpF6KSpQKNB4hMvLn8CNeXn5M8OzI3LRRwFC",
+	"3rXaWTHHLEcTZMAZunGZDxzMQtDxaGLbOnZxZOw9O+ua+bHxx0FQc3ob8Lq2GQY4ZBjhkF5+XZew/Nh8",
+	"QVERGCnCrNMmeJelDdAgfb/Tznts+W0IQGmssOy/Kl7/JJX7mWWXzbNnXwv2sq7fwZggQMV/eW8r7Kd9",
+	"TSE+R5qdusFS0hgXjvTMxJ0zPAMXv00u3wleI/V3gtmmAhJAUAp2i3ECYmBreIXRArZbQMDHNAEIjmXa",
+	"cbRCXNwF9Qrhsukl4CckIbZhO1H6OIUH0Cuy/ZxMrgP2o5kA3cvLnzD2NlCmjWXbcqlsOBWs3CrYBD7s",
+	"D6Ih4F4hijP2dsNQqq173X2ErJeYreiQliL12EdYI0YdsJwrGLCpC+58qKzaDz24VjgX/OXoNPoYBS0c",
+	"GQLMb7gs+VUpMm9hSoQRdbIhlnwV31Ok8jqWJfxK3xC1IU7Gj5lmUx8axQ+cxkUDK2lP5I652C23rNIY",
+	"RpAL5cq9j7ZKTJfGQyOVo8CRnEIIM9g6U/IKN2wUxQh7NpZefozhHohizHhds22pr7yQa3fHi3Z7hD7T",
+	"8uw9AGAfQZYlDScBDTPbvuYmgQjsMIWCExYK4z1IAswu72SWw0h0oKPg/nji8e48gfN8mOEYlP/cCVQI",
+	"tWFKuwFL2SBNUkzfxiGtVyWEwFi5XehmfBea+2i6wzKhTYAKumEIVWv1hxDi5FlEUoQkBQjj7/Eekq7l",
+	"IW0otBJ1AL1m3PWkaK6VFco2GBjtdK7LtJSpIC5oOQa+Dc3v16uaGydzWS9z61H/970+MMghlSyphOnN",
+	"UNcaqULJ1VLjDANtU7tXwBfYvo2lgGlYY5fCQTPRLQdXcMYwmczT6KrEGOo2f4U2CDcY3B2WrbZzoKWF",
+	"ijCq04UDGH2MxEfPjtsQ512sI/m6SD2d2PkQl4mfkGGjrR/fNyTMW4obPoX/6fi3t6oALhe2H/PeRrcF",
+	"dWAoO5OR4Jbi3Crbxbut1kfFrpGpv0mTQyvUzUE0bWnh1DgwigftDzYiEMDx/WaDUeAZk+1q3c5vXG6t",
+	"ziUF6ndizM8hCswCAm6DARaPkGLjCOxa65IGZt/peG+q7TFAKiFRFPMwNsrk6P9igXW+TZj0l8KDl7ex",
+	"7Og20XoVe2ya1I27jVt5Wdc+QC7J9D5YjFW8oN2txpHqSH8BrMm7ANWRR9+n1R2rerUR8gvOwE4GUDcG",
+	"N1NtWF76A9nZGOL0oUgR+1IdNV84i5wenlrpSXD+jJBbLJopxvS12FPGkPX0SZ7v41AAIMEcK1gr3LHM",
+	"EGc1zLNASLiDIzx1AH0nHAtfW1lSkUjvX1+WMo+fUqq5KaV61CmjDNJTWD0Znv4yXGnw3Alnmz+VKy7x",
+	"wunhRquhrlGI6cadcOmjvXMC++Nu23TqXJr1pc02Rv+SMoN+J24ZffOMDrx1te/4jHKnP+O+nQI75Uw9",
+	"sJuO3UgeogNSNHEkv/KDOR3LnVhg2OWXo+GhkDKRTFz/Yjh6uU5Hzx7JoeNMNMBA8Fkb+YtoMwzXTNJB",
+	"rR1ylOzpKWgowDhZUK8gB85pJp0V5eaEzdMVZjhGzPleTCpWgc4EU9gTpE+Y/TiJl5w91pynNeZlx/dR",
+	"WxLIMXdp9aRaNuaIF+YOzJlbyAf6wGouMRs/BvihRJs1NvjEKu7TkLzgOGGW6bsHbJrxhaO7bYieoebz",
+	"Xi8Alt7FOtwtwkmAuo8RW2mdIf3qUkWXC/xThb+GWv1BNXwqJma9GppAkmK9BFnrGJpVAE2tfR5sYAox",
+	"1V3cjMi1KUSUBNsrxiIdEsNXZeHdoONjAT8lr2p4UfszJsBR8rAo2Me/vHwXAIOxukS5vRMwNndOGBjg",
+	"/zz5jxc/vcz+N89+eZZ98z/Pf/70b/dP/3X04/P7P/3p//Z/+vr+T0//419SPi8/cbbjdjcG+a/c7oJU",
+	"8i3XfgtIO7Sac8wzNDzvjOatD/RXWt+AnYgwg0XPcNe3kTVqkruCyQoNMdqiK9MzWHUSg2FifBiVEuo7",
+	"MYB4DqdjdOnFO7He4JVqLGXHPEqe0CxkvoxXZ5urbtbOb4rnEFUCWbOrHif31Iovy8VDlcTtjLCg7qTd",
+	"eTu0iXde3HittrWNpm1GN8JYb2AcDXwDw7b84FsuCIONiRED3802w6Tvh3bP5F2l14pRkyvvWI6cAymb",
+	"FhA8bb/ts5QVpUBeznonBiwriS0rUBxehG4Rl7EnEjbQ/mlkOKdDRhgfmYEQtnr5l2W3G+1Ehs6F7IaX",
+	"Ms1l0OiNRZ/rG2iatlf2D1eqPCKnWA+mhYTmQpaNm2RC7cTfXsO038UMDswFlBQ837Er7nIU7P3poc3M",
+	"1CU/uOB3tOB3/NHWu4yXoClMbLR2gzl+J1w1EAlzmynBgCnmGFNtEqUz4uWDSOL8pVd9WwPDWLOnMzBy",
+	"gPTFhRK32czV8WOkXHSe/C4H348bVYXAU3Hd+0jXRrhgyvG98oTbpC6L42FuWauDKyqtsQRqpV2A/ASg",
+	"Z+8zrTMJ9se8b3Qyjf3t6yXDTDB6nw2GKO4s5zj1DJ9iKMxrUTo+X5mODFkFNDybCyIbCf0ijH0gDjlA",
+	"Me1SoJGSa+knJk6vQqpC3KFTVrqoIJIdrWipEx3NlHTqR9Pg3Z9G+OzO8nh1scPcj5I2EPiPD1jeePil",
+	"y5s4Bnldy+JuEC1HBEtvKqTeMbEgFFQyYjAU8H6wA8wVRcaNS384bUTPLxP72ej2q4YelQHTtU6iZYRJ",
+	"eYk2M46bx2dAMelFS/Ei3eDwqjVy78eW9QnHdY8FO9VoMKuvczrmFzjk0dt0MEBY8PJvYv8jtEWqxmb5",
+	"pVvmSJ/aUtI8LNQxxfl+xAOc/77dbEmuh4X5mK9e5PKRG4DXEBDOy8xbGqYEhdE3XlBg81/JTJKmFdil",
+	"3nvw0XIsuKEQ4dlVYbv6d7MqONz0AVUObSsh0Gl4iPiA0IE57HYnvH4XXa7huPbMRbu8CxDuxgtBpZtw",
+	"CTlS2/OxzN7CNR3TLOo2pLmLacLOgyjmNuaU7OwEbVoy0eK6OPKjhVM8wIOjoaOg9uxRxc1od6d3xwFJ",
+	"FM8wU9KtorKAlmmSxp3VCm/yMAMxKET4Xgkfip+4bjVVBpsus6XM0+Fm6sp6uxgMD40ZNp6wCcCIINDT",
+	"YzUyGgua2QWGsAGQ0RxJZIas9incXWlvfm6U/EcjmCyEcvDJtPGM0faE3Rg82yfr0YlgVKr2+gU1aZzw",
+	"GB3aF8l80OLaUU5YHirH40k91fx6Wto9RImGoYL6DCqDNomt8IGCBnPRi54IbkZfZBWrsa2ZhoBLxAGo",
+	"S/QNcSSdb3PG/nLHc9ifLt8Jy3BSH3n/fB0KNoav9PtXvbOy0M1VGVWVJhEw1oIQi/NXgDjbYrTu161V",
+	"uAsk8mkikSHnyKSteMZ0CFJaOepb6BslfdLKA2OA0lXkA6F9hMREBsSUrvByWk+A8Y/QEDqFAAGLVQEq",
+	"UMtLqxPDNOqWKxfK3Hps+d5WkAkfet1qYx0Wok6mIR51X+oFmj3kljQdf3R5+dMG+OB2PH00MfWeD0I6",
+	"VrRN3HpaykwzyiFmbAsQPxSk9pb8YKCG6k0b8do9ohB4PybXpICZumNFH1k/tXHiFEZZE2WxoIwNwcNc",
+	"kXCh4tw9o2NaREUt7DmN34koD/PYksFvwVacvuoATC8ja3cc5uw0C50DYWyfXmcsykBr2/q4qFqYSjqX",
+	"Mvg+4NryexNHuax8Ev4I+QVi/2NPIy7kVjobXufoPAN+IFZrGSIyC2nrku8pMa9DzdsNe7aO5JunRiFv",
+	"pJVXpcAWX619mJYVuLa+miAp+UMot7PY/PmC5rtGFUYUbucLkVvN2qsl2nravIIr4W6FUOwZtvvqG/YE",
+	"48KsvBFPAYv+vrB68dU3mBVE/3mWOtB8Cfo58Vug/A3iP83HmFJCY0TPiaTlMQWMTkv6md1EXZfsJWzp",
+	"D4fDe6niim9FOr+0OgAT9UVqon91gBeFjbxmzKRLzy8cB/k0EYID4o/AgACTSjp8PsBpZnUF/NSVYKZJ",
+	"w3AUOEKyvoUrfMT0lZqlLXlf1pdO1YxTq8Yko+94JfpoXTNumW0A5i4izgvEifquVpib9CRmgsBBvfB9",
+	"2ROlVVbB3imeennW57/UxJgglZzWBdk1rCcwP/RSHQNGySYR2/QQyyOZdDKKG5NeJ29gqh8+vPMHQ6WN",
+	"6BtWr0Kxgt4RY4QzUtwkd+ywskWrmbTHRcD8pIJyEeqwDW3A3EnrZN76rv15abiyG7TgYVWRRgVDsD/H",
+	"fBWQUA8+EW71kDQEafKmxHjJDGmyTx/7xEqVVI0d5k0EEgZujqLCPqdH5Ug7BOw5/0bhIAi13R5tLmPY",
+	"n5zBxvxFGB1ZMVOZA9GxN52YkJgolZAQh2K7nbbdbQQAmSljNUe4pAwQJ4sAF3h2bpVtoxDUOkDmVFhO",
+	"2aREC5bfCmQIQxvM+XTMctcYzN7zuXteT2EE8oPvLNFVZbxfOt6KqB9oEuOqXVxKdFBVvNGy8edYqE1Z",
+	"SLS+vhailmp7fgV96PZBow7lxVYoYaWd1gm2O5Cs8BlO8cgih0OzK1Fqn+nxZY/zAPiEc3wr8PB5+/oQ",
+	"1IlAZXpMJcOm04iBdjDFe9/eDw3tvzw2ovyFg/UWfUrDTMQO6CtU7uSVT6s3FMOjxqgEkyyva6EK0SZI",
+	"5DsuJwJ7rBDFRJCmwBkvtHHIzgx++fKYdLIS1vGqTkLp0HFBOxEVAgC07cIkQJ1rVVhmpcoFE7W2u0O1",
+	"8yZqAd0pnKyU1rUy1HdguTb05AadDXpQ72pp3tVsZa8+jJnR2k0Bilpm1PiD1g4j9YRybS41ZfUNV0JF",
+	"M2AVXschkcW+1aZ7rATecwPp/gcaB0AhlbIS5roUzBkBj8bBCVkKfiO6V/RwtD9Y9vFOFhRSXoo7mYPj",
+	"rN7J3D81yd74uDm82FEnP9+zM+YrFfnz5OOdwuUVWtCtL14nLbML8fa+tHjF3pQ//Bl+qKwob4Q9Yx9v",
+	"NQFhu8wGy6tBj6vGUamRQm42AvcpLgfvg9iv+xDBhO8B4quE7bB+Tb/CbrtTGem56XuxI+PLnXpFjZjX",
+	"lvoOysHWqOgSHhiqFMVWmHWUDSEr0VXzg6NbG9fZoDYCEYWSTSpndNHkgmrIXfT4MQJLjkBqX+zqYCMe",
+	"Cs8xdnAG+1GQqWBjQH32GZmQlO6vEGknboRhV0KoaKAnJHQiuKzjBr5cCdhhfqmieJoWzk29NbwQy/zq",
+	"KAR/oB5t7bMwwo0+boAfof1Q1+rpJr0TP31KR9UPhIB/OlmekmWTqteHqWTAN/TKpBGk9dF7edh2PVKs",
+	"NkJkVqq0QXcjBMp2nueiBnaOH9gWghJX4YpKXj84XcPZChRWTt4IqmIxowxkOS9JQdUqmznpb3NeDvJU",
+	"SrFxGhgsfpe0s3JKmOuqCQlIYT4DAjDqATsK2HTvW5ABQKpuc5hB7Mm4LkxWihuRvvMLTuVh/qpvwT62",
+	"b2kBU3RgrGm/4FZpISddBQMbiNo/eNtEBD5tJs9180ACKSaQW8R0roWRupA5k+rvwu/mViwFjqEHIrVy",
+	"UjUgaJgRHdx0TjCMMB/m5o45wEzVWYQP/cwNJW571C4ifW6UmHktCGw/T7hqLaWpEVYWzYR11vC8D9lx",
+	"zOg37wfuxLlpSWsfiS8HEqrd5HObbsjLA7YZUGuMpUk51RO+S4QVb5OqmBfUiZBaX8A1tJy4+2ing2nR",
+	"9+jGHuejdZwJ6J0fG1r0xocfYPAaY3aPnyULYVR2cr69sH2eC8oXVbLC/r7uRgqDEzV/WwDsrXT5LpvI",
+	"o4K21AJg+DC8aY2nJBUCd6HYbETulsCACTmUDjIJBX0GKF4LXmDJpS63irKqhqA8+U4zGNpGeo2yErXQ",
+	"Tq3BUZ4e8RhRmOcg8/+oF/L+jca/Nlif6fA28B8870zYt6mNZ56ukhdne2ERK20+TrRHam15mTZMhkkL",
+	"UfL93JTYoD9pq9gGvx2dORhdBAeKuBN5M51wE6b2+2xucmgyXHC7Pce7In6cckjJvxijTVy/e+DHV0xA",
+	"i+4hbLzVaPweSgK3JU77BIRvkRG8m7MS1vKtiL5NGPxDwxQL/uWGlxM5QB9EbYQVygFeMOee/KpTmUD5",
+	"ZIIld77cmuNsLlkKbmpp2UZxlvidoEg7VaZiKym0Ej6Pep9mPJ2q6R8hNITqjgH6W0hHYDWXPmigS4Ma",
+	"Y9anE46TapekNHQEHi7CJ0biIKmVxG+KjDma7fAz1QZu+foI9i2usjZQOvXI8HqFW6Zf3f1gHru0WSW3",
+	"BqVletTpbROZEQ9I9x7sg0m7GdYz1ThGL4ElMGxlVZfkqfY6ApzocS92VD5eFzz4+YNpHzvM7bMHqp3u",
+	"OHr8+LRTYTlcknE+Fq3/kM4CZ9+aAIV/4yq3cEzr2jGp4hqqGBbUuTOnHw5NVnzPdO0yqebBmikelhx0",
+	"ajr0jS2ZMOZ1O/ZyeifhQQdhV5Bs2YuvdtXHiV/MEPJ24BSxv1evdFWXYvrUrimgpADHnFfMsLYrLwrp",
+	"FZdgydN53pjOxDsMLfwRkukx88JifVeldQ3/YpU/+APzGHXj6G/BDfxBVeL7fxEDRdWaYChyUqKHMgwU",
+	"MkxW6xV1XgUxlqzm1Cv2McX/4eXIQZlYb9rWSiQLPx3L65VWqfIBF00V5g1vJQ7LncEGO7ZYMH7M0IE+",
+	"O2dkYLBtKk33hka7yYlJdClYW+gZeswUyp/mcMLEAMgUK8dvWR1BtZRkWkPkxzIyKu2yXl3oQ6pYisng",
+	"/KDCX6f2Vg/oPHFadcVIsCy5Nv3yC73QnUB8X5UOm9B7YQuIPUZhh452aXNq1Ik1DBb5FMeafELfnM1J",
+	"692gUKKW5Ant8uwAufhli1/idD5GgGBYog3/s6wQTphKKsF2YC9uwPPjtOFbERLaMNYS/WmDiXqjh7Dx",
+	"fmKmjzizNc9pIArFLbnZCsN8dGzYzm2IbcWlclyqzlc1DAuE3zDI8ug0u2992Etn1Me7aJRsl8jmC2Bc",
+	"i/05XbXw9xO0u+mcvQnAoPHnBOlBCYBxDukBfr3u3VKRn3rc0oH/iLdVgM/vtSNvq+Ps2KXLw3Xgdmis",
+	"GK9zeQxCjNuEqOjWttTUMkbutIXEXS2xkKTrZ0J3NNEQQsJjJAnj2pcysNA6/Rh+3iTV+w83Dsr5ahRK",
+	"Fp+e2pAlFXzMWqEPAUzPvQAOVTCM9qSCgooJdSNKXYtka0TSgrQZqmfk7hQFr13gfz/eqVTb6D/UOlpe",
+	"6gG3jkmz016wHDyXQ7eHHNODTh2xSzDqRqREhIeM+AZH6EYMAYgPGTMEKS94NGurDKX+UxoQKdmhtAVR",
+	"uM8dbSmQ8JhWSPdpg23EPxpeUhOhMHTnI6r2+bVQ9E4WSCP/OiITyjbGx+4ArDgegOKH0X3Vtm1yavH0",
+	"bO41E4N+zdZl6oPeMX2LuoI6UABx9PxrLtAegk5nslpzTGv1DUOoLDojDlXeQjY2lSgWFm2JBqTc89B/",
+	"JreVXtRqN+FEVnaXXj84QbE9e/L29VMmN8OPUf57uFhLu2DZ8RNXyyCyGHU+gmWYhX8MFBshpuJFBiF2",
+	"bCMmDptD5SI3N12lSGw19PEdhHJhzHCo6eub+9im32igcA9I9vZ1Ug3oVQ05ukzberU1uknHlW5NnajY",
+	"jMo6KkIU7Wh3/I9fPT9//sd/Z4XcCuvOIGNOMa8FjV+u6VOTye5FnN77ZAwBa0tVkDrjQ9qiOXdRkeae",
+	"2JY+tA2H+fIUTpa/ilb39nWyl3KGk5DL9GaTrPDxPf7e2bpNkH1GjLG7QPpdi70Rp+oIf8POMMyB+qjl",
+	"TVsa9bQNXoqph8LKuwSbfv086zj1jL2D3kxAnkIuLKsaB2etuMPkUXLGxNxDGZWue+wSkykVGH/xEq2Y",
+	"VrkYnTUyQjaGy/Ec9WDrYz4BhraUR5uY9OQCtYY1AfmU7mhjlmaNcpLUDEDjjxEWaxDwAPR/7mSZ4IJa",
+	"w3cbwwEmRkbPOMctKbi5ywwmmH1iWo+Rvux2issZFWkbEXACBra96z9O5G/o4ZWTthppdD5TJCpFI0Ql",
+	"oAc8ecyrIX0ZO3oLXU+EwClfyRd0ZIC0ag0tXxbdNd9XQrkThcJ76k1GaXweyMwroWZCCQ29Dz0kCAYA",
+	"p9Njw8e2fEKr7aNJjQRRtMb1hOrdxhGFx2479YmYC06pTYMR2lFQezCp+VtF61KhVyP8ARnx2zgz8agq",
+	"t5mTycw0WYlONSZdInUKy0WnBd1w0lcrSs8hafaHmeW0w8xzhZ3gCuo7zxMtFY5g24u2DyYBZNMGln0t",
+	"+sFGvXcS+9H1eM08Y6/brAdo5uPlu1QIMmkMHWxUDiEc7UIa3w49HWSKRB8cRD9S7FVi4/oGdMxDm/GB",
+	"75vwfLNtX8lO2A5Cs7uNMF271P09tNyYX7qGY9NBaDZ+YD1uFXkIa75fBY1ltV4BwPAPAAT/bswvK/Sf",
+	"lqufl+0hT+YMJ0hE0q76d5c1VdvsVRX3OyLmuY59Dhi6Zkse+4BBNO537fp6ypJiL11fX/Kl++EVL8uP",
+	"d4pmmnVuplzKVO3ep4KFxihavVe5qxqGOzY2pPM8F9bG74FEcP7BsmGZQQpAHxca7B3MR0rNxKP4Lf9x",
+	"s51cN9oxxlqTzBk326Yi2+/nX9+BFUxWaJaFz0LVmwlNiLZ+Y+hpY8o/kxufXDhVIWxh2Vdek462lXmn",
+	"cXXR7xOcvgZdXdThRUFI0AoBD0zSg0xOs0sKFLhcnUGyEmitRvCChKiRTqQKkPbWj7UfbkVZwr+eo7OW",
+	"uvFrneylX24oGGqRs42AXT4KnPgdl7TltW0mKDYllUIQRUykX4FCr2AmP1JLpJwrelDg90KnI0va9kuL",
+	"xeE9dR2wwEqhIt+/VFTsdsJ0p42QW5Wln3tEBtnwcBDYxDPp4+OgL6V8jmxMeDs6JVoV+TQhigZ5Gowe",
+	"xuZFBmlsKekarX0oXltcTDw6SQKuzZC28WPytMqovtiyJQYx8z5aITI23jDfP+76TqhA/OCyw4MBelLj",
+	"UN9e3FuiUHF8Fg6HPqSZRc6vWc3MYrGrEhZO8smILJyf/hegGdbBarowukv1kmIJ6QLZDgUbojOZ0ugh",
+	"qf8s0aktWmdH3YZTHlkUkBY/ox1OFha9vPzpjo+0DITpAfrFCUVu7xfQ+M1EUbaYxv0Xfx9abZFmnEFs",
+	"F/c9dpTwohhU7eo9V4pCpq09RNj21emQWfjtRCG4WWpuZqk5M34v8+s23ABnnvMPN0bKsbsNGO/i9g69",
+	"hdzFSXdFccZTL9n8rU95EWuEW/BDmSPMOsMeM3WDeYV3spdtNaiueLMf94y9jGOY41JFZFspN0GaBZdN",
+	"cCrGnAYnE51rFa8ftSrxQeERQTztihaTjuhBxLdtx4tKxfi3z9ttxTsfVUJjfLSX1dEEA1+HWXQ8rilm",
+	"d7qBqmb43Lq+6V0xE8Sh46dTC7tap9Fr7b3Y92iGGNdQAAJ0rvKW722wnXaMNT1cwCrVqkvY7eIccTL4",
+	"pnFjcnQifRC5rKVQLn5sraUL8Pi0xTE9sLdcftyF5FUoZUAdQqIH74rU9h1FwU/ky23y6IBeezTzsm8t",
+	"oIGDdRjavApjhxW1JI3Os8PlAFPFi1uUHpB53pM3K+y86fBYGUe9SMjRNNPSTQ0f/ZvwkyhoBET7lpvr",
+	"/pt4vXcW1ZYymlQ/ODmdpnTCI57eu/C+e2cRQ3ZbW/+PwpCz7wNXha7Ym0YRFzz58cObp8wI25QuMFmo",
+	"miJYC8lv+H3Pzfh9z8Qrl4CSx3rZ87r4lV72LEcve56+0uVvegbemnrRMwSHD18h70uoL/+U55yYCb7B",
+	"eTnj3RjHChrfjSSNn+k0RYr0qC4cPKrUAfQMheUGR+SD1JFoCqrJJIwvGN5TS/oheV3lcdVG1kUW94Mh",
+	"e/3xJt608hoJTuIrgI50E+tTlPyMkQ7h37WjiullpCZsGlXYAQq7Z5ZmnIezWoJXEkKbWT/k1PG59My8",
+	"iL2MfUjQi0e7sXtJfPiSGlaxpnrV36tyj3lnXpexnRu5QyWYgmSRyrnEx939Y+3Hujvfhb6QsRY9+n7s",
+	"OO2D8eR/TZ+YEj2MF46rgpuCieL5H//41Tfdcn9j4mqMpNSqSr8sb47jTuZ9ja9d3QIhFkh5ttVjkTXp",
+	"lTLbzkjfeqFmH6k/6ExCQNLrjRYbohvgnZyI1bWht+C7n9bwG4TrdaIzejsBa05z5uXVMJoL8yh+nZf0",
+	"ok2RPSiqYLA9pgRHt0l+C3sjFo/ED0tF4reRJBmtsPJLJAMl8EtILkNc16UA3a6TgeN9k5t97fR5IA0d",
+	"+WHOCzl+bikeL4315spDBbBYX9BDb2KNC6/SHVQnlDQd4ecihiuxC93OCAsQJYF2O4jESCubVGcirV2m",
+	"O90fSduLAU77GCe8TWq49TUB8WX38gEe+PIgHcL5kmr9qeBnrlBTNTdU2Glc4OdGZF74ivnCCKERVPfC",
+	"bHRufI3XBYkbIX5k4fb4uK/FK+pEqQfzqextXcrHrrCOT8lkB3OqAxwxpsdYqORkrcv48rxoLCyemuSH",
+	"zj4Zj9CurACXHEYILnNDf3jz6uuvv/6GXUzoykMOaontyRZjMEZBWMKBcyRihEM1/APTx6+Cplh+s50o",
+	"EQJhY+kvdV1OfLnznoGpePr0t5rvF1UGobC2KJytC3Jrg99grDES7zGLYINXuVwrx3PkFnoPZfXSk3nl",
+	"Xy1Z7Zyr7Yvz89vb27PAA2e5rs63mHGUOd3ku/Mw0P16QIkwnq9nDCpcuUep9PL9W2Rg6UqY+C2kJCH8",
+	"7bG0en72jGruCMVruXqx+vrs2dlXJG53SLNzKky1wjcBcB1AUbxVvS0wbftaxKWt1qtQvAq7P3/2LKDB",
+	"mxwin/D53y0djsvc1PE09/cjRDxBJ+bT6GGpMdv+oK6VvlUMC8wh0W1TVdzsYbcJ1xhl2fNnz8ATSutG",
+	"973joPL/tKJs19XP0O/85vl5FJw3+OX8k/8rk8X9gc/nPiz+UDMMoz7YalA/PrTtkD7x6/mnvrM+hjqE",
+	"WvT+f/4pWLjvZz6dh+oqc22sP2AnG6QXRcU4zz9R5DUZiiJYxF2tjZsB6Sy3N6PmPbtdrwFCmaA5/Y7V",
+	"b4c/puG2zgheTXw8/Ov5J3fnkY72cgPbefXip08DeSLuOERaoChZ3f/csnEriTw736/bX0qtr5s6/oUe",
+	"lI1/ITz12uB6+r8AQe9/vv9/AwAbYmkYCtgAAA==",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
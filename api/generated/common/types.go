@@ -38,6 +38,9 @@ type Account struct {
 	// \[spend\] the address against which signing should be checked. If empty, the address of the current account is used. This field can be updated in any transaction by setting the RekeyTo field.
 	AuthAddr *string `json:"auth-addr,omitempty"`
 
+	// MicroAlgos the account may spend, the amount above the min-balance.
+	AvailableBalance *uint64 `json:"available-balance,omitempty"`
+
 	// Round during which this account was most recently closed.
 	ClosedAtRound *uint64 `json:"closed-at-round,omitempty"`
 
@@ -57,6 +60,15 @@ type Account struct {
 	// Whether or not this account is currently closed.
 	Deleted *bool `json:"deleted,omitempty"`
 
+	// The latest logic sig program of an lsig account, recorded from the transactions it signs with a logic sig.
+	Logicsig *AccountLogicsig `json:"logicsig,omitempty"`
+
+	// MicroAlgo balance required by the account, for the assets it holds and the applications it created or opted in to, at the current consensus protocol.
+	MinBalance *uint64 `json:"min-balance,omitempty"`
+
+	// The latest multisig composition of an msig account, recorded from the transactions it signs as a multisig. The signature of a rekeyed account is that of its authorized address.
+	Multisig *AccountMultisig `json:"multisig,omitempty"`
+
 	// AccountParticipation describes the parameters used by this account in consensus protocol.
 	Participation *AccountParticipation `json:"participation,omitempty"`
 
@@ -85,6 +97,111 @@ type Account struct {
 	Status string `json:"status"`
 }
 
+// AccountAppChange defines model for AccountAppChange.
+type AccountAppChange struct {
+	AppId uint64 `json:"app-id"`
+
+	// Whether or not the account closed out or cleared its local state.
+	ClosedOut *bool `json:"closed-out,omitempty"`
+
+	// Whether or not the account opted into the application.
+	OptedIn *bool `json:"opted-in,omitempty"`
+
+	// Whether or not the local state key values changed.
+	StateChanged *bool `json:"state-changed,omitempty"`
+}
+
+// AccountAssetChange defines model for AccountAssetChange.
+type AccountAssetChange struct {
+
+	// Net decrease of the amount held.
+	AmountDecrease *uint64 `json:"amount-decrease,omitempty"`
+
+	// Net increase of the amount held.
+	AmountIncrease *uint64 `json:"amount-increase,omitempty"`
+	AssetId        uint64  `json:"asset-id"`
+
+	// Account which received the remaining amount when opting out.
+	CloseTo *string `json:"close-to,omitempty"`
+
+	// Whether or not the account opted out of the asset.
+	Closed *bool `json:"closed,omitempty"`
+
+	// New frozen state set by an asset freeze.
+	IsFrozen *bool `json:"is-frozen,omitempty"`
+
+	// Whether or not the account opted into the asset.
+	OptedIn *bool `json:"opted-in,omitempty"`
+}
+
+// AccountChange defines model for AccountChange.
+type AccountChange struct {
+
+	// Changes to application local states.
+	Apps *[]AccountAppChange `json:"apps,omitempty"`
+
+	// Changes to asset holdings.
+	Assets *[]AccountAssetChange `json:"assets,omitempty"`
+
+	// New authorized address, it is not set if the account was rekeyed back to itself.
+	AuthAddr *string `json:"auth-addr,omitempty"`
+
+	// Net decrease of the balance in microalgos.
+	BalanceDecrease *uint64 `json:"balance-decrease,omitempty"`
+
+	// Net increase of the balance in microalgos, including rewards.
+	BalanceIncrease *uint64 `json:"balance-increase,omitempty"`
+
+	// Whether or not the account was closed.
+	Closed *bool `json:"closed,omitempty"`
+
+	// Whether or not the authorized address changed.
+	Rekeyed *bool `json:"rekeyed,omitempty"`
+
+	// Rewards paid to the account in microalgos.
+	Rewards *uint64 `json:"rewards,omitempty"`
+
+	// Round which made the changes.
+	Round uint64 `json:"round"`
+
+	// New type of signature used by the account.
+	//
+	// * sig
+	// * msig
+	// * lsig
+	SigType *string `json:"sig-type,omitempty"`
+
+	// New participation status set by a key registration.
+	//
+	// * Offline
+	// * Online
+	// * NotParticipating
+	Status *string `json:"status,omitempty"`
+}
+
+// AccountLogicsig defines model for AccountLogicsig.
+type AccountLogicsig struct {
+
+	// \[l\] Base64 encoded TEAL program.
+	Logic []byte `json:"logic"`
+
+	// Hash of the program, which is the address of a contract account with the program.
+	ProgramHash []byte `json:"program-hash"`
+}
+
+// AccountMultisig defines model for AccountMultisig.
+type AccountMultisig struct {
+
+	// The subsignature public keys, in order, base64 encoded.
+	PublicKeys [][]byte `json:"public-keys"`
+
+	// \[thr\] number of subsignatures required.
+	Threshold uint64 `json:"threshold"`
+
+	// \[v\] multisig version.
+	Version uint64 `json:"version"`
+}
+
 // AccountParticipation defines model for AccountParticipation.
 type AccountParticipation struct {
 
@@ -104,6 +221,22 @@ type AccountParticipation struct {
 	VoteParticipationKey []byte `json:"vote-participation-key"`
 }
 
+// AccountRekey defines model for AccountRekey.
+type AccountRekey struct {
+
+	// The address which signs for the account after the rekey, the account itself if it was rekeyed back.
+	NewAuthAddr string `json:"new-auth-addr"`
+
+	// The address which signed for the account before the rekey, the account itself if it was not rekeyed.
+	OldAuthAddr string `json:"old-auth-addr"`
+
+	// Round of the rekey transaction.
+	Round uint64 `json:"round"`
+
+	// ID of the rekey transaction.
+	Txid string `json:"txid"`
+}
+
 // AccountStateDelta defines model for AccountStateDelta.
 type AccountStateDelta struct {
 	Address string `json:"address"`
@@ -210,6 +343,9 @@ type Asset struct {
 	// Definition:
 	// data/transactions/asset.go : AssetParams
 	Params AssetParams `json:"params"`
+
+	// Relevance of the asset to the search query, only set when searching with query. Exact matches score above 2, prefix matches above 1.
+	Score *float64 `json:"score,omitempty"`
 }
 
 // AssetHolding defines model for AssetHolding.
@@ -277,6 +413,32 @@ type AssetParams struct {
 	Url *string `json:"url,omitempty"`
 }
 
+// AssetStats defines model for AssetStats.
+type AssetStats struct {
+	AssetId uint64 `json:"asset-id"`
+
+	// The total minus the amount held by the reserve address.
+	CirculatingSupply uint64 `json:"circulating-supply"`
+
+	// Whether or not the asset is currently deleted.
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// Number of accounts holding a non-zero amount of the asset.
+	Holders uint64 `json:"holders"`
+
+	// Number of accounts opted into the asset, including those holding zero.
+	OptedIn uint64 `json:"opted-in"`
+
+	// The total number of units of the asset.
+	Total uint64 `json:"total"`
+
+	// Number of transfers with a non-zero amount.
+	Transfers uint64 `json:"transfers"`
+
+	// Total amount transferred, it saturates at the maximum uint64.
+	Volume uint64 `json:"volume"`
+}
+
 // Block defines model for Block.
 type Block struct {
 
@@ -426,9 +588,49 @@ type MiniAssetHolding struct {
 	OptedOutAtRound *uint64 `json:"opted-out-at-round,omitempty"`
 }
 
+// NetworkTotals defines model for NetworkTotals.
+type NetworkTotals struct {
+	Accounts uint64 `json:"accounts"`
+
+	// Number of application local states.
+	AppOptIns uint64 `json:"app-opt-ins"`
+	Apps      uint64 `json:"apps"`
+
+	// Number of asset holdings, including those with a zero amount.
+	AssetOptIns uint64 `json:"asset-opt-ins"`
+	Assets      uint64 `json:"assets"`
+}
+
 // OnCompletion defines model for OnCompletion.
 type OnCompletion string
 
+// ParticipationTotals defines model for ParticipationTotals.
+type ParticipationTotals struct {
+	Accounts uint64 `json:"accounts"`
+
+	// Sum of the balances in microalgos, not including pending rewards.
+	Money uint64 `json:"money"`
+
+	// Sum of the reward units of each account, which are the whole Algos of its balance.
+	RewardUnits uint64 `json:"reward-units"`
+}
+
+// StakeTotals defines model for StakeTotals.
+type StakeTotals struct {
+
+	// Balances of the accounts with one participation status.
+	NotParticipating ParticipationTotals `json:"not-participating"`
+
+	// Balances of the accounts with one participation status.
+	Offline ParticipationTotals `json:"offline"`
+
+	// Balances of the accounts with one participation status.
+	Online ParticipationTotals `json:"online"`
+
+	// Last round, at or before the requested round, which changed the totals.
+	Round uint64 `json:"round"`
+}
+
 // StateDelta defines model for StateDelta.
 type StateDelta []EvalDeltaKeyValue
 
@@ -788,9 +990,44 @@ type TransactionSignatureMultisigSubsignature struct {
 	Signature *[]byte `json:"signature,omitempty"`
 }
 
+// TransactionStats defines model for TransactionStats.
+type TransactionStats struct {
+
+	// Number of addresses taking part in a transaction.
+	ActiveAddresses uint64 `json:"active-addresses"`
+
+	// Number of transactions of each type.
+	Counts TransactionTypeCounts `json:"counts"`
+
+	// Sum of the fees, it saturates at the maximum uint64.
+	Fees uint64 `json:"fees"`
+
+	// Last round of the interval.
+	MaxRound uint64 `json:"max-round"`
+
+	// First round of the interval.
+	MinRound uint64 `json:"min-round"`
+
+	// Start of the interval.
+	Start time.Time `json:"start"`
+}
+
+// TransactionTypeCounts defines model for TransactionTypeCounts.
+type TransactionTypeCounts struct {
+	Acfg   uint64 `json:"acfg"`
+	Afrz   uint64 `json:"afrz"`
+	Appl   uint64 `json:"appl"`
+	Axfer  uint64 `json:"axfer"`
+	Keyreg uint64 `json:"keyreg"`
+	Pay    uint64 `json:"pay"`
+}
+
 // AccountId defines model for account-id.
 type AccountId string
 
+// AccountsOrder defines model for accounts-order.
+type AccountsOrder string
+
 // Address defines model for address.
 type Address string
 
@@ -809,6 +1046,9 @@ type AssetId uint64
 // AuthAddr defines model for auth-addr.
 type AuthAddr string
 
+// BalancesOrder defines model for balances-order.
+type BalancesOrder string
+
 // BeforeTime defines model for before-time.
 type BeforeTime time.Time
 
@@ -821,6 +1061,9 @@ type CurrencyLessThan uint64
 // ExcludeCloseTo defines model for exclude-close-to.
 type ExcludeCloseTo bool
 
+// Format defines model for format.
+type Format string
+
 // IncludeAll defines model for include-all.
 type IncludeAll bool
 
@@ -857,6 +1100,28 @@ type TxType string
 // Txid defines model for txid.
 type Txid string
 
+// AccountChangesResponse defines model for AccountChangesResponse.
+type AccountChangesResponse struct {
+	Changes []AccountChange `json:"changes"`
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+}
+
+// AccountRekeysResponse defines model for AccountRekeysResponse.
+type AccountRekeysResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string        `json:"next-token,omitempty"`
+	Rekeys    []AccountRekey `json:"rekeys"`
+}
+
 // AccountResponse defines model for AccountResponse.
 type AccountResponse struct {
 
@@ -923,6 +1188,16 @@ type AssetResponse struct {
 	CurrentRound uint64 `json:"current-round"`
 }
 
+// AssetStatsResponse defines model for AssetStatsResponse.
+type AssetStatsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Statistics of an asset. Transfers are counted within the requested rounds.
+	Stats AssetStats `json:"stats"`
+}
+
 // AssetsResponse defines model for AssetsResponse.
 type AssetsResponse struct {
 	Assets []Asset `json:"assets"`
@@ -940,6 +1215,26 @@ type BlockResponse Block
 // HealthCheckResponse defines model for HealthCheckResponse.
 type HealthCheckResponse HealthCheck
 
+// NetworkTotalsResponse defines model for NetworkTotalsResponse.
+type NetworkTotalsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Number of accounts, assets, applications and opt ins which are not deleted.
+	Totals NetworkTotals `json:"totals"`
+}
+
+// StakeTotalsResponse defines model for StakeTotalsResponse.
+type StakeTotalsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Balances of the accounts which are not deleted, by participation status.
+	Totals StakeTotals `json:"totals"`
+}
+
 // TransactionResponse defines model for TransactionResponse.
 type TransactionResponse struct {
 
@@ -954,6 +1249,17 @@ type TransactionResponse struct {
 	Transaction Transaction `json:"transaction"`
 }
 
+// TransactionStatsResponse defines model for TransactionStatsResponse.
+type TransactionStatsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64             `json:"current-round"`
+	Intervals    []TransactionStats `json:"intervals"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+}
+
 // TransactionsResponse defines model for TransactionsResponse.
 type TransactionsResponse struct {
 
@@ -24,6 +24,12 @@ type ServerInterface interface {
 	// (GET /v2/accounts/{account-id})
 	LookupAccountByID(ctx echo.Context, accountId string, params LookupAccountByIDParams) error
 
+	// (GET /v2/accounts/{account-id}/changes)
+	LookupAccountChanges(ctx echo.Context, accountId string, params LookupAccountChangesParams) error
+
+	// (GET /v2/accounts/{account-id}/rekeys)
+	LookupAccountRekeys(ctx echo.Context, accountId string, params LookupAccountRekeysParams) error
+
 	// (GET /v2/accounts/{account-id}/transactions)
 	LookupAccountTransactions(ctx echo.Context, accountId string, params LookupAccountTransactionsParams) error
 
@@ -42,17 +48,38 @@ type ServerInterface interface {
 	// (GET /v2/assets/{asset-id}/balances)
 	LookupAssetBalances(ctx echo.Context, assetId uint64, params LookupAssetBalancesParams) error
 
+	// (GET /v2/assets/{asset-id}/stats)
+	LookupAssetStats(ctx echo.Context, assetId uint64, params LookupAssetStatsParams) error
+
 	// (GET /v2/assets/{asset-id}/transactions)
 	LookupAssetTransactions(ctx echo.Context, assetId uint64, params LookupAssetTransactionsParams) error
 
 	// (GET /v2/blocks/{round-number})
-	LookupBlock(ctx echo.Context, roundNumber uint64) error
+	LookupBlock(ctx echo.Context, roundNumber uint64, params LookupBlockParams) error
+
+	// (GET /v2/export/assets/{asset-id}/balances.csv)
+	ExportAssetBalancesCSV(ctx echo.Context, assetId uint64, params ExportAssetBalancesCSVParams) error
+
+	// (GET /v2/export/transactions.csv)
+	ExportTransactionsCSV(ctx echo.Context, params ExportTransactionsCSVParams) error
+
+	// (GET /v2/stats/accounts)
+	LookupNetworkTotals(ctx echo.Context, params LookupNetworkTotalsParams) error
+
+	// (GET /v2/stats/stake)
+	LookupStakeTotals(ctx echo.Context, params LookupStakeTotalsParams) error
+
+	// (GET /v2/stats/transactions)
+	LookupTransactionStats(ctx echo.Context, params LookupTransactionStatsParams) error
+
+	// (GET /v2/stream/transactions)
+	StreamTransactions(ctx echo.Context, params StreamTransactionsParams) error
 
 	// (GET /v2/transactions)
 	SearchForTransactions(ctx echo.Context, params SearchForTransactionsParams) error
 
 	// (GET /v2/transactions/{txid})
-	LookupTransaction(ctx echo.Context, txid string) error
+	LookupTransaction(ctx echo.Context, txid string, params LookupTransactionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -64,16 +91,31 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) SearchForAccounts(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"pretty":                true,
-		"asset-id":              true,
-		"limit":                 true,
-		"next":                  true,
-		"currency-greater-than": true,
-		"include-all":           true,
-		"currency-less-than":    true,
-		"auth-addr":             true,
-		"round":                 true,
-		"application-id":        true,
+		"pretty":                   true,
+		"asset-id":                 true,
+		"limit":                    true,
+		"next":                     true,
+		"currency-greater-than":    true,
+		"include-all":              true,
+		"currency-less-than":       true,
+		"auth-addr":                true,
+		"round":                    true,
+		"application-id":           true,
+		"order":                    true,
+		"status":                   true,
+		"vote-last-valid-before":   true,
+		"vote-last-valid-after":    true,
+		"min-balance-greater-than": true,
+		"min-balance-less-than":    true,
+		"sig-type":                 true,
+		"created-after-round":      true,
+		"created-before-round":     true,
+		"closed-after-round":       true,
+		"closed-before-round":      true,
+		"is-rekeyed":               true,
+		"multisig-public-key":      true,
+		"auth-addr-history":        true,
+		"format":                   true,
 	}
 
 	// Check for unknown query parameters.
@@ -177,267 +219,234 @@ func (w *ServerInterfaceWrapper) SearchForAccounts(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.SearchForAccounts(ctx, params)
-	return err
-}
-
-// LookupAccountByID converts echo context to params.
-func (w *ServerInterfaceWrapper) LookupAccountByID(ctx echo.Context) error {
-
-	validQueryParams := map[string]bool{
-		"pretty":      true,
-		"round":       true,
-		"include-all": true,
-	}
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := ctx.QueryParam("order"); paramValue != "" {
 
-	// Check for unknown query parameters.
-	for name, _ := range ctx.QueryParams() {
-		if _, ok := validQueryParams[name]; !ok {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
-		}
 	}
 
-	var err error
-	// ------------- Path parameter "account-id" -------------
-	var accountId string
-
-	err = runtime.BindStyledParameter("simple", false, "account-id", ctx.Param("account-id"), &accountId)
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params LookupAccountByIDParams
-	// ------------- Optional query parameter "round" -------------
-	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := ctx.QueryParam("status"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
 	}
 
-	// ------------- Optional query parameter "include-all" -------------
-	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
+	// ------------- Optional query parameter "vote-last-valid-before" -------------
+	if paramValue := ctx.QueryParam("vote-last-valid-before"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	err = runtime.BindQueryParameter("form", true, false, "vote-last-valid-before", ctx.QueryParams(), &params.VoteLastValidBefore)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter vote-last-valid-before: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LookupAccountByID(ctx, accountId, params)
-	return err
-}
-
-// LookupAccountTransactions converts echo context to params.
-func (w *ServerInterfaceWrapper) LookupAccountTransactions(ctx echo.Context) error {
-
-	validQueryParams := map[string]bool{
-		"pretty":                true,
-		"limit":                 true,
-		"next":                  true,
-		"note-prefix":           true,
-		"tx-type":               true,
-		"sig-type":              true,
-		"txid":                  true,
-		"round":                 true,
-		"min-round":             true,
-		"max-round":             true,
-		"asset-id":              true,
-		"before-time":           true,
-		"after-time":            true,
-		"currency-greater-than": true,
-		"currency-less-than":    true,
-		"rekey-to":              true,
-	}
+	// ------------- Optional query parameter "vote-last-valid-after" -------------
+	if paramValue := ctx.QueryParam("vote-last-valid-after"); paramValue != "" {
 
-	// Check for unknown query parameters.
-	for name, _ := range ctx.QueryParams() {
-		if _, ok := validQueryParams[name]; !ok {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
-		}
 	}
 
-	var err error
-	// ------------- Path parameter "account-id" -------------
-	var accountId string
-
-	err = runtime.BindStyledParameter("simple", false, "account-id", ctx.Param("account-id"), &accountId)
+	err = runtime.BindQueryParameter("form", true, false, "vote-last-valid-after", ctx.QueryParams(), &params.VoteLastValidAfter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter vote-last-valid-after: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params LookupAccountTransactionsParams
-	// ------------- Optional query parameter "limit" -------------
-	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+	// ------------- Optional query parameter "min-balance-greater-than" -------------
+	if paramValue := ctx.QueryParam("min-balance-greater-than"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "min-balance-greater-than", ctx.QueryParams(), &params.MinBalanceGreaterThan)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-balance-greater-than: %s", err))
 	}
 
-	// ------------- Optional query parameter "next" -------------
-	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+	// ------------- Optional query parameter "min-balance-less-than" -------------
+	if paramValue := ctx.QueryParam("min-balance-less-than"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	err = runtime.BindQueryParameter("form", true, false, "min-balance-less-than", ctx.QueryParams(), &params.MinBalanceLessThan)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-balance-less-than: %s", err))
 	}
 
-	// ------------- Optional query parameter "note-prefix" -------------
-	if paramValue := ctx.QueryParam("note-prefix"); paramValue != "" {
+	// ------------- Optional query parameter "sig-type" -------------
+	if paramValue := ctx.QueryParam("sig-type"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
+	err = runtime.BindQueryParameter("form", true, false, "sig-type", ctx.QueryParams(), &params.SigType)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note-prefix: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sig-type: %s", err))
 	}
 
-	// ------------- Optional query parameter "tx-type" -------------
-	if paramValue := ctx.QueryParam("tx-type"); paramValue != "" {
+	// ------------- Optional query parameter "created-after-round" -------------
+	if paramValue := ctx.QueryParam("created-after-round"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "tx-type", ctx.QueryParams(), &params.TxType)
+	err = runtime.BindQueryParameter("form", true, false, "created-after-round", ctx.QueryParams(), &params.CreatedAfterRound)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tx-type: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created-after-round: %s", err))
 	}
 
-	// ------------- Optional query parameter "sig-type" -------------
-	if paramValue := ctx.QueryParam("sig-type"); paramValue != "" {
+	// ------------- Optional query parameter "created-before-round" -------------
+	if paramValue := ctx.QueryParam("created-before-round"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "sig-type", ctx.QueryParams(), &params.SigType)
+	err = runtime.BindQueryParameter("form", true, false, "created-before-round", ctx.QueryParams(), &params.CreatedBeforeRound)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sig-type: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created-before-round: %s", err))
 	}
 
-	// ------------- Optional query parameter "txid" -------------
-	if paramValue := ctx.QueryParam("txid"); paramValue != "" {
+	// ------------- Optional query parameter "closed-after-round" -------------
+	if paramValue := ctx.QueryParam("closed-after-round"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
+	err = runtime.BindQueryParameter("form", true, false, "closed-after-round", ctx.QueryParams(), &params.ClosedAfterRound)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter closed-after-round: %s", err))
 	}
 
-	// ------------- Optional query parameter "round" -------------
-	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+	// ------------- Optional query parameter "closed-before-round" -------------
+	if paramValue := ctx.QueryParam("closed-before-round"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	err = runtime.BindQueryParameter("form", true, false, "closed-before-round", ctx.QueryParams(), &params.ClosedBeforeRound)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter closed-before-round: %s", err))
 	}
 
-	// ------------- Optional query parameter "min-round" -------------
-	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+	// ------------- Optional query parameter "is-rekeyed" -------------
+	if paramValue := ctx.QueryParam("is-rekeyed"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	err = runtime.BindQueryParameter("form", true, false, "is-rekeyed", ctx.QueryParams(), &params.IsRekeyed)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is-rekeyed: %s", err))
 	}
 
-	// ------------- Optional query parameter "max-round" -------------
-	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
+	// ------------- Optional query parameter "multisig-public-key" -------------
+	if paramValue := ctx.QueryParam("multisig-public-key"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	err = runtime.BindQueryParameter("form", true, false, "multisig-public-key", ctx.QueryParams(), &params.MultisigPublicKey)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter multisig-public-key: %s", err))
 	}
 
-	// ------------- Optional query parameter "asset-id" -------------
-	if paramValue := ctx.QueryParam("asset-id"); paramValue != "" {
+	// ------------- Optional query parameter "auth-addr-history" -------------
+	if paramValue := ctx.QueryParam("auth-addr-history"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	err = runtime.BindQueryParameter("form", true, false, "auth-addr-history", ctx.QueryParams(), &params.AuthAddrHistory)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter auth-addr-history: %s", err))
 	}
 
-	// ------------- Optional query parameter "before-time" -------------
-	if paramValue := ctx.QueryParam("before-time"); paramValue != "" {
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "before-time", ctx.QueryParams(), &params.BeforeTime)
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before-time: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
-	// ------------- Optional query parameter "after-time" -------------
-	if paramValue := ctx.QueryParam("after-time"); paramValue != "" {
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchForAccounts(ctx, params)
+	return err
+}
+
+// LookupAccountByID converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountByID(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":      true,
+		"round":       true,
+		"include-all": true,
+		"format":      true,
+	}
 
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "after-time", ctx.QueryParams(), &params.AfterTime)
+	var err error
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameter("simple", false, "account-id", ctx.Param("account-id"), &accountId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after-time: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
 	}
 
-	// ------------- Optional query parameter "currency-greater-than" -------------
-	if paramValue := ctx.QueryParam("currency-greater-than"); paramValue != "" {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAccountByIDParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "currency-greater-than", ctx.QueryParams(), &params.CurrencyGreaterThan)
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-greater-than: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
 	}
 
-	// ------------- Optional query parameter "currency-less-than" -------------
-	if paramValue := ctx.QueryParam("currency-less-than"); paramValue != "" {
+	// ------------- Optional query parameter "include-all" -------------
+	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "currency-less-than", ctx.QueryParams(), &params.CurrencyLessThan)
+	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-less-than: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
 	}
 
-	// ------------- Optional query parameter "rekey-to" -------------
-	if paramValue := ctx.QueryParam("rekey-to"); paramValue != "" {
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "rekey-to", ctx.QueryParams(), &params.RekeyTo)
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rekey-to: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LookupAccountTransactions(ctx, accountId, params)
+	err = w.Handler.LookupAccountByID(ctx, accountId, params)
 	return err
 }
 
-// SearchForApplications converts echo context to params.
-func (w *ServerInterfaceWrapper) SearchForApplications(ctx echo.Context) error {
+// LookupAccountChanges converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountChanges(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"pretty":         true,
-		"application-id": true,
-		"include-all":    true,
-		"limit":          true,
-		"next":           true,
+		"pretty":    true,
+		"limit":     true,
+		"next":      true,
+		"min-round": true,
+		"max-round": true,
+		"format":    true,
 	}
 
 	// Check for unknown query parameters.
@@ -448,60 +457,81 @@ func (w *ServerInterfaceWrapper) SearchForApplications(ctx echo.Context) error {
 	}
 
 	var err error
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameter("simple", false, "account-id", ctx.Param("account-id"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SearchForApplicationsParams
-	// ------------- Optional query parameter "application-id" -------------
-	if paramValue := ctx.QueryParam("application-id"); paramValue != "" {
+	var params LookupAccountChangesParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "include-all" -------------
-	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
-	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
 	}
 
-	// ------------- Optional query parameter "next" -------------
-	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.SearchForApplications(ctx, params)
+	err = w.Handler.LookupAccountChanges(ctx, accountId, params)
 	return err
 }
 
-// LookupApplicationByID converts echo context to params.
-func (w *ServerInterfaceWrapper) LookupApplicationByID(ctx echo.Context) error {
+// LookupAccountRekeys converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountRekeys(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"pretty":      true,
-		"include-all": true,
+		"pretty":    true,
+		"limit":     true,
+		"next":      true,
+		"min-round": true,
+		"max-round": true,
+		"format":    true,
 	}
 
 	// Check for unknown query parameters.
@@ -512,43 +542,1129 @@ func (w *ServerInterfaceWrapper) LookupApplicationByID(ctx echo.Context) error {
 	}
 
 	var err error
-	// ------------- Path parameter "application-id" -------------
-	var applicationId uint64
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
 
-	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	err = runtime.BindStyledParameter("simple", false, "account-id", ctx.Param("account-id"), &accountId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAccountRekeysParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAccountRekeys(ctx, accountId, params)
+	return err
+}
+
+// LookupAccountTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountTransactions(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":                true,
+		"limit":                 true,
+		"next":                  true,
+		"note-prefix":           true,
+		"tx-type":               true,
+		"sig-type":              true,
+		"txid":                  true,
+		"round":                 true,
+		"min-round":             true,
+		"max-round":             true,
+		"asset-id":              true,
+		"before-time":           true,
+		"after-time":            true,
+		"currency-greater-than": true,
+		"currency-less-than":    true,
+		"rekey-to":              true,
+		"format":                true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameter("simple", false, "account-id", ctx.Param("account-id"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAccountTransactionsParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "note-prefix" -------------
+	if paramValue := ctx.QueryParam("note-prefix"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note-prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "tx-type" -------------
+	if paramValue := ctx.QueryParam("tx-type"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tx-type", ctx.QueryParams(), &params.TxType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tx-type: %s", err))
+	}
+
+	// ------------- Optional query parameter "sig-type" -------------
+	if paramValue := ctx.QueryParam("sig-type"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sig-type", ctx.QueryParams(), &params.SigType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sig-type: %s", err))
+	}
+
+	// ------------- Optional query parameter "txid" -------------
+	if paramValue := ctx.QueryParam("txid"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "asset-id" -------------
+	if paramValue := ctx.QueryParam("asset-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "before-time" -------------
+	if paramValue := ctx.QueryParam("before-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "before-time", ctx.QueryParams(), &params.BeforeTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "after-time" -------------
+	if paramValue := ctx.QueryParam("after-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "after-time", ctx.QueryParams(), &params.AfterTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-greater-than" -------------
+	if paramValue := ctx.QueryParam("currency-greater-than"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-greater-than", ctx.QueryParams(), &params.CurrencyGreaterThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-greater-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-less-than" -------------
+	if paramValue := ctx.QueryParam("currency-less-than"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-less-than", ctx.QueryParams(), &params.CurrencyLessThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-less-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "rekey-to" -------------
+	if paramValue := ctx.QueryParam("rekey-to"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "rekey-to", ctx.QueryParams(), &params.RekeyTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rekey-to: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAccountTransactions(ctx, accountId, params)
+	return err
+}
+
+// SearchForApplications converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchForApplications(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":         true,
+		"application-id": true,
+		"include-all":    true,
+		"limit":          true,
+		"next":           true,
+		"format":         true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchForApplicationsParams
+	// ------------- Optional query parameter "application-id" -------------
+	if paramValue := ctx.QueryParam("application-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "include-all" -------------
+	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchForApplications(ctx, params)
+	return err
+}
+
+// LookupApplicationByID converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupApplicationByID(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":      true,
+		"include-all": true,
+		"format":      true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupApplicationByIDParams
+	// ------------- Optional query parameter "include-all" -------------
+	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupApplicationByID(ctx, applicationId, params)
+	return err
+}
+
+// SearchForAssets converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":         true,
+		"include-all":    true,
+		"limit":          true,
+		"next":           true,
+		"creator":        true,
+		"name":           true,
+		"unit":           true,
+		"query":          true,
+		"manager":        true,
+		"reserve":        true,
+		"freeze":         true,
+		"clawback":       true,
+		"url-prefix":     true,
+		"metadata-hash":  true,
+		"decimals":       true,
+		"default-frozen": true,
+		"asset-id":       true,
+		"format":         true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchForAssetsParams
+	// ------------- Optional query parameter "include-all" -------------
+	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "creator" -------------
+	if paramValue := ctx.QueryParam("creator"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "creator", ctx.QueryParams(), &params.Creator)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter creator: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+	if paramValue := ctx.QueryParam("name"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "unit" -------------
+	if paramValue := ctx.QueryParam("unit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", ctx.QueryParams(), &params.Unit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unit: %s", err))
+	}
+
+	// ------------- Optional query parameter "query" -------------
+	if paramValue := ctx.QueryParam("query"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "manager" -------------
+	if paramValue := ctx.QueryParam("manager"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "manager", ctx.QueryParams(), &params.Manager)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter manager: %s", err))
+	}
+
+	// ------------- Optional query parameter "reserve" -------------
+	if paramValue := ctx.QueryParam("reserve"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "reserve", ctx.QueryParams(), &params.Reserve)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reserve: %s", err))
+	}
+
+	// ------------- Optional query parameter "freeze" -------------
+	if paramValue := ctx.QueryParam("freeze"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "freeze", ctx.QueryParams(), &params.Freeze)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter freeze: %s", err))
+	}
+
+	// ------------- Optional query parameter "clawback" -------------
+	if paramValue := ctx.QueryParam("clawback"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "clawback", ctx.QueryParams(), &params.Clawback)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clawback: %s", err))
+	}
+
+	// ------------- Optional query parameter "url-prefix" -------------
+	if paramValue := ctx.QueryParam("url-prefix"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "url-prefix", ctx.QueryParams(), &params.UrlPrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter url-prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "metadata-hash" -------------
+	if paramValue := ctx.QueryParam("metadata-hash"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "metadata-hash", ctx.QueryParams(), &params.MetadataHash)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter metadata-hash: %s", err))
+	}
+
+	// ------------- Optional query parameter "decimals" -------------
+	if paramValue := ctx.QueryParam("decimals"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "decimals", ctx.QueryParams(), &params.Decimals)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter decimals: %s", err))
+	}
+
+	// ------------- Optional query parameter "default-frozen" -------------
+	if paramValue := ctx.QueryParam("default-frozen"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "default-frozen", ctx.QueryParams(), &params.DefaultFrozen)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter default-frozen: %s", err))
+	}
+
+	// ------------- Optional query parameter "asset-id" -------------
+	if paramValue := ctx.QueryParam("asset-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SearchForAssets(ctx, params)
+	return err
+}
+
+// LookupAssetByID converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAssetByID(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":      true,
+		"include-all": true,
+		"format":      true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAssetByIDParams
+	// ------------- Optional query parameter "include-all" -------------
+	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAssetByID(ctx, assetId, params)
+	return err
+}
+
+// LookupAssetBalances converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAssetBalances(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":                true,
+		"include-all":           true,
+		"limit":                 true,
+		"next":                  true,
+		"round":                 true,
+		"currency-greater-than": true,
+		"currency-less-than":    true,
+		"order":                 true,
+		"format":                true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAssetBalancesParams
+	// ------------- Optional query parameter "include-all" -------------
+	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-greater-than" -------------
+	if paramValue := ctx.QueryParam("currency-greater-than"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-greater-than", ctx.QueryParams(), &params.CurrencyGreaterThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-greater-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-less-than" -------------
+	if paramValue := ctx.QueryParam("currency-less-than"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-less-than", ctx.QueryParams(), &params.CurrencyLessThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-less-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := ctx.QueryParam("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAssetBalances(ctx, assetId, params)
+	return err
+}
+
+// LookupAssetStats converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAssetStats(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":    true,
+		"min-round": true,
+		"max-round": true,
+		"format":    true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAssetStatsParams
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAssetStats(ctx, assetId, params)
+	return err
+}
+
+// LookupAssetTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":                true,
+		"limit":                 true,
+		"next":                  true,
+		"note-prefix":           true,
+		"tx-type":               true,
+		"sig-type":              true,
+		"txid":                  true,
+		"round":                 true,
+		"min-round":             true,
+		"max-round":             true,
+		"before-time":           true,
+		"after-time":            true,
+		"currency-greater-than": true,
+		"currency-less-than":    true,
+		"address":               true,
+		"address-role":          true,
+		"exclude-close-to":      true,
+		"rekey-to":              true,
+		"format":                true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAssetTransactionsParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "note-prefix" -------------
+	if paramValue := ctx.QueryParam("note-prefix"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note-prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "tx-type" -------------
+	if paramValue := ctx.QueryParam("tx-type"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tx-type", ctx.QueryParams(), &params.TxType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tx-type: %s", err))
+	}
+
+	// ------------- Optional query parameter "sig-type" -------------
+	if paramValue := ctx.QueryParam("sig-type"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sig-type", ctx.QueryParams(), &params.SigType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sig-type: %s", err))
+	}
+
+	// ------------- Optional query parameter "txid" -------------
+	if paramValue := ctx.QueryParam("txid"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "before-time" -------------
+	if paramValue := ctx.QueryParam("before-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "before-time", ctx.QueryParams(), &params.BeforeTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "after-time" -------------
+	if paramValue := ctx.QueryParam("after-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "after-time", ctx.QueryParams(), &params.AfterTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-greater-than" -------------
+	if paramValue := ctx.QueryParam("currency-greater-than"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-greater-than", ctx.QueryParams(), &params.CurrencyGreaterThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-greater-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-less-than" -------------
+	if paramValue := ctx.QueryParam("currency-less-than"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-less-than", ctx.QueryParams(), &params.CurrencyLessThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-less-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "address-role" -------------
+	if paramValue := ctx.QueryParam("address-role"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "address-role", ctx.QueryParams(), &params.AddressRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address-role: %s", err))
+	}
+
+	// ------------- Optional query parameter "exclude-close-to" -------------
+	if paramValue := ctx.QueryParam("exclude-close-to"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude-close-to", ctx.QueryParams(), &params.ExcludeCloseTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude-close-to: %s", err))
+	}
+
+	// ------------- Optional query parameter "rekey-to" -------------
+	if paramValue := ctx.QueryParam("rekey-to"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "rekey-to", ctx.QueryParams(), &params.RekeyTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rekey-to: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAssetTransactions(ctx, assetId, params)
+	return err
+}
+
+// LookupBlock converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupBlock(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round-number" -------------
+	var roundNumber uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round-number", ctx.Param("round-number"), &roundNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round-number: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params LookupApplicationByIDParams
-	// ------------- Optional query parameter "include-all" -------------
-	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
+	var params LookupBlockParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LookupApplicationByID(ctx, applicationId, params)
+	err = w.Handler.LookupBlock(ctx, roundNumber, params)
 	return err
 }
 
-// SearchForAssets converts echo context to params.
-func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
+// ExportAssetBalancesCSV converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportAssetBalancesCSV(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"pretty":      true,
-		"include-all": true,
-		"limit":       true,
-		"next":        true,
-		"creator":     true,
-		"name":        true,
-		"unit":        true,
-		"asset-id":    true,
+		"pretty":                true,
+		"include-all":           true,
+		"next":                  true,
+		"round":                 true,
+		"currency-greater-than": true,
+		"currency-less-than":    true,
 	}
 
 	// Check for unknown query parameters.
@@ -559,9 +1675,16 @@ func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
 	}
 
 	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SearchForAssetsParams
+	var params ExportAssetBalancesCSVParams
 	// ------------- Optional query parameter "include-all" -------------
 	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
 
@@ -572,77 +1695,313 @@ func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
-	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-greater-than" -------------
+	if paramValue := ctx.QueryParam("currency-greater-than"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-greater-than", ctx.QueryParams(), &params.CurrencyGreaterThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-greater-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-less-than" -------------
+	if paramValue := ctx.QueryParam("currency-less-than"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-less-than", ctx.QueryParams(), &params.CurrencyLessThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-less-than: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ExportAssetBalancesCSV(ctx, assetId, params)
+	return err
+}
+
+// ExportTransactionsCSV converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportTransactionsCSV(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":                true,
+		"next":                  true,
+		"note-prefix":           true,
+		"tx-type":               true,
+		"sig-type":              true,
+		"txid":                  true,
+		"round":                 true,
+		"min-round":             true,
+		"max-round":             true,
+		"asset-id":              true,
+		"before-time":           true,
+		"after-time":            true,
+		"currency-greater-than": true,
+		"currency-less-than":    true,
+		"address":               true,
+		"address-role":          true,
+		"exclude-close-to":      true,
+		"rekey-to":              true,
+		"application-id":        true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTransactionsCSVParams
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+	}
+
+	// ------------- Optional query parameter "note-prefix" -------------
+	if paramValue := ctx.QueryParam("note-prefix"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note-prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "tx-type" -------------
+	if paramValue := ctx.QueryParam("tx-type"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tx-type", ctx.QueryParams(), &params.TxType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tx-type: %s", err))
+	}
+
+	// ------------- Optional query parameter "sig-type" -------------
+	if paramValue := ctx.QueryParam("sig-type"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sig-type", ctx.QueryParams(), &params.SigType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sig-type: %s", err))
+	}
+
+	// ------------- Optional query parameter "txid" -------------
+	if paramValue := ctx.QueryParam("txid"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "asset-id" -------------
+	if paramValue := ctx.QueryParam("asset-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "before-time" -------------
+	if paramValue := ctx.QueryParam("before-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "before-time", ctx.QueryParams(), &params.BeforeTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "after-time" -------------
+	if paramValue := ctx.QueryParam("after-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "after-time", ctx.QueryParams(), &params.AfterTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-greater-than" -------------
+	if paramValue := ctx.QueryParam("currency-greater-than"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-greater-than", ctx.QueryParams(), &params.CurrencyGreaterThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-greater-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency-less-than" -------------
+	if paramValue := ctx.QueryParam("currency-less-than"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "currency-less-than", ctx.QueryParams(), &params.CurrencyLessThan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-less-than: %s", err))
+	}
+
+	// ------------- Optional query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "address-role" -------------
+	if paramValue := ctx.QueryParam("address-role"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "address-role", ctx.QueryParams(), &params.AddressRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address-role: %s", err))
 	}
 
-	// ------------- Optional query parameter "next" -------------
-	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+	// ------------- Optional query parameter "exclude-close-to" -------------
+	if paramValue := ctx.QueryParam("exclude-close-to"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	err = runtime.BindQueryParameter("form", true, false, "exclude-close-to", ctx.QueryParams(), &params.ExcludeCloseTo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude-close-to: %s", err))
 	}
 
-	// ------------- Optional query parameter "creator" -------------
-	if paramValue := ctx.QueryParam("creator"); paramValue != "" {
+	// ------------- Optional query parameter "rekey-to" -------------
+	if paramValue := ctx.QueryParam("rekey-to"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "creator", ctx.QueryParams(), &params.Creator)
+	err = runtime.BindQueryParameter("form", true, false, "rekey-to", ctx.QueryParams(), &params.RekeyTo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter creator: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rekey-to: %s", err))
 	}
 
-	// ------------- Optional query parameter "name" -------------
-	if paramValue := ctx.QueryParam("name"); paramValue != "" {
+	// ------------- Optional query parameter "application-id" -------------
+	if paramValue := ctx.QueryParam("application-id"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
 	}
 
-	// ------------- Optional query parameter "unit" -------------
-	if paramValue := ctx.QueryParam("unit"); paramValue != "" {
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ExportTransactionsCSV(ctx, params)
+	return err
+}
+
+// LookupNetworkTotals converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupNetworkTotals(ctx echo.Context) error {
 
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "unit", ctx.QueryParams(), &params.Unit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unit: %s", err))
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
 	}
 
-	// ------------- Optional query parameter "asset-id" -------------
-	if paramValue := ctx.QueryParam("asset-id"); paramValue != "" {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupNetworkTotalsParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.SearchForAssets(ctx, params)
+	err = w.Handler.LookupNetworkTotals(ctx, params)
 	return err
 }
 
-// LookupAssetByID converts echo context to params.
-func (w *ServerInterfaceWrapper) LookupAssetByID(ctx echo.Context) error {
+// LookupStakeTotals converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupStakeTotals(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"pretty":      true,
-		"include-all": true,
+		"pretty": true,
+		"round":  true,
+		"format": true,
 	}
 
 	// Check for unknown query parameters.
@@ -653,42 +2012,45 @@ func (w *ServerInterfaceWrapper) LookupAssetByID(ctx echo.Context) error {
 	}
 
 	var err error
-	// ------------- Path parameter "asset-id" -------------
-	var assetId uint64
 
-	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupStakeTotalsParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params LookupAssetByIDParams
-	// ------------- Optional query parameter "include-all" -------------
-	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LookupAssetByID(ctx, assetId, params)
+	err = w.Handler.LookupStakeTotals(ctx, params)
 	return err
 }
 
-// LookupAssetBalances converts echo context to params.
-func (w *ServerInterfaceWrapper) LookupAssetBalances(ctx echo.Context) error {
+// LookupTransactionStats converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupTransactionStats(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"pretty":                true,
-		"include-all":           true,
-		"limit":                 true,
-		"next":                  true,
-		"round":                 true,
-		"currency-greater-than": true,
-		"currency-less-than":    true,
+		"pretty":    true,
+		"interval":  true,
+		"min-round": true,
+		"max-round": true,
+		"limit":     true,
+		"next":      true,
+		"format":    true,
 	}
 
 	// Check for unknown query parameters.
@@ -699,103 +2061,91 @@ func (w *ServerInterfaceWrapper) LookupAssetBalances(ctx echo.Context) error {
 	}
 
 	var err error
-	// ------------- Path parameter "asset-id" -------------
-	var assetId uint64
-
-	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
-	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params LookupAssetBalancesParams
-	// ------------- Optional query parameter "include-all" -------------
-	if paramValue := ctx.QueryParam("include-all"); paramValue != "" {
+	var params LookupTransactionStatsParams
+	// ------------- Optional query parameter "interval" -------------
+	if paramValue := ctx.QueryParam("interval"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "include-all", ctx.QueryParams(), &params.IncludeAll)
+	err = runtime.BindQueryParameter("form", true, false, "interval", ctx.QueryParams(), &params.Interval)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-all: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
-	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
 	}
 
-	// ------------- Optional query parameter "next" -------------
-	if paramValue := ctx.QueryParam("next"); paramValue != "" {
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
 	}
 
-	// ------------- Optional query parameter "round" -------------
-	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "currency-greater-than" -------------
-	if paramValue := ctx.QueryParam("currency-greater-than"); paramValue != "" {
+	// ------------- Optional query parameter "next" -------------
+	if paramValue := ctx.QueryParam("next"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "currency-greater-than", ctx.QueryParams(), &params.CurrencyGreaterThan)
+	err = runtime.BindQueryParameter("form", true, false, "next", ctx.QueryParams(), &params.Next)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-greater-than: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
-	// ------------- Optional query parameter "currency-less-than" -------------
-	if paramValue := ctx.QueryParam("currency-less-than"); paramValue != "" {
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "currency-less-than", ctx.QueryParams(), &params.CurrencyLessThan)
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-less-than: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LookupAssetBalances(ctx, assetId, params)
+	err = w.Handler.LookupTransactionStats(ctx, params)
 	return err
 }
 
-// LookupAssetTransactions converts echo context to params.
-func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error {
+// StreamTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamTransactions(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
 		"pretty":                true,
-		"limit":                 true,
 		"next":                  true,
 		"note-prefix":           true,
 		"tx-type":               true,
 		"sig-type":              true,
-		"txid":                  true,
-		"round":                 true,
-		"min-round":             true,
-		"max-round":             true,
-		"before-time":           true,
-		"after-time":            true,
+		"asset-id":              true,
 		"currency-greater-than": true,
 		"currency-less-than":    true,
 		"address":               true,
 		"address-role":          true,
 		"exclude-close-to":      true,
 		"rekey-to":              true,
+		"application-id":        true,
 	}
 
 	// Check for unknown query parameters.
@@ -806,26 +2156,9 @@ func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error
 	}
 
 	var err error
-	// ------------- Path parameter "asset-id" -------------
-	var assetId uint64
-
-	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
-	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params LookupAssetTransactionsParams
-	// ------------- Optional query parameter "limit" -------------
-	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
+	var params StreamTransactionsParams
 	// ------------- Optional query parameter "next" -------------
 	if paramValue := ctx.QueryParam("next"); paramValue != "" {
 
@@ -866,64 +2199,14 @@ func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sig-type: %s", err))
 	}
 
-	// ------------- Optional query parameter "txid" -------------
-	if paramValue := ctx.QueryParam("txid"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
-	}
-
-	// ------------- Optional query parameter "round" -------------
-	if paramValue := ctx.QueryParam("round"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
-	}
-
-	// ------------- Optional query parameter "min-round" -------------
-	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
-	}
-
-	// ------------- Optional query parameter "max-round" -------------
-	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
-	}
-
-	// ------------- Optional query parameter "before-time" -------------
-	if paramValue := ctx.QueryParam("before-time"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "before-time", ctx.QueryParams(), &params.BeforeTime)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before-time: %s", err))
-	}
-
-	// ------------- Optional query parameter "after-time" -------------
-	if paramValue := ctx.QueryParam("after-time"); paramValue != "" {
+	// ------------- Optional query parameter "asset-id" -------------
+	if paramValue := ctx.QueryParam("asset-id"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "after-time", ctx.QueryParams(), &params.AfterTime)
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after-time: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
 	}
 
 	// ------------- Optional query parameter "currency-greater-than" -------------
@@ -986,36 +2269,18 @@ func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rekey-to: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LookupAssetTransactions(ctx, assetId, params)
-	return err
-}
-
-// LookupBlock converts echo context to params.
-func (w *ServerInterfaceWrapper) LookupBlock(ctx echo.Context) error {
-
-	validQueryParams := map[string]bool{
-		"pretty": true,
-	}
+	// ------------- Optional query parameter "application-id" -------------
+	if paramValue := ctx.QueryParam("application-id"); paramValue != "" {
 
-	// Check for unknown query parameters.
-	for name, _ := range ctx.QueryParams() {
-		if _, ok := validQueryParams[name]; !ok {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
-		}
 	}
 
-	var err error
-	// ------------- Path parameter "round-number" -------------
-	var roundNumber uint64
-
-	err = runtime.BindStyledParameter("simple", false, "round-number", ctx.Param("round-number"), &roundNumber)
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round-number: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LookupBlock(ctx, roundNumber)
+	err = w.Handler.StreamTransactions(ctx, params)
 	return err
 }
 
@@ -1043,6 +2308,7 @@ func (w *ServerInterfaceWrapper) SearchForTransactions(ctx echo.Context) error {
 		"exclude-close-to":      true,
 		"rekey-to":              true,
 		"application-id":        true,
+		"format":                true,
 	}
 
 	// Check for unknown query parameters.
@@ -1246,6 +2512,16 @@ func (w *ServerInterfaceWrapper) SearchForTransactions(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
 	}
 
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SearchForTransactions(ctx, params)
 	return err
@@ -1256,6 +2532,7 @@ func (w *ServerInterfaceWrapper) LookupTransaction(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
 		"pretty": true,
+		"format": true,
 	}
 
 	// Check for unknown query parameters.
@@ -1274,8 +2551,20 @@ func (w *ServerInterfaceWrapper) LookupTransaction(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupTransactionParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LookupTransaction(ctx, txid)
+	err = w.Handler.LookupTransaction(ctx, txid, params)
 	return err
 }
 
@@ -1298,14 +2587,23 @@ func RegisterHandlers(router interface {
 
 	router.GET("/v2/accounts", wrapper.SearchForAccounts, m...)
 	router.GET("/v2/accounts/:account-id", wrapper.LookupAccountByID, m...)
+	router.GET("/v2/accounts/:account-id/changes", wrapper.LookupAccountChanges, m...)
+	router.GET("/v2/accounts/:account-id/rekeys", wrapper.LookupAccountRekeys, m...)
 	router.GET("/v2/accounts/:account-id/transactions", wrapper.LookupAccountTransactions, m...)
 	router.GET("/v2/applications", wrapper.SearchForApplications, m...)
 	router.GET("/v2/applications/:application-id", wrapper.LookupApplicationByID, m...)
 	router.GET("/v2/assets", wrapper.SearchForAssets, m...)
 	router.GET("/v2/assets/:asset-id", wrapper.LookupAssetByID, m...)
 	router.GET("/v2/assets/:asset-id/balances", wrapper.LookupAssetBalances, m...)
+	router.GET("/v2/assets/:asset-id/stats", wrapper.LookupAssetStats, m...)
 	router.GET("/v2/assets/:asset-id/transactions", wrapper.LookupAssetTransactions, m...)
 	router.GET("/v2/blocks/:round-number", wrapper.LookupBlock, m...)
+	router.GET("/v2/export/assets/:asset-id/balances.csv", wrapper.ExportAssetBalancesCSV, m...)
+	router.GET("/v2/export/transactions.csv", wrapper.ExportTransactionsCSV, m...)
+	router.GET("/v2/stats/accounts", wrapper.LookupNetworkTotals, m...)
+	router.GET("/v2/stats/stake", wrapper.LookupStakeTotals, m...)
+	router.GET("/v2/stats/transactions", wrapper.LookupTransactionStats, m...)
+	router.GET("/v2/stream/transactions", wrapper.StreamTransactions, m...)
 	router.GET("/v2/transactions", wrapper.SearchForTransactions, m...)
 	router.GET("/v2/transactions/:txid", wrapper.LookupTransaction, m...)
 
@@ -1314,171 +2612,237 @@ func RegisterHandlers(router interface {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/W/cNrbov0LMu0CTfTN22mwv0ACLizTZYINNu0HsdoEX5+FyJM4Ma4lUScr2NM//",
-	"+wPPISlKoqQZe+ymt/NT4hE/DsnzxfPFz7NMlpUUTBg9e/F5VlFFS2aYgr9olslamAXP7V8505nileFS",
-	"zF74b0QbxcV6Np9x+2tFzWY2nwlasqaN7T+fKfZrzRXLZy+Mqtl8prMNK6kd2Gwr29qNdHs7n9E8V0zr",
-	"/qz/EsWWcJEVdc6IUVRomtlPmlxzsyFmwzVxnQkXRApG5IqYTasxWXFW5PrEA/1rzdQ2gtpNPgzifHaz",
-	"oMVaKiryxUqqkprZi9lL1+928rObYaFkwfprfCXLJRfMr4iFBYXDIUaSnK2g0YYaYqGz6/QNjSSaUZVt",
-	"yEqqiWUiEPFamajL2YuPM81EzhScXMb4Ffx3pRj7jS0MVWtmZp/mqbNbGaYWhpeJpb11J6eYrgujCbSF",
-	"Na75FRPE9johP9TakCUjVJAPb16R58+ff0dwGw3LHcINrqqZPV5TOIWcGuY/73KoH968gvnP3AJ3bUWr",
-	"quAZtetOks/L5jt5+3poMe1BEgjJhWFrpnDjtWZpWn1pv4xM4ztOTVCbzcKizfDBOorXJJNixde1YrnF",
-	"xlozpE1dMZFzsSaXbDt4hGGah6PAJVtJxXbEUmx8UDSN5/9d8TSrlWIi2y7WilEgnQ0V/S354LZCb2Rd",
-	"5GRDr2DdtAQZ4PoS2xfP+YoWtd0inin5slhLTajbwZytaF0Y4icmtSgsz7KjOTwkXJNKySues3xu2fj1",
-	"hmcbklGNQ0A7cs2Lwm5/rVk+tM3p1U2geehk4brTfsCCvtzNaNY1sRPsBghhkRVSs4WRE7LKix8qchJL",
-	"l0Zw6f0kFznfMAKT2w8otWHvhEXootgSA+eaE6oJJV5OzQlfka2syTUcTsEvob9bjd21kthNg8NpCVWr",
-	"mQxtX28zEpu3lLJgVMDmOS1lQYtihF8WBeGGldopNZY1wgR5YKVzkrOCwSIbcQC/aqPkFhavmW0nK8Py",
-	"hayNQ4qNLOyAeg4ngsPi50j4FDKjhTbUsEGFKF7JxKILXnLTX+4P9IaXdUlEXS6ZsgfueauRRDFTKzE0",
-	"OY44gaglvVkoWYt8B5XDEKlilq4rlvEVZzkJowzB0kwzBQ8X+8HTKEIROH6QQXDCLBPgCHaTOBRLXPYL",
-	"qeiaRWdyQn5yvAW+GnnJRGBBZLmFT5ViV1zWOnQagBGmHlf2hTRsUSm24jd9IM/cdlj6xjaOAZZO+mZS",
-	"GMoFyy1vBKClYcgrBmGKJtxXxVhSzf7zr0Pytfmq2CXbJllmFwFwOeFOs7FfsO/4KsIMEyS5Ix6uZBf/",
-	"RnFvJ7yDRgsk+oQMtV8dS0jfH1v9d7hBxnNrvl7gzz2U4utzK3ZWvACR9IvFJL8NtbYsuL0RXkhpvhbU",
-	"1Iq9uBB/sX+RBTkzVORU5faXEn/6oS4MP+Nr+1OBP72Ta56d8fXAZgZYk9cw6FbiP3a89LXL3ITlpqbw",
-	"n1MzVNQ2vGRbxewcNFvBPzcr2HW6Ur/N8EIzNHPqzvFOysu6incya93Bl1vy9vUQdsGQY1wDKExXUmgG",
-	"VoKXKCw/uN/sT5YxMAF8L5J3p79oCfpcM3alZMWU4Sy2edj//odiq9mL2f86bWwkp9hNn7oJGxXaDDF8",
-	"RHNqHKEjgTvSZ8oysLKqDaptKRoKSP8xwNadszkWufyFZQY3qA3GE1ZWZvvUAuxg14fbLfg/6DF77JsD",
-	"mSpFtw+8jygCFyDK+iP/ZNUty/8quuYCFj4n1xsmSEkvLTugQpoNU8SeBdPGC0NUIFE+BmONk6hOqTyZ",
-	"pSgmcab63ofanNohzrVpO3miUdNHpYZDbZc+7H7tQQvtnTvSA9BDvJP3pQl7DfqeFlRk7BCnvHRD7XzC",
-	"P3DBAYh/4FXseMz+mMNWHuKID0HAdpxJgoVGjyvyYcpDbJI+1C7tweD8fh1xPpzlvTH++0Jml3c6y7Gj",
-	"glEnZv4Ho4XZvNqwB5g/GnsCivPmEnEAjH5QTIzuO1Prj1Y1oei0h90TeaJp9Je+e18OHbe2fHf21zrT",
-	"LhPc/Yz1fod86+/N8cU44RN0/nsu0Hpl7+TUEOpcXGj8uRAX4jVbccHt9xcXIqeGni6p5pk+rTVTTrk6",
-	"WUvygrghX1NDL8Rs3pUdQz598GI4aKp6WfCMXLJt6hTQvdIf4eLiIy3W8uLiEzHS0CKyM0dOF2cfbC7R",
-	"fZTDCRYWM2RtFs5ZuVDsmqo8AboO1kkYGb0/Y7POiRsbjajOGerGT5MBrSq9ACv9Asz06eVXVWGXH2vP",
-	"aNon9siINlJ5EynXHho43x+lcWZHek0Qv0itmSb/XdLqIxfmE1lc1M+ePWfkZVW9s2OeWTj+25kMLT1t",
-	"K3Sb7HnraQZLKQmwcDjPBbsxii4qumY6uXzDaAWnv2FE1yV4lIqCQLeWt6NScq1oCSZv3SzA78fwASAc",
-	"u8myaIWwuDPs5V306SXAJzhCaEM2rHDG9nucV3T1uPNxTVxfRoICLi4+gr/fn0zwD64pF9pLBc3XwhKB",
-	"c6UuGcmsFsDyE/J2RYCrzVvdXUCP45iBdXCN3k9ybtcIpnOSUQFe0SoHLyEXhIpt1wypmTHe6PuBXbLt",
-	"eWR53zPswDnZ6IRIzGs7XBCLzQmTa6pJKcEgnTFhiq3z2yVQMw1MzYVBF0SGvtGFxd8hpgFUE7lnLeHE",
-	"LMSN0UXEyFtJq4qsC7l0nCag6IuAo77PMFN5bwHQB2AoybuG34YR2quoSmwEEuLAFtxhoXa8e5Hh6PLu",
-	"jHIrrjT4hBl1MoLGJHIHzHMO6z4o/94w0MqkIkKaDkppT9IppA8erfmsosrwjFe7WSdx9PetPnaQKdGe",
-	"FOZy1ZXZPZGaFCHYeLGkOi2+mf1iMbDWGMxg1+gZnZ8JtWVYwQmBQEhHqssC4htC7BWeMVUQeOGXjbFI",
-	"Q6Cl6YIp0ehUHoz2jsTK24ZqH4MBoSqeReyk5gwg77ndAEBgSzcR9sZ6K7fzFuyKDu3/sDPwrcgt72C6",
-	"HY8SXH1erHTJfx4c0Bhj6l2C3g/onX+z+V6OvPnM6nh1+jikAB3PUtcaF46NPaI40L7S0QFZOP61WhVc",
-	"MLIgPKzWwGoxfkhmHINoGkp0czB7BfgLsdhmB9h5hBQaR2BXUhY4MPlRxrQp1vsAKRgHbkL92MBWor/Z",
-	"DjaZEOzrLheTl4A+72iIaN74xfEY+ze34H5732VjyftZqxXBJkt334jEVQpFLWvK7AVf6BpiyIzMZHHS",
-	"u5hpVjDg9IsWZ13YS1hSp2OAhme+W3RpI0/4yqpYTyNWrtiaa8OUu7ADhCG0oImc2BpmIaPGMGUn+r9P",
-	"/uvFx5eL/0MXvz1bfPe/Tz99/uvt07/0fvzm9m9/+3/tn57f/u3pf/1H6v54JQ1bgLhbXNEi5bW+uPho",
-	"G73RoIq/AcmYZD+trSIY5McHDBkw7SXbLnJe1OnTdvP+87Wd9sdwe9X18pJtQcgwmm3IkppsA1KoNb1t",
-	"MzJ1QScX/A4X/I4ebL274ZJtaidWUprOHH8QrOrwkzFiSiBgCjn6pza4pSPsBW6er1lh6HjwOdgULMM0",
-	"9GTMZtMjptyPPaZ+RVAMc14cKbmWtht6eBVc5OwGwhy5iWI6dW9Fu6rLYEtEbhpNY29nboQHV4vj1cWq",
-	"sRslrRu7j/dYXn/4XZc3wF5oVfH8pmOcwgNLsw84vX1ufXh97CEYEI4bbAK5IkNUP1zMSMW8MQ2pJVJH",
-	"MPBZxGvrk1ETervbwXgB7iKBZR2UqM40D4aArB8j7NaewkWyUrIEyuvfgiLk5AP6fQsFG5HTmdWlMvXx",
-	"xTJPCLGftMczWvyTbX+2beFUbW8MmuZiV5JprjvQk3Bh5AGO5n6WxRTmuxEnMP99ILYk1kPOC1p3Wo6C",
-	"PQmAVpWSV7RYOPvrEKNQ8soxCmjuzbWPLNPTZ3X+95fv3jvwwdLHqEKL/OiqoF31h1mVFW5SDdCpT9Kw",
-	"1zJvFusKEWd/5bpls73eMBduH11arLh2yIVU3tjjI47gbLgrr9ztaZF1rgNc4ogLgVXBg9CYftCB0HYa",
-	"0CvKC29z8dCmORMurnHb7M2c4gHu7XyIfEiLg7KbHnWnqWOCE8UzjKQBlJhKool04f7hsgQ3JDDgAIKW",
-	"dGvxBj1ffZYk6nJhiW6hC56lrXJiqS1KCHQo2cYEGg/cteyIlqGnx6p5NJZtpneI/ukAGc2R3EwfwzS0",
-	"d0vpPN614L/WjPCcCWM/KaDFDnlaavSJaHfWoxNmZ0xYe0RNGibcR4d2iVX3WlwY5S6atFWO+5O6U3Pr",
-	"CWd3HyXaDjWkPgMQ4xp07Bvsgfs6GKs8FgWnJhUtN8oeIQbxjD0tYyQ8wBGfYxW14M7FeofTmc6z9tq6",
-	"S8BLs4tBUftyWMza8fcQsI08BcBiSYo5gbTQMjFMLa6pMD6z0O2W660ZWhZtr2uptIFU1GTQzF7XjThj",
-	"8V6XDL1YKfkbSxvZVhYPrvvTRxNj7/TgO18WOpxh4NIQTmYYUaaQMeR83hekcMm8N1Bd7SDY1ZsyAx73",
-	"4+MaZDBDV5ToI2kH4gwIMeA1kbsXbnTeRUEFMpdXULig5QBNs6g4QusUx29YlIO5bwig10uaXaZvCham",
-	"l02QQ8uZYiTxnUNeb/u8TkgULxHacg04XjFVctMWeQ2h3lXr/6Oxo4yXtEir/zns/nlLocz5mmOKcq1Z",
-	"lKLrBiKV5MIgFuVcVwXdYhhJszVvV+TZPOJv7jRyfsU1XxYMWnyNLZZUg2LW2Hp8F7s8JsxGQ/Nvdmi+",
-	"qUWuWG42LvdbSxJuZmAqCd7LJTPXjAnyDNp9/R15An5bza/YU7uLTt2evfj6O0hrxj+epQSaK2Ywxn5z",
-	"4L+e/afxGBzXOIZVFdyoaX6M5WiGOf0INWHXXWgJWjrhME1LJRV0zdLRUOUETNgXThPcPp19ETmWTwDF",
-	"knCTnp8ZavnTYkP1Jq0LIRgkk2XJTWkJyEiiZWnxqcl6xUn9cFiLAXl9gMt/BCd5RdKGsMd18WECaWrV",
-	"EMrwIy1Ze1vnhGqiawtzk93uGGJygxXTTF2lJ1EDB+zVC9eXPBFSLEpLO/lTx8/a+JeMQZaGFunwR8+7",
-	"utGv40PvqmPYURaDG1u3NpZGPOnOW1yr9Dppbaf66cM7JxhKqVjbLrn0obUtEaOYUZxdJSm2G4cdNJMg",
-	"LvzOpxQUTJvowQo/x5ANXXOkvLxkrOJifbq0fVCFwFG7ysOaCaa5Hibs9cZuj/1sSTG6lcLQZMkKKdb6",
-	"8WnSAz7gIFozwKC3r6eg7g3si1AsoOnwxth2dor3vmgFDm3bP/5uRAFXkwk5H1zb4fgoy3QwwvaVi4dF",
-	"933blYLrvaZgq2QiR3EDZLihXAwETTGWDwSAMJjxTCrD0YnM2O8QzmF4ybShZZVmimC8Q0oEqraAhi5W",
-	"S9IskyLXRHORMcIqqTdTaTwD4ec3AiYruEbWFxcmzKTCUgUgAYzspFjsGgA6mkzShnGhpDRDgIKoiLOA",
-	"pDSE1mbDhAlhVwzqI3VXgiGioAmhwo0si/xg2bAv8kCLYjsn3HyF40BgB8iFkqnLghGjGCPXG6kZKRi9",
-	"Yk0xLBjtK03Ob3iuodRVwW54JteKVhueEalypk7IG1eoBLQz7OTme3ZCXHC8Cxs7vxGwvFwyVN3ideIy",
-	"fZxfsCfHK54TKYpt72eoIKVZccX0CTm/lgiEbhKKtBWGrR7L2mBgbc5XKwZ0CssBpQ76NR8imKCsFxQX",
-	"C8O6Nf0O1HYjFqDNDCi3Bm9QN+IVNiIuGrVtpO+QRomatEeoguVrpuZo6oFt5yVrEsisDiGVaS6SK4ZB",
-	"mpazcWGUzOuMYdrSWQsfI7B4D6RQ6SjKCwAc8lXVGjj9JdDzVHtRgEvXM7wHCtleIZwdu2KKLO0tqxno",
-	"CTKdCC5tqII4cwbZELhUlj9NM+e6Wiuas918S8AEf8IeId3Gj3Al9xvgZ9u+qza1dJOWxE9L6ShQ0kqZ",
-	"mJeneNmg6vVhKHr5DRaLU6zAsFKoMwZt5z3FasXYQnORtsqsGAPeTrOMVRad4zqyjFlGhXomsArId/Gy",
-	"1Z6wMPyKYcDriDKwyGiR1QUGdo1I+uuMFqptyi7YykiLYHF5wcZUwe1cSwgswxJfOJ+yDDDqYSnKounW",
-	"tUAt3lfUssShOv7Xfgj5omBXLK24M4qR5P+Q1/aSuw1nYadowJgjvQCpBMhRVwHnHp72T+6CEYGPxOSw",
-	"bhxIexQDm5vH51wxxWXOM8LFL8xRc2BLHmOwsJ4Uhosa6hEq1sCNcoJAUHw38L2PAWootc9+aEeFCnbd",
-	"Ou080ufaMZTa0EuGYPvwfScadz1TxTTP6wETi6JZG7L9kNER7wdq2KkKR6sPhJcdDhWIfIzourjcQZvO",
-	"afV3aZBPtZjvLsyKhoBt4hh1IqzM5Qz7lgN3H2mktw/4nLkw9hVTuh2wFJlS2M3E2LZFa3zMpFaygri1",
-	"/WdZ+FACPTjfFtlxg3Ne+cKkF+jPnC87sYMDaeYBAH3NTbZZDMRo27bYwsLwoXvT6k+JKgRQIVutWGZ2",
-	"gQGCfbFC5SAU+NlC8ZrRHLIzmrhtjNjugvLkR0ns0DrSa4TmoIU2ag2M8nSP8ksBQ6aQ/2e5I+5fSfgf",
-	"uG52IAOvyLizTxupsI1Dnibph5It07AroQBiRCOV1LRIW579pDkr6HZsSmjQnjQott74jjKHWhlmBQq7",
-	"YVk9EEcYTe3obGxy26S74ECefaqIi/p1T/LvSkkVl4zoOOMEYbYF8WX58FYj4bvPQg9Zte0DtN+iEPNm",
-	"zpJpTdcsXTY0xkXfMIWCf7+ixUAc/AdWKaatpksoOf/7y3fOOTIUDZ8NJm9Q4zKzDCWDaZO3c7ippXkb",
-	"xhrBd1cwOmkZHYovwvAi+7nX+25e26HyItGG+nC1PkD/9CG5pKLcef6aVID+zrr0kH7Czi5hvc0Bdxfh",
-	"ki5gkNRK4qIzfYwmG/iM6egBr/dA33y5CMGCqeKs8xmQTLugSP/e3bH0cL0o+VoBt0yPOkw2kRlxgru3",
-	"YO9M2szgx0ttbq/2WWKHNS+rAt1NTkewEj3uRfbKSWkigB4+oOzQsSoPHm3C7uwAOnyQyV1hmc7eHA8o",
-	"+Zd4JcuqYMOMvEJHIRapR1kNmcE0z7mTZd64I7OsVo3Vrxsy8jMtOBYP1pAdLKSs7L9WJgr7H0jvkLXB",
-	"/zOq7H+wVkX7f4hVUSqxHWoG58LFzFWdkLXxgbczqyTkeEVxfVOpxndMEdvJXN0XEglWNhry2xLOcDIF",
-	"GtmbMGZLlfBlDV/iaGmCgIDbWvu/NMmZYaq02vJGXpOyzjYQIEzXzMcLgy8eTLWdiVqj+7Cidty780jq",
-	"imY4EIZqFFStmSIueoK40ochBKOkvFPCvOs2hsszTQnOqSjmfuF9UHOiWOZEsLQH45JtT1GKw+93YBzD",
-	"IdEDgEFg9AOCdK/46jhEfwJfL1sKEBaeaWU1BPAPqAhZ+Byt7akI9ZMPdl0erAPIodasv87d3Vvx3iZY",
-	"RbO2XbX4/uYOK99muYvyna4gYbuD9o8b4qu6JO5tj6W74zrdGG7e5Km3yxN2X3YBpqShkJZ7eiWTZSkF",
-	"mKeKouMbFDmB2BYNb7EIwsQVK2TFkq1hk3YIq9R8LVhubgTGRZzBn+c3ItU2Fr/QOlpeqhxd9LbW3eo0",
-	"duoOYXgrvnt11xGbANRmRP/k2t1HfINRcmFEGGrF1H3GPHdj7FACbC0UZlZhmKh76cK5PfGEO0/5+UxL",
-	"XxrMh4MGPy77taaF81ML8AqfQ0hkdskEVv0KL44ZSZjQtXJuYQsrjGdBccPIWOjqpsld638txmrqKDCZ",
-	"B2u8C4qC8F7satWB3B6OHK8pZNtzsV6MZD1kkPbgGvq0NrBzjZZ3soNbJFQly3fMiY29YpDa4/uP5D5g",
-	"abLmgbt00kv0AIzoZ5CTJ29fPyVQHmIoUT96GXJ62XGtsN0gwqjbHizdJKd9oFgxNuSK7ERvkBUbEDZT",
-	"VU5WV02BE2jVNR9PQrljONo/qIaKJa65c5t/oTFoLSDd+yT9oeKkzL2rYMxnayXrdMjSGhOFv4dXhAgT",
-	"mcQXlwwjoAhhII3e0G+//ub0m2//k+R8zbQ5If+GTAbUgvr1k9qnSXhTl6lV6I0AYCETENUZFy0Rzblx",
-	"B9qLiuEuagKGefwTTlYXiFYHz1/2ewmjKDK5hVytkgmU/4LfGzOK8rxPsf7u7sD98KWdO0rff+IzPbfz",
-	"2URZn+IqVPS5G4EXbKhcXXGTQNPn3ywaTD0h72xvwsRKKnvLLGtjZS08ouftfDH2YMS9aUp3QrC9+I0p",
-	"CZdoQaS9M3dlDY82GyIxaAZ6sHbhRBaGkCkZYo+fnIHWMEcgn+IdLfFOcC0MRzXDbuPP0S5WlsFboP+9",
-	"4UUCCyppv+sYjjkRkmBR6rglxs01mSMIswtcbiHS45JTnC2ep21EFhMgZuJdVKmjuaFnGyqaKrvtMh8Y",
-	"5ISOrqhyWQcn93lRqM1ju9dHIQeiK4QrQGV1ZEhvCIaWx93uim5LJswdmcJ77I2BG/gI5rgSqgaUUN97",
-	"qpzl0ON2dmz7MaTXBW0fTGrIiKI1zgdU7+ZBU1e6t1GfELmslFrVEPwXxUt6k5q7VQTT7CXbEuXNBHGl",
-	"PNTc76Doo8RIvx98zkvWqMaoS6SkMN9JWrhnuJNXK4z8Rm721chywjDjWKEHsAL7juNEOIU90PYs9Gk/",
-	"YNc3sGwr1vZjt6p1tgM34Zp5Ql6HgFowwWNoWRNl696A7xjqMV0uZC9yFb86i6ZIsOVfXHys0K2fIFzX",
-	"AMW8bdMX+K4JzVbrUPM7YTvwzW5WTDXtUvd333Klfmsa9k0Hvlm/XHyL88wP8TZgmobcMS9ggkSQ1qx9",
-	"d5ljMaNWMbzwMH2Dcw36TBi6RivKuVgUMO5Hwqqlp+ySDBzZPzEluPnhFS2K8xuBMyUiDJrn9FKuKSzS",
-	"6LIMAte0rNV5p7wxw1FsbEinWWY1kryJYozg/EqTbhUX92hwr45LSzDvyTUTJf4D/lG1Hlw32DH6WhPP",
-	"CFXrukTb78Ovb2IFgwXweO4SnPpV3JwmFD2XL5VLbeArl7cyVEFix6pa+DQCPEHaaFxNYOUAps+trs4q",
-	"l98sxSILjlMru+D9bEku0OF4MTshbzEMWjGaIxNV3LBUfafW+iE38JoVBZiNEaMX4XSjEnAnlopa9bM0",
-	"YLZi8AJC1wH7B64YRitdD5zYEFdywVatQ/odTuiVncmNFA4po0JI8wc6pz0rhnXegInCBKoqlA4rmPBP",
-	"EaEuDMMOmO6kYnwtxt5tWFEvCHT3uJLioM2lXPpVfPC6JyWCinw3JgoGeRwMy7PTfCFFsU1x1zjVrsNe",
-	"w16MPt4Qku90E1qi3Sqj+hO7LdGzmffRCgGx4Yb5/rDru0OBt3tXdesM0OIaU31b8TMjT3di5k976CnN",
-	"LHJ+jWpmWAyhsAtH/qTYwstPz7FEjnUS6iYc50K8JL8xJd0FMgxlCaIxmbq8cZcvepLoFIqa6F637pR7",
-	"Fo3BxY9oh4OFpy4uPt7QnpYBMN1Dv7hbDbHJM34zULQjPmPvQXFVOu5ZjQdnHNnYoafJLi4+rmied6o6",
-	"xCE6yGRC7RXcbVe9BJCFXg8UChk9zdXoaY6M30oquPY3wJFHJfyNEdM3rv2OY49U2OJwCF5T36k/9S7E",
-	"H3zKO6GGvwXfFzn8rCPoMVJXjpZwJ3sZSoY64GSA74Q4FuL8r/535W0rxcpzM++y8U7Fzqse+IwrKWl1",
-	"0Kp1k8wjgnjYFc0GHdFNqo4TzH68qAoBDNB4vLtvh9zvOSI/evoE4Ws3QYPGVUSal8kUKyG7qLliJg7H",
-	"lVwKamFTCwud++CLj0OIdTRDvNeEvLUj0+KabrW3nTaINTyc31WsZZKw28Xph2jwTe+NysCJ9IFlvOLw",
-	"2FqbCwYcH7Y4Djx2h5ZLy3QwL4pfBaOFiyGmTRGztqPI+4lcOSYaCei522ZatK0FOLC3Dts2r/zYfkXh",
-	"SCN5tsPzMYnidmFLJ3ie8+SNMjtnOtyXx2EvZHI4zTB3E923Kgb8JMI2sof2A1WXLRlIdfuhKQyWb43a",
-	"UjGiEPc7vD3jvAvvm+dBIGQ32Pp/ZgqdfR+oyGVJ3tQCseDJzx/ePHUP0Hok8wn5FvkcJF/wszSr/rM0",
-	"icdZ7JYc6kGay/x3epCm6D1Ic/eV7v4UjcetoYdofHA4+pPWXBuVMBE//gs0Y2zG+wbH+YxzY+zLaFw3",
-	"5DRuprspUqhHDTzca0LNoo6IvJc60nrGjhp8k1q7eniNWtIOyWsqU4oQWRdZ3CdD9trjDTwZ4DQSmAQK",
-	"qCXeRNPuVT3PhaP3U/HZEKyoWURqwqoWue5sYVPFfsR5OKolOCXBtxn1Qw6Jz11l5lnsZWxDAl48F1wf",
-	"Xu/rPlQBVQ6xniG8oIiP93VLATVb6Z4NTyStFnLNM422in3dne9839v5rKwLw+84zg++L/pf0xKTg4fx",
-	"zFCRU5UTln/z7bdff9cs9wtjV/1NSsaduGU5cxw1PGtrfGF1OzAxf5Qna9lnWYNeKbVujPTBCzWHuqxN",
-	"VNR+ziQAJL3eaLE+umG5JTRCdWkV3MLw5qe5/W1D9aZhne1nlKmgxPGrbjQX5FH8Pg+VRESxuFdUQYc8",
-	"hhhHQyRfAm3E7BHxYVeW+EPESfqlZ90S0UBp8cUnl8FeVwWzul3DA/t0k6ltZeSpPxoU+X7OM94vxx+P",
-	"l951aAA1K6XVRDBX3CqTjcYFV+kGqjtUy+vtz1kMV6qU3kYxbSFKh6Js1MXFp7SyiSnMae0y3el2z7M9",
-	"6+xpe8dx3wY13OoSgXhcWp7AgccHqb/ntxAIvAJtLJPC0Az0Rix5O3vpTEszV5h2tjGm0i9OT6+vr0+8",
-	"3ekkk+XpGpIGFkbW2ebUD4QvisSpta6Lq3ZnuXCxNTzT5OX7t6AzcVMwfJuY3YB9K2DW7JuTZ5iRzQSt",
-	"+OzF7PnJs5Ovccc2gASnWLZg9uLz7Xx2evXNaRxUsk4+EMOoyjZ4EXBtTyC7mOHt5m0eGr2R6qUfzjkI",
-	"8J3FFx+HHsOwJGv//rVmajvzFZpjg0njtuqTx3TeKF7oNUYvmlph5GhixoKX3Ow5XVPUiK5ZNNsJ+Umz",
-	"qHKgvIQAfFQWfZixL3wXOg0AZodIwdUgbD/lEdfsFFUIbaPCW5jXkHICzgERxUyetKpyOZOkK6/uShhk",
-	"W1KLwmoH3swO3jEdlgYF2zC7P6NuB1yuiw/YdO+2pxbqJ1k4CBcWwj1P5C0GlMLNBkRB9NC4v/g4DJ2H",
-	"cgyxf3zePM3jDNJzEgocdCypc+ff9s839l9FRO/50IJd9OuCFkVqmZFPZb8TLtyDDF/o8dop7nW2PrIt",
-	"clu6Vxhgvdq/KH/JtkPANEmJw5Q1Ga82/nkIfM+RvLe4qamPxeqghG3FFAwpMjBYa8BMb+NCruoDFnKu",
-	"6bJgUGoMLrAtb/cg8oUKm3ucQFz2YZh1d/38IzN8goL5UDkHBNA3z555KeuMUtFop79oVJ+aAYfjI/dJ",
-	"Dkipeb5+2WiCYyg9i34FPFewE9nJajPse70xC5AK/ZF/0i6aq6JrLlzEAph6SnoJFh2BaSIuYMhTp89n",
-	"taImWLudcHIYs4PFpZHe7Q34lNSK2pA/gcCBp6hCUXsx/jjToBfMPt12tI3Tzz5WjOe3g6rHOykv6yp6",
-	"sb6pmN/TQLCtO9Hvt4CeoxpIsIx5agdktopShMsByFm8UUbVbC+JvCvtH5BW/2dKwgdhGHuwiQdkC2lS",
-	"PBglFkAfE5R42i1mvwtZdr0bI3QZl5afos+jLt9JJ7ezrPiNwy3vrs5kpzyQgHKVvpZiEgpwe8Fge6s/",
-	"aMAc0n7C18/JiX0WRjzpAVJJUtvG1+fbyu5DAcGdv9jd8vhTN26ZwH99slCwR0Aij+ZrsgjWcftLiT+B",
-	"xeWMr+1PBf4Etl60dKXWrvl6ePEaupX4jx1vp0U6OowW0jZzL7cuST19Fmnl7IsUYn5KaohUUc3oZuqS",
-	"uyrNQ9OHBgcBAQvsdmGgNxMw+Ab76t0PYizprixaEz5BYnhp742O0VBBPrx5RZ4/f/6de+nLagyILkML",
-	"xiExETIGLjCMnJrweRf28+HNKwDgLBgQd2o1eagBow61chjxy1v4n9g09Ke0mfyelxxctVPtnS6MmeHj",
-	"6knIH3/Em8Cf5Lrff63q/q9LDRR+93KuNeHBLi/RPXUnx0Xcfth30W417r84tCnsz2rK/lNe8w5sxuhQ",
-	"w262z3Z9wqP9s5Oj9oA20GiS089tHjFtC21XT03aWpomaTtoSgfocqpJPeBoejwUze5JqY9ngnwgw2NI",
-	"1p2U2tByLNgAh5oQ1UdB+ieyl74Bgx/a+3ymt5cGeLcPeU9NGHLy6hWeej7o7Hb0wdXSjrXgAPPVAl+C",
-	"T81nv+0330EMUQdmpIGd7Kb22OZHhScoPJ6DPpCqA8OffvaIMa3euNzWaUevbbi7ehPn3x0VmwdVbLSr",
-	"kroTFT6iPxWmvBeiz2d/ffbXvbZm9A2U1pNpt7e300pTREin7p2QSS8tVBjt1gS73kjAs/jFolFC85Md",
-	"Va2jqvU7+gOP7ov/6e6Lgwnvw0q1mNvupGf23rc7qpz+/ZlGljykgSGWlftENbUK58UlZUY10WNg0zGw",
-	"6RjYdAxsOgY2HUOQjiFIxxCkYwhS8z6OKLZNFFCvLHFcXcQCGtXciFm+K8g/hOqhzOAjJXO9kuWSC9Zo",
-	"wX4FTXqWke6Z4PZrBL4hFPfzrq6JdS2ULAbkqy92H0qkzGe+rj9VVs/dRd62VuMBhAIx0fxxrdy91gZ1",
-	"3MBMQ3zoF+KysPtcFFti3Cto1CqDfiVzwldkK2tyDcRS8EvoD28EYTxZiVWs21lxUAKvHvSxuO6LUPVv",
-	"yvLz8NbkY7zcMV7ugePl4JUZffoZ36XBi+ekLya8xZe69X5vP07ddBENcLp07GkM0OPad8bODxd3x73e",
-	"ydgQRTmMp0+FWIejheFoYThaGI4WhqOF4Zg6dbRbHO0WR7vF0W5xtFsc7Ra7Raw8rq3hj1Y46GjN+PKs",
-	"GfPZtwe80Y8G23WjVlvlfz9bbX86bpXYi1HRe4skZTo5bz2lOh286q4bu2fm/oGI424PWu+Ddl9WiOcj",
-	"YnVjl4KXNNSVR7F2mVJ2Q8uqYFChdGZRx/UPBU4zWZZA+eEXN3L0i6Og20+3/z8AAP//Sw0AvDP4AAA=",
+	"H4sIAAAAAAAC/+y9e2/cOLIo/lWI/h1gkvNr2ZmZ3QNsgIODbLLB5mzmgTgze3Enc3Foid3NtZrUkpTt",
+	"nrn57hdVRUqURKnVbcdJNv1XnBYfRbJYVazn74tcbyuthHJ28fT3RcUN3wonDP6P57mulctkAf8rhM2N",
+	"rJzUavE0fGPWGanWi+VCwq8Vd5vFcqH4Viyexv2XCyP+WUsjisVTZ2qxXNh8I7YcBna7Clr7kd6/X4aO",
+	"NtOmEGY4+Q/wM9Mr5jaCGWHr0tklu9yxQqx4XToWBmDcQANXGyUKJhXjRWGEtQwHPnun/p1d8pKrXGQw",
+	"A8tYyc1aWBd+ZitprFsycZuXdSHVmlVC4b9G3HBT2LDyf9bC7NqlE+DxKoWqt4unvyzi+Ra/LlOrJxgT",
+	"y1bljkkFkAjmDFeW5/DJshvpNsxtpG0WKBXTSoQ9ihqzlRRlYc9GAA+Tjx/QcnGb8XKtDVdFttJmy93i",
+	"6eKZ7/d+72c/Q2Z0KYZrfK63l1KJsCLRLKhBTeY0nDM22nDHADpYZ2joNLOCm3zDVtrsWSYBkTomKxSd",
+	"oBG5kNf458oI8ZvIHKCIGzm7lRMmc3KbWNorf3IeYRm2xTWu5bVQDHqdse9qwD7BuGJvXj5n33777Z8Y",
+	"baMThb9uo6tqZ4/X1JxCwZ0In+cc6puXz3H+C7/Aua14VZUy57DuJPF41n5nr16MLaY7SAIhpXJiLQxt",
+	"vLUiTamewZeJaULHfRPUbpMB2owfbEN1cq1Wcl0bUQA21lbQ3bSBdlyJ3egRNtN8uBvoSdDx5DUMMIO8",
+	"8i2ygB51pV+JuB5MQaMh05fwUqy0ETNvITW+12sYz/9R72FeGyNUvsvWRnAkDRuuhlvyxm+F3ei6LNiG",
+	"X+O6/SH5vgz6Eh5f87KGLZK50c/KtSY8gB0MCBImZrUqAR9gNH/PmLSsMvpaFqJYAs7cbGS+YTm3NAS2",
+	"YzeyLGH7ayuKsW1Or27PNW46AVxH7Qcu6NPdjHZde3aCRBqR5aW2InN6Dy8OV5urgsXcs2XM9jDOzN5u",
+	"BMPJ4QNJJbh3ChC6LHfM4bkWjFvGWeDDSyZXbKdrdoOHU8or7O9XA7u2ZbBpeDgdoQHkzrHtG2xGYvMu",
+	"tS4FV7h54dINt8xTfhuIZ6WVFUyoXAPpX7KtJywknT0FGvkPqxXLGGdWqnUp2H9f/PA9K3Reb4Vy7JHH",
+	"o8fQdGvXFc+v4tbhp9ABmqnCj6nETQnnUYhSbiVsJgy+DOfQiCJGMAvbvRUFQXb5D5E7VgnDsD/H9ew8",
+	"wecFWxm9JSznjl9yK0Y21m9Uio4DiIvlwsMPXRDqNE33Ym/Gy3KCAZclk05srZeSgdfiiRYNb17CVgjE",
+	"qla+wF+tM3onCrpzdsl05USR6drRL2yjSxjQLvEK0LD0uR2IlTrnpXXciVEJO17JHizDMxsu9zt+K7f1",
+	"lql6e0mMOpyj054dj01OI+6hDFt+mxldq2KGDOuYNjEPtZXI5UqKgjWjjMHSTrMPHqkOg6eVrCNwpNoD",
+	"jlTzwFHiNnEoQM3gC6v4WkRncsZ+8sQcvzp9JVRD80Gogk+VEddS17bpNAIjTj39dlbaiawyYiVvh0Be",
+	"+O0AgkptPMcJVCnXynHppTkEWjtBxHkUpmjCQ2VWIBz/8YfF+31fjbgSuySP6iMALad5JCMNpr7Tq2hm",
+	"2HMlZ+LhSvfxbxL3ZuEdNsro0ieEFvjqSUJaHdPpP0MhE89t5TqjnwcoJddvgc+vZIkywD8Ak8I21MCj",
+	"ehsRpAIr14q72gjkgVauWcYuHFcFNwWxOvzpu7p08kKu4aeSfnqt1zK/kOuRzWxgTb7rsduW/oHx0uzG",
+	"3TbLTU3hbsdnqDg0vBI7I2AOnq/wn9sV7jpfmd8W9EIemzn1iH2t9VVdxTuZd5Q6lzv26sUYduGQU1QD",
+	"bxhJKqh2ekbM8vmGq7Wwb/wn+AL0QSgkfxHbO0e+/fT3aIrK6EoYJ2nAnEaCP5E/wx//ZsRq8XTx/523",
+	"Kshz6m/POwAAAfAQc2P4rn3ZuDG2QJeBO08OoncsuxEGyNy2qh1J031sJwKfIaEejvyTFQXe7oqvpcLV",
+	"L9nNRii25VeA7FxptxGGwfUS1gVST/IoDtrqtjy/8DLq2SKFD+01/aXZxv76W0QiuY2OtAv4I7Gt3O4x",
+	"rM/v7hsgefdyul/GYXg2dDAW4z4Pkbh/tp1NbOY69mjvfKheYJ65xg98J3t7FWC7n3tg72+3DsaNE23r",
+	"nendiVt7avdxrm3bvScaNX3Q23Bf22Xvd78OuAvdnTvdB7wP8U7e9U5YK9yfvdL+Hk456P9nn/B3UkkE",
+	"4q+kRDkdczjmZivv44jv4wLDOHsvLDZ6WJaPU97HJl047j55qdcCkLOOAZezV5qk8Y7ZLntfSHUAPwjo",
+	"dSIRDerfmUD8udT51VFnOXVUOOqemf8qeOk2zzfiA8wfjb0Hiu+Fu9Hm6q12vPzkr79DKPctvrOkvSTA",
+	"j3kg2lw4fiX+lTYtWtAH2rK3rV7uk9+yFtR9+xatav++RW2P37zPglHDn+aal/O5W3+FKUb36Wogeyfd",
+	"Lv/4cz6pIA9QQUZX6yiUO1gP2ZnwoEN+H0wOsU0h4Z9HH5hUZPiTWsFJce+ORXazd+qdeiFWUkn4/vSd",
+	"Krjj55fcytye11YY/7o9W2v2lPkhX3DH36nFsi+NjvnXwhEEv+aqvixlDp56qVMgV6DhCO/e/QJmzHfv",
+	"fmXIOyITfeQg5E2rrRZziHI0QQaYoWuXecfBLDgdDya2jWEXR8bek7MumR8bf+w5NaevAa8qm6GDQ4Ye",
+	"DunlV1UJy4/VF+QVgZ4izDptgnVZ2gANnu/32nmLLb8JDii1FZb9z5ZXv0jlfmXZu/rJk28Fe1ZVr2FM",
+	"IKDif7y1Fe7TriIXnwPVTu1gKWqMC8fzzMStMzwDE79NLt8JXuHpbwSz9RaOAJxSsFu8J0AG1oZv0VvA",
+	"tgsI+zF+AATHPOk4WiEu7oJ6BXfZ9BLwEx4htmEbUXo/hTucV6T7Ofq49uiPJhx03737BX1vw8k0vmxr",
+	"LpUNXMHKtYJL4N3+wBsC3hWiOGOvVgyp2rLT3XvIeorZkA5pyVOPvYU1otcBy7mCAeuq4M67yqpd34Jr",
+	"hXPBXo5Go7eR08KBLsD8msuSX5Yi8xqmhBtRSxtiyrflO/JUXsa0hF/qazpt8JPxY6bR1LtG8T3cuKhh",
+	"JQ1HbpGL3XDLthrdCHKhXLnz3laJ6dL7UEvlyHEkJxfCDK7OGL3CCxt5McKdjamXH6N/ByIfM15VbF3q",
+	"S0/kmtvxtLkeoc84PfsRALD3QMuSipOwDRPXvuImsRHYYWwLjlgojHcnCjC5vKNRDj3R4RwF9+yJx7fz",
+	"CMzzboZDUP6+ESgQasOUdj2UsoGapJC+8UNaLkpwgbFyPdPM+Do09950+2lCEwAVZMPgqtbID8HFyaOI",
+	"JA9JchDG3+M7JF2DQ9qQayXKAHrJuOtQ0VwrK5St0THa6VyXaSqzBb+g+TvwXWj+frmouHEyl9U8sx71",
+	"/7HTBwbZJ5IlhTC96staA1EouVpqnKGjber2CvgC17e25DANa2xDOGgmeuXgCs4YBpP5M7os0Ye6iV+h",
+	"C8INOneHZav1FGhpoiKMamXhAEZ3R2LWs+E2+HkXy4i+zhJPR24++GXiJ0TY6OrH7w0J85bimo/t/7j/",
+	"2ytVAJYL2/V5b7zbgjjQp51JT3BLfm5b2/q7LZYH+a6Rqr9OH4dWKJsDaVrTwqlxQBQP2lc2OiCA44fV",
+	"Cr3AMyab1bqNv7jcWp1LctRvyZifQxQYBQTYBgPMHiGFxhHYldYlDcy+1/HdVOtDgFRCIinmYWykydH/",
+	"xQztfBMw6R+Fex9vQ9rRXqLlIrbY1KkXd+O38qyqvINcEum9sxjb8oJutxp6quP5C0BN3jqoDiz6Pqzu",
+	"UNGr8ZCfwQNbGkDdGLxMtWF56RmyszHEaaZIHvtSHTRf4EVO97lWehKcP6PNLWbNFO/0ldhRxJD155Pk",
+	"70NXADiCKVSwVrhDkSGOaphGgRBwByw8xYC+F46Frw0t2RJJ7z5f5iKPn1KqqSmlutcpowjSY1A96Z7+",
+	"LDxpkO8E3ua58pZLfHB6uFFrqCskYrp2Rzz66O4cgf5421atOJdGfWmzldG/pdSg34sbRt88ogNuXe5a",
+	"PKPY6Q94b8fAThlT99ymQy+Sh2gPFU2w5Od+MKdjuhMTDDv/cdRnCikVycjzL4ajE+t08OwRHTpMRQMI",
+	"BJ+1kb+JJsJwySQxau0Qo2RHTkFFAfrJgngFMXBOM+msKFdHXJ42McMhZM73YlKxLchMMIU9gvqE2Q+j",
+	"eMnZY8l5XGKex74PupJwHFOPVn9U88Yc4MIUw5x4hbyhD6ziEqPxY4DvemiTygYfWMV9GJInHEfMMv72",
+	"gEszfHC0rw3RUdR82OcFwNJ5WIe3ReAEKPsYsZbWGZKv3qnocYF/qvBXX6rfK4aP+cQsF30VSJKsl0Br",
+	"HUO1CmxTo58HHZjCnWofbkbk2hQiCoLtJGORDg/DZ2Xh7aBDtoCfkk81fKj9GQPgKHhYFOztX569DoDB",
+	"WG2g3M4JGJs7JwwM8H8e/dfTX55l/5tnvz3J/vT/n//6+x/eP/73wY/fvP/P//y/3Z++ff+fj//r31I2",
+	"Lz9xtuF2MwT5r9xuAlXyLZf+Ckjb15pzjDM0PG+V5o0N9COtr4dOdDC9RU9g13eRNmoUu4LKChUx2qIp",
+	"0yPY9igEw8D4MCoF1LdkAPc5cMfo0YtvYr3CJ9WQyg5xlCyhWYh8Ga7O1pftrK3dFPkQZQJZsssOJnfE",
+	"iofF4r5I4jZGWBB30ua8DerEWytuvFbb6EbTOqNrYaxXMA4GvoZhG3zwLWe4wcaHEQPfzjaBpD/29Z7J",
+	"t0qnFaMml96wHBkHUjotOPC0/raLUlaUAnE563AMWFZyt6xAcngRukVYxh5JuEC7x5HinJiMMN4zAyFs",
+	"5PKHRbdr7USGxoXsmpcyjWXQ6KVFm+tLaJrWV3aZK2UekWOoB9NCQHMhy9qNIqF24m8vYNrvYwQH5IKT",
+	"FDzfsEvuciTs3emhzcTUJd+74Ne04Nf83tY7D5egKUxstHa9OT4TrOqRhKnLlEDAFHIMT210SyfIyxuR",
+	"3PNnXvRtFAxDyZ54YGQA6ZILJW6yiafj20i4aC35bQy+HzfKCoFccdn5SM9GeGDK4bvyiNekLovDYW5Q",
+	"q4UrSq0xB2qlXYD8CKAn3zONMQnux7RtdDSM/dWLOcOMIHoXDfpb3GrOceoJPEVXmBeidHw6Mx0psgpo",
+	"eDblRDYg+kUYe48fcoBi3KRAIyXX0g1MHF+FVIW4RaOsdFFCJDtY0VwjOqopietH0+Dbn0b44MbyeHWx",
+	"wdyPklYQ+I93WN5w+LnLG2GDvKpkcdvzlqMDS18qPL1DfEHIqWSAYEjg/WB7kCvyjBum/nDaiI5dJraz",
+	"0etX9S0qPaRrjETzDiZlJVpNGG7uHwHFqBUthYv0gsOn1sC8H2vWRwzXHRRsRaPerD7P6RBfgMmjtWmv",
+	"g7Dg5d/E7mdoi6caq+XnXpkDbWpzj+Zuro4pzPcj7sH8H5vLlsR6WJj3+ep4Lh94AXgFDuG8zLymYYxQ",
+	"GH3tCQU2/0hqkvRZgV7qRw8+ao4FN+QiPLkqbFd9NqsC5qb3iHKoWwmOTn0m4h1Ce+qwm43w8l30uAZ2",
+	"7ZGLbnnrINyOF5xKV+ERcqC0532ZvYZr3KdZVI1Lc+vThJ17XsyNzynp2QnaNGWixbV+5AcTp3iAO3tD",
+	"R07t2b2Sm8HtTt+OPZQonmEipduW0gJapokat1orfMnDDISg4OF7KbwrfuK5VW8zuHSZLWWedjdTl9br",
+	"xWB4aMyw8YhOAEYEgp4eq5bRWNDMzlCE9YCM5khuZohqH9u7S+3Vz7WS/6wFk4VQDj6Zxp8xup5wG4Nl",
+	"+2g5OuGMStleH1CSxgkPkaF9ksw7La4Z5YjloXA8nNSfml9Pc3Z3EaJhqCA+g8igTeIqvCGnwVx0vCeC",
+	"mdEnWcVsbEumweES9wDEJfqGeySdb3PG/nLLc7ifLt8Iy3BS73n/zTIkbAxf6fevO7yy0PVlGWWVJhIw",
+	"lIJwF6efAHG0xWDdLxqtcOtI5MNEIkXOgUFb8YxpF6S0cNTV0NdK+qCVO/oApbPIh4P2HhIjERBjssKz",
+	"cTkBxj9AQmgFAgQsFgUoQS0vrU4MU6sbrlxIc+t3y/e2glT40OtGG+swEXUyDPGg91LH0ewur6Rx/6N3",
+	"735ZAR7cDKePJqbe005Ih5K2kVdPczLjiLIPGZsExHcFqXkl3xmovnjTeLy2RRQC7sfHNUpgxt5Y0UfW",
+	"DW0c4cJIa6IoFqSxwXmYKyIulJy7o3RMk6iohT2n8VsS5WEeajL4DeiK008dgOlZpO2O3ZydZqFzOBjb",
+	"Pa8zFkWgNW29X1QlzFY6l1L43uHZ8rmRo1xufRD+YPML3P23HYm4kGvpbKjO0VoG/ECs0jJ4ZBbSViXf",
+	"UWBeuzWvVuzJMqJv/jQKeS2tvCwFtvh66d20rMC1dcUEScEfQrmNxebfzGi+qVVhROE2PhG51ax5WqKu",
+	"p4kruBTuRgjFnmC7r//EHqFfmJXX4jHson8vLJ5+/SeMCqL/PEkxNJ+Cfor8Fkh/A/lP4zGGlNAYUTmR",
+	"ND0mh9FxSj9xm6jrnLuELT1z2H+XtlzxtUjHl273wER98TTRvtrbF4WNvGTMpEvPLxwH+jTiggPkj8AA",
+	"B5OtdFg+wGlm9RbwqU3BTJOG4chxhGh9A1f4iOErFUtr8h7Wlk7ZjFOrxiCj7/lWdLd1ybhltgaYW484",
+	"TxBH8rtaYa7Tk5iRAw7ihe/LHimtsi3cneKxp2dd/EtNjAFSyWldoF39fALTQ8+VMWCUbHRj687G8ogm",
+	"Hb3FtUmvk9cw1U9vXnvGsNVGdBWrlyFZQYfFGOGMFNfJG9vPbNFIJg27CDs/KqBchDxsfR0wd9I6mTe2",
+	"a88vDVd2hRo8zCpSq6AI9nzMZwEJ+eAT7lZ3CUOQJq9L9JfM8Ex2abZPqLSVqrb9uIlwhAGbI6+wD2lR",
+	"OVAPAXfO1yjsOaE216OJZQz3kzO4mL8JoyMtZipyIGJ744EJiYlSAQmxK7bbaNu+RgCQiTRWUweXpAHi",
+	"aBLgAs5OrbJpFJxae5s55pZT1inSgum3wjGEoQ3GfDpmuasNRu/52D0vpzAC+c5vluipMrwvLW5Fpx/O",
+	"JN6rZnEp0kFZ8QbLxp9jojamIdH66kqISqr1+SX0odcHjdqnF2uhhJV2XCZYb4Cywmfg4pFGDodml6LU",
+	"PtLjYdl5AHzEOL4WyHxevdgHdcJRmYqpZNh0fGOgHUzxo2/vh4b2D78bUfzC3nyLPqRhwmMH5BVKd/Lc",
+	"h9Ub8uFRw60ElSyvKqEK0QRI5BsuRxx7rBDFiJOmwBkvtHGIzgx+efiddHIrrOPbKgmlQ8MF3UQUCADQ",
+	"pguTAHWuVWGZlSoXTFTabvblzhvJBXSrcLJSWtfQUN+B5dpQyQ3iDbqX72pu3NVkZq8ujJnR2o0BilJm",
+	"1PiN1g499YRyTSw1RfX1V0JJM2AVXsYhksW+06YtVgL13IC6f0XjACgkUm6FuSoFc0ZA0TjgkKXg16Kt",
+	"ooejfWXZ21tZkEt5KW5lDoazaiNzX2qSvfR+c/iwo05+vidnzGcq8vzk7a3C5RVa0KsvXicts3Xx9ra0",
+	"eMVeld//GX7YWlFeC3vG3t5oAsK2kQ2Wb3s9LmtHqUYKuVoJvKe4HHwPYr/2QwQT1gPEqoTNsH5NH+G2",
+	"3aqM5Nz0u9iR8uVWPadGzEtLXQNl72ps6REeEKoUxVqYZRQNIbeizeYHrFsb1+qgVgI3CimbVM7oos4F",
+	"5ZC76OBjBJYcgNRU7GphIxwK5RhbOIP+KNBU0DGgPPuEVEhKd1eIZyeuhWGXQqhooEdEdCK4rOMGvlwK",
+	"uGF+qaJ4nCbOdbU2vBDz7OpIBH+iHk3uszDCtT5sgJ+hfV/W6sgmHY6f5tJR9gMh4J+Wlqdo2ajo9WYs",
+	"GPAlVZk0gqQ+qpeHbZcDwWolRGalSit0V0Igbed5LipA57jAthAUuApPVLL6AXcNvBVOWDl5LSiLxYQw",
+	"kOW8JAFVq2yC09/kvOzFqZRi5TQgWFyXtNVySpjrsg4BSGE+AwQw6gE3CtB051uQAkCq9nKYnu/JMC9M",
+	"VoprkX7zC07pYf6qb0A/tmvOAqZowVjSfcGr0kBOsgo6NtBp/+R1ExH4dJk81k0DCUcxsrlFfM6VMFIX",
+	"MmdS/UP429yQpYAxVCBSKydVDYSGGdHCTXyCoYd5PzZ3iAFmLM8ifOhGbihx0zntIpLnBoGZV4LA9vOE",
+	"p9bcMzXCyqIe0c4annchOwwZ/eV9w504N83R2nvCyx6Fai751KXr43IPbXqnNdylUTrVIb5ziBVvgqqY",
+	"J9QJl1qfwDW0HHn7aKeDatH3aMcexqO1mAnbOz02tOiMDz/A4BX67B4+SxbcqOzofDthuzgXhC/KZIX9",
+	"fd6N1A6O5PxtALA30uWbbCSOCtpSC4DhTf+lNZySRAi8hWK1ErmbAwMG5FA4yCgU9BmgeCF4gSmX2tgq",
+	"iqrqg/Loe81gaBvJNcpKlEJbsQZHeXxAMaIwz17k/1nPxP1rjX+tMD/T/mvgP3jcGdFvUxuPPG0mL852",
+	"wuKuNPE40R2ptOVlWjEZJi1EyXdTU2KD7qSNYBvsdsRz0LsIGIq4FXk9HnATpvb3bGpyaNJfcHM9h7ci",
+	"Lk7ZP8m/GKNNnL+7Z8dXTECLthA2vmo0fg8pgZsUp90DhG+RErydcyus5WsRfRtR+IeGKRT8yzUvR2KA",
+	"3ojKCCuUg33BmHuyq45FAuWjAZbc+XRrjrOpYCl4qaVpG/lZ4neCIm1UGfOtJNdK+DzofZzydCynf7Sh",
+	"wVV3CNDfQjgCq7j0TgNtGNRwZ3044TCodk5IQ3vA/UX4wEgcJLWSuKbIEKPZBj9TbuAGrw9A3+Iyaxyl",
+	"U0WGlwu8Mt3s7nvj2KXNtnJtkFqmRx2/NpEacQ9178Dem7SdYTmRjWNQCSyxw1Zuq5Is1V5GAI4e92IH",
+	"xeO1zoMf3pn2vt3cPrij2vGGo/v3TzsWlv0pGad90bqFdGYY+5YEKPwbZ7kFNq0rx6SKc6iiW1Brzhwv",
+	"HJrM+J7pymVSTYM1kTwsOejYdGgbmzNhjOt2aOX0RsK9BsI2Idm8iq920d0Tv5g+5M3AqcP+QT3X26oU",
+	"41y7IoeSAgxzXjDD3K68KKQXXIImT+d5bVoVb9+18GcIpsfIC4v5XZXWFfyLWf7gD4xj1LWjvwU38Adl",
+	"ie/+RQgUZWuCochIiRbKMFCIMFksF9R5EchYMptTJ9nHGP6HypG9NLFeta2VSCZ+OhTXt1ql0gdc1Nsw",
+	"b6iV2E93Bhfs0GTB+DFDA/rknJGCwTahNG0NjeaSE5LoUrAm0TP0mEiUP47htBM9IFOoHNeyOuDUUpRp",
+	"CZ4f845RaZd18kLvE8VSSAb8gxJ/Hdtb3aHzCLdqk5FgWnJtuukXOq474fB9VjpsQvXCZhz2cAvb7WiW",
+	"NiVGHZnDYJZNcSjJJ+TNyZi0zgsKKWpJltA2zg42F7+s8UsczscIEHRLtOF/lhXCCbOVSrAN6ItrsPw4",
+	"bfhahIA29LVEe1pvos7owW28G5jpPc5sxXMaiFxxS27WwjDvHRuuc+Niu+VSOS5Va6vquwXCb+hkeXCY",
+	"3Xfe7aVV6uNbNAq2S0TzBTCuxO6cnlr4+xHS3XjM3ghg0PhDgnSnAMA4hnQPvl51XqmITx1sacG/x9cq",
+	"wOfv2oGv1WF07Nzl4TrwOtRWDNc53wch3tsEqWjXNlfVMtzccQ2Ju5yjIUnnz4TuqKKhDQnFSBLKtYdS",
+	"sNA6/Rh+3uSpdws39tL5aiRKFktPrUiTCjZmrdCGAKrnjgOHKhh6e1JCQcWEuhalrkSyNW7SjLAZymfk",
+	"bhU5r13gf9/eqlTb6D/UOlpeqoBbi6TZcRUse+Vy6PWQY3jQsSO2AUbtiBSIcJcRX+II7YjBAfEuYwYn",
+	"5RlFs9bKUOg/hQGRkB1SW9AJd7GjSQUSimmFcJ/G2Ub8s+YlNREKXXfeomifXwlFdbKAGvnqiEwoWxvv",
+	"uwOw4ngAih9Gd0XbpsmxydOzqWomBu2ajcnUO71j+BZ1BXGggMPR09VcoD04nU5EteYY1uobBldZNEbs",
+	"y7yFaGy2opiZtCUakGLPQ/+J2FaqqNVcwpGo7Da8vsdBsT179OrFYyZX/Y9R/Ht4WEs7Y9lxiat5EFn0",
+	"Oh/A0o/CPwSKlRBj/iI9Fzu2EiPMZl+6yNV1mykSW/VtfHuhnOkzHHL6+ubet+kTdRTuAMlevUiKAZ2s",
+	"IQenaVsu1kbXab/StakSGZtRWEdBiLwd7Yb/8etvzr/543+wQq6FdWcQMaeYl4KGlWu6p8lkWxGnU5+M",
+	"IWBNqgoSZ7xLWzTnJkrS3CHb0ru24TAPf8LJ9FfR6l69SPZSznAicplerZIZPn7A31tdtwm0z4jh7s6g",
+	"fldiZ8SxMsLfsDMMsyc/anndpEY97oKXYqxQWHmbQNNvv8laTD1jr6E3ExCnkAvLtrUDXituMXiUjDEx",
+	"9lBEpWuLXWIwpQLlLz6iFdMqFwNeI6PNRnc5nqMcbL3PJ8DQpPJoApMeXaDUsCQgH9MbbYjSrFZOkpgB",
+	"2/hztIsVEHgA+u8bWSawoNLw3cZwgIqRURnnuCU5N7eRwQSzD0zrINLDXqc4nVGR1hEBJqBj2+tucSL/",
+	"Qg9VTppspBF/Jk9U8kaIUkD3cPKQqiFdGjuoha5HXOCUz+QLMjJAum0ULQ+73RXfbYVyRxKFH6k3KaWx",
+	"PJCZFkLNiBAaeu8rJAgKAKfTY8PHJn1CI+2jSo0IUbTG5Yjo3fgRhWK3rfhEyAVcalWjh3bk1B5Uav5V",
+	"0ZhUqGqEZ5ARvg0jEw/Kcps5mYxMk1vRisYkS6S4sJzFLeiFk35aUXgOUbOvJpbTDDONFXYEK6jvNE40",
+	"p3AA2l40fTAIIBtXsOwq0XU26tRJ7HrX4zPzjL1ooh6gmfeXb0MhSKXRN7BROoTA2oU0vh1aOkgViTY4",
+	"8H4k36vExfUNiM1DmyHD9014vlo3VbITuoPQ7HYlTNsu9X4PLVfmt7bhUHUQmg0LrMetIgthxXeLILEs",
+	"lgsAGP4BgODflfltgfbTcvHrvDvkjznDCRKetIvu22VJ2TY7WcX9jYhxrkWfPYquyZTH3mEQlfttu66c",
+	"MifZS9vXp3xpf3jOy/LtraKZJo2bKZMyZbv3oWChMZJWb1Vus4bhjY0V6TzPhbVxPZAIzq8s66cZJAf0",
+	"YaLBDmM+kGomiuI3+MfNenTdqMcYSk0yZ9ys6y3pfj/8+vasYDRDsyx8FKpejUhCdPVrQ6WNKf5Mrnxw",
+	"4ViGsJlpX3lFMtpa5q3E1Xq/j2D6EmR1UYWKghCgFRwemKSCTE6zd+Qo8G5xBsFKILUawQsiokY6kUpA",
+	"2lk/5n64EWUJ/3qMzprTjat1smd+uSFhqEXMNgJu+cBx4jNOacsrW4+c2BhVCk4U8SF9hBN6DjP5kZpD",
+	"yrmiggKfyzkdmNK2m1osdu+pqrALrBQqsv1LRcluR1R32gi5Vlm63CMiyIoHRmATZdKH7KBLpXyMbHzw",
+	"dsAlGhH5OCKKCnkajApj8yKDMLYUdY3W3ievzV6MFJ0kAtdESNu4mDytMsovNm+Jgcz8GK0QERtfmD/e",
+	"7/qOyEB857TDvQE6VGNf347fWyJRccwL+0Pvk8wi49ekZGYx2VUJCyf6ZEQW+Kf/Bc4M82DVrRvdO/WM",
+	"fAnpAdkMBReiVZnS6CGo/yzRqUlaZwfd+lMemBSQFj8hHY4mFn337pdbPpAyEKY7yBdHJLl9P+OMX44k",
+	"ZYvPuFvx967ZFmnGiY1t/b6HhhJeFL2sXZ1ypUhkmtxDtNs+Ox0iC78ZSQQ3eZqrydOcGL8T+XUTXoAT",
+	"5fzDi5Fi7G7Cjrd+e/tqIbd+0m1SnOHUcy5/Y1OehRrhFXxX5AizTqDHRN5gvsU32bMmG1SbvNmPe8ae",
+	"xT7Mcaoi0q2Uq0DNgskmGBVjTAPORHxty6t7zUq8l3hEEI+bosWoIbrn8W2b8aJUMb72eXOteGujSkiM",
+	"91ZZHVUw8LUfRcfjnGJ2o2vIaobl1vV154mZOBxiP61Y2OY6jaq1d3zfoxnivYYEECBzlTd8Z4PutEWs",
+	"8eHCrlKuuoTeLo4RJ4Vvem9MjkakNyKXlRTKxcXWmnMBHB/XOKYH9prLt5sQvAqpDKhDCPTgbZLarqEo",
+	"2Il8uk0eMeil32ZedrUFNHDQDkOb52HssKLmSCN+tj8dYCp5cbOle2iet+RNEjuvOjyUxlEvInI0zTh1",
+	"U/2ifyN2EgWN4NC+4+aqWxOvU2dRrSmiSXWdk9NhSkcU8fTWhR/bOovostvo+n8Whox9b7gq9Ja9rBVh",
+	"waOf37x8zIywdekCkoWsKYI1kHzC9T1Xw/qeiSqXsCX3VdnzqvhIlT3LQWXP41c6v6ZnwK2xip7BObxf",
+	"hbxLoR6+lOcUmQm2wWk6480YhxIa340ojZ/pOEGK5KjWHTzK1AHnGRLL9VjkncSRaArKySSMTxjeEUu6",
+	"Lnlt5nHVeNZFGve9Lnvd8UZqWnmJBCfxGUAHson1IUp+xkiG8HXtKGN6GYkJq1oVtreFbZmlCePhpJTg",
+	"hYTQZtIOOcY+5/LMi9jK2IUErXh0G9tK4v1KapjFmvJV/6DKHcadeVnGtmbkditBFSSLVMwlFnf3xdoP",
+	"NXe+Dn0hYi0q+n7oOE3BeLK/pjmmRAvjheOq4KZgovjmj3/8+k/tcj8xcjXcpNSqSr8sr47jTuZdia9Z",
+	"3QwiFo7ybK2HJGvUKmXWrZK+sUJNFqnfa0xCQNLrjRYbvBugTk6E6tpQLfj2pyX8Bu56LemMaidgzmnO",
+	"PL3qe3NhHMXHqaQXXYrsTl4FvesxRjjaS/Ip3I2YPBI+zCWJ30WUZLDCrV8iKSgBX0JwGe51VQqQ7Voa",
+	"OLw3udlVTp+HoyGWH+a8kMNyS/F46V2vLz1UAIv1CT30Kpa48CndQnVEStPB/lzEcCVuodsYYQGiJNBu",
+	"A54YaWGT8kykpct0p/cHnu1Fb0+7O077NirhVlcExMPe5T048PAg7dvzOdn6U87PXKGkaq4psdMwwc+1",
+	"yDzxFdOJEUIjyO6F0ejc+ByvMwI3gv/IzOvxdleJ59SJQg+mQ9mbvJT3nWEdS8lke2OqAxzxTg93YStH",
+	"c13Gj+dZY2Hy1CQ+tPrJeIRmZQWY5NBDcJ4Z+s3L599+++2f2MWIrNzHoOaw/bHFOxhvQVjCHj4SIcK+",
+	"HP4B6eOqoCmUX61HUoSA21j6S1WVI19uvWVgzJ8+/a3iu1mZQcitLXJna53cGuc3GGu4ie8ximCFT7lc",
+	"K8dzxBaqh7J45o954auWLDbOVfbp+fnNzc1ZwIGzXG/P1xhxlDld55vzMND7Ze8kwng+nzGIcOUOqdKz",
+	"H18hAktXwsSvICQJ4W/Y0uKbsyeUc0coXsnF08W3Z0/OviZyu8EzO6fEVIunv79fLs6vvzmPPdLWyfKn",
+	"VJ9y1eqZkUsDIuBj7FXRNHqpzbM2QUVrmF88/WWsUuIC9nbxdIGlLRehfE+sbW1t3sMz3h90TtpAS67P",
+	"rjbkdp6YsZRb6Q6crk1bydcimu2M/WRFlBtaXwnVvDRDjEJIbdx0GgEMhkjB1XK7Ybw0rdm/ctEvlqtg",
+	"nlpjvBpaFlXkcH3Wybvq7Rm+9pZPUpXvWK1KYdu4ODSt22ZpmJLXJ7zgfgd8oFzw9rb+yZRYaJgk8xBm",
+	"AOGBJ/KKvNFRLYJyZJTyJWhNmuxMIeFW7FyzbAvPNtmbmhRWg4xG5Byjbfjcz7DUJlhKLZhAExkvy9Qy",
+	"I4PsYSdc+mp9n+jxwhR3Olt/gLHPgy/Rh+u1IbfPldiNAdNGNI/frL3OrtOfx8APFCm4mrQF1ygdMRYp",
+	"qITBIVUOHbhFzAwKcqKqwdupkBYS7WEyWdR+dVxlRpGvER0OOIE4Z8w46e47CR0yww+m8GLIptmpZVQF",
+	"sD18wGGi6D72JGg9YIQzcIP3aVgymIFllKTFuvAzQ3vMkgLWUimhRpaH43dWFTzp4/kSabRmIHRbBKLB",
+	"ibFkSynQ6GsStiZZUJs/aJhd6DiY0T+GbCVo8U9acMo2SjFKltRH/ZF19UxKIb3xHanHwYC3yciPhBsH",
+	"uB+wQfgPiCxtn6fHELaJ9VIgRuPcC9udhDLmS8eBeCzruJBreP2wlSzRUfQftXUNIa5bE08DUgg8anQb",
+	"GBRk5ZpljaYdftnST6i9uZBr+Kmkn1BvTFqz5F2FOCBK3zK8rRa7bekfGO+4q4kUjkyaB+NukygBOmbH",
+	"MIspcA6nAQEe6nk/AOHdDlLhwRuE/e5zf2Jwjtggguc+98e7PtZuo438TRQNj5UWLQ/kn7PEQAS0VWBk",
+	"6BiA0mb4XRQHCrsBrqCYTvBLKorlLTOxcnmr6WpL09F+N/4LSYLj58kibeuhUiKBsni/5+uMIwBpG+3W",
+	"WAnI72CwX9O6I2vspKybbaR12uweTuZ9HiR0GwQ6yu+ORiip1svGZYvstEhl/2G1YhmavtS6FOy/L374",
+	"nhU6RwMce+QlwcdEftcV+HhFrcNPoQM0U4UfU4kbLHdQCHzuiwIHBwxGHUfgCBQLagTfolgtGKmCWCUM",
+	"w/4c17PzQiiP/AXB+AjHO3IWfs9SRB9ARKqP8EMXhDpF+n9dLsJWot7mmydPgnLKO4JEQvg5jvL092jK",
+	"8ZjEQwLyU6aVUNhhMqlQU5MrEvIJx2Gy2o37O9+6DJUpw5F/sj6CquJrqXyUALpXbEnFzhWlZvBBOuFR",
+	"G3JIgYamISdep+MfWjO8HFqlV3cDEsrETkTheTjt0/F8Iscz0Mc+wliKxzio42vQZS6IXCx+fd/ToZ7/",
+	"7v/KZPF+VKH6WuurumrceuJKrwO9KrX1J/rnHT66J/WqYdSGniMVAvVvxBAaIBfxRjlTi4P0jHM1Gveo",
+	"gfhC9Hsnvvn58M0DyPEHJL9pkvchGdLnvu5ZlL5E+ruH0p/7REj7KL6vmQxN2ZYXlDlVNYzgckfGVp84",
+	"XPoqv16jOc0annsIPiHucLLFpXgkJYxPaRsae/6UIuwuPLMLQlLD0PgXjMLAb4+C4cTQPgOGFpGxQx4a",
+	"RHtOz42muGFDi++f956O6KGP6B7FBFTeHSQlhFjdoQpWryLR4UBh4Q3BcZIVTrLCSVY4yQrHyQpfBt/w",
+	"qVAPZrhIYof8dqQMcltAHOe6F0HhdD4PeT73KCXEDthzdcj96OQJ5v82Hn6PCHBiy71yEDDLSt76WxPY",
+	"UK575b0U1gQPBauTUGDYOg724WzLqYlDFtUUM7lDKtgv1ecmktmbjInRZQTkpCIT6bNI+0d+khaXL1wo",
+	"/TDxCv2VRWsi1w4nt+C67QkNV+zNy+cMg4jowjtR+Afa2IJpyBCm1AJ3b1FMM1vtPdQGo+5r5Tjip7fw",
+	"Lzg644sMW/iYFnlatbdDeymfKjtMiyeh1clsfXq5f7Yvw/5D6tC8Agc/DzsTnh7xn/NRHfeeb093Xjht",
+	"3H48orbbajqq9oMHaH0hDlgnzceJn38ubmg9qjNPDdotbXyyCvfS238gV+7TUX2kozrOrTua5Pz3Ltvc",
+	"797drZGf1Mi3TdKu3amXYp95730tnpj5iY19XmzsQIr4cN7FH5QjfL6rPu6x1BT82ftMwpZTOYdoqD1v",
+	"o9PL5Qt6ubxEoyPZHEO1qG6wcFM7YTp41je779lh9NHV8q247/lqJUcTLMC3Ay3idDlbRTqMhBiP87A3",
+	"EQdDh0Q6ciNKcc1VLiDrCs8d23KXb4QNqVicL3IPFnb/yf9o5VaW3OA8o0cV/nuvG7fliq/FXjTxze57",
+	"diOsMNdi3+y+2X3P7isF7ZmcWt379eyV7xhPe0Dt7j4/Jjv46c1rZh03Q4AIL5dkQrJCWQnZGkfvlCn3",
+	"u3cctTE+ycFWOF5wxzH98yhW+kYZNHqoHAb7l9CypkLkcsvL0dMN3w9kSqPHq8RNw6KROvlCW22CqSXV",
+	"NnHjEGGzjDoeyJs/jBPB6XnzOTxvGnF3ntIHmp/UPY26J0j4H+AFdjqYBzmY4zRwOPz574Ey7te6+cJ6",
+	"+1MqQMP5Wre4+NdJ33bSt/2LMKTZ1O4BI/hxyg9G6T/nFY+T0OXiD0/+cBAyTO3BX4zR5o1HtXk7fchw",
+	"7/erBSOKf+5zP86KmyylpRqtUQo7JIjE7HBQNskRwmQnZeJJmfgRve5PTsL/6k7CH07K3JtDO1DUGTm0",
+	"adf7KbTpV1LbHpwmOxpyVojNSZr8DKTJmEnPekd/J5VEjvtXYnKnJ3UQBy9bEeT+ZeDTOX2Uc7qDhTwW",
+	"hW2oXLZPDgbJURgWSiWA9ChNXpdUvdvWVVXuQgoRi/VnoY2MSvdDLJq7EbCVIWoP23QC7EbFaCqx9hE0",
+	"K6fUGSde+UUH4DQkYq+Sg+7ovkAJGu+TD2Z5sGV/mTqYQ3JSxG3Jf8szmUmOcUpLcUpLcUpLcUpLcRL4",
+	"TgkkTgkkTgkkTgkkWn2uKndtDodYuvK6C2nbykzKv+z6BcyJ74/XCKL+D1kZaHsplWjVL2EFbX1Lp+Gg",
+	"sNGGu4YPh4ZOh2fkSps968qMLkf4q1CkqDYiF/Ia/ySP1sxxsxZupo46Wk0AkKuCxfO3S7OHrY2BBIrm",
+	"PxYSdxAuK9jnstwxh1eqYNwyzsJKlkyu2E7X7AYvSymvsD8VvEQs3jJA4l5ZUaeZM/WoZ6vvniE8c1KE",
+	"PJzS55Tt5KRsOaXQOGU7OR3VQ2Q7uSx1fmXPf8dJMtLF7PXPxE5jiqA/w8d9yh9CA5ounUwrBuiOHOZE",
+	"4j9JEj91TwiJ7qwwDcMcdTXEbaWNm/BlO8vt9ehV+Qv2JqE4+LF5V7DmhQIn+Pzi5zP2LM9F5Qg9Ld+2",
+	"ei1uWTn0a1uyy9p53LBYUnRHoXYweJikrkJ9UQwrM19ZRitiRt8wxDwmlXWAOHrVoi7oR8/Y34FwQueo",
+	"sQUsyzeiwA//K3ulCnErTPY9UOW3SECd4bIUhuVchRcVQAE4IVVN4hxBMSQftGOdhT6/+Pnkw3dyqjsp",
+	"Tk5Odce8NfdzKCdu3bkn4+NoP2Afzy9+bmUBUhewjeCFMEgvV7os9Q3dP2Dc8BtwbbolZ2RlnAfKQebA",
+	"5eB2XvNSFpHKws/97Yef2/M/qi7OhOKXJYoxhJzEkhCaPz7MTjhhFC+ZgJZnHb5P/GjA9zvZ+Odw+qj9",
+	"LMZuQ+aL2Go6ydqjKT5X9h6vdQZ3P1ltT1bbk9X2ZLU9FRM42YJPtuDTk+ZkCz7Zgk+24I9sC/6k7Lf3",
+	"niz9pDQ4KQ0OVxqgq3lTC3BOdE/r99zqp4P+OdZPI9XRFb7m/eUiSuGCOnvMEve9cDfaXL3VjpJvTb60",
+	"T0ayL90PwhGe7Lk1XaTaa7OmZp+8Y8HDrn1eFiUKXukSGOv4lZhDXZro8FB8vM2lESiIVnBBlkyvVnRT",
+	"VIFUpeLGyVxWFGbILakPJ8MFLwCseXTmDUZ2IEy0QX6GhM4C04aykjthHf0Sxb/fp0LjRPtOtG/O/Y/R",
+	"/MuifPez8umwuyefT9jdZxYlmJKXP4+gxiQTPCiOsSNpxz3h/2Dvwqc6shpp2EoIErj7EjqkCQ6PeYFa",
+	"GewLF8lc8xIzs6BbnNdSvvIfYncUrnaBjU1H5Q9SusCYPp8L2D2ctE7mIfNLDh9QU4AA0M9yC++T8bdB",
+	"ZIcbCfPvbadQa7dp9qxd909vn7OC7+wM1hw6JflKwXezFDMn48MpevYkuJ0ENy++BJpylAu2zyIwzIXz",
+	"yaar6cta7fI/eUHzdFLjJ3WcQgAIyDxh6ALbDlyULlChml0I5dhfrmHvj/JY4qpgVqjCkmyQdFdCO8ZX",
+	"0S9fgXOTckyrXGDOIpJ8pAU02koHeMSesa/w59AYlcDKeXaPszWtvYYCTSsSfZ7Ie6rpySMetWR5KWHB",
+	"iMFb4UfkrJA210oBNb7ceYSAtUjniXIPEZh3t3jNrctwD7NXL7xa/4z9XbqNrmGRJGF6qh+KU7RSi8eW",
+	"MS0Lnd8h2TVOjlMnx6nPzHHqw/jTnHwrTr4VJ9+Kk2/Fybfi8/GtQJEtI1npQCeLoUi7bN7NrcYvuumR",
+	"eBjJV/5FPuV1MQ7kh3C/+OMDA9HzfAAAvn5AAEjgRcGbfEH4NZcluIMMvUE6TySEo3kjzXscNbSjwy8m",
+	"SpWe0tyd5P6T3H8KmDgFTJwCJk4BE6eAidOj/vSoPz3qT4/6L/NRf7LKn6zypzxtp5R6X1RKvc/YpbJX",
+	"nTneg/Pf4T29vz5zILtR3xmOhrNKCfkH/d4sPyensBP7GfVpj3DuEOoxn1p8Bn79H2UP/mUK63y+9L1N",
+	"Y/l+uSAjCRHb2pSLp4uNc5V9en4ubvm2KsVZrrfni/e/Nv1/bx7tertFltr84keOfvG8JPrFx8XGbcgm",
+	"0/kFnfl+ff//BgCe0X+6k6oBAA==",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
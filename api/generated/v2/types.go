@@ -38,6 +38,9 @@ type Account struct {
 	// \[spend\] the address against which signing should be checked. If empty, the address of the current account is used. This field can be updated in any transaction by setting the RekeyTo field.
 	AuthAddr *string `json:"auth-addr,omitempty"`
 
+	// MicroAlgos the account may spend, the amount above the min-balance.
+	AvailableBalance *uint64 `json:"available-balance,omitempty"`
+
 	// Round during which this account was most recently closed.
 	ClosedAtRound *uint64 `json:"closed-at-round,omitempty"`
 
@@ -57,6 +60,15 @@ type Account struct {
 	// Whether or not this account is currently closed.
 	Deleted *bool `json:"deleted,omitempty"`
 
+	// The latest logic sig program of an lsig account, recorded from the transactions it signs with a logic sig.
+	Logicsig *AccountLogicsig `json:"logicsig,omitempty"`
+
+	// MicroAlgo balance required by the account, for the assets it holds and the applications it created or opted in to, at the current consensus protocol.
+	MinBalance *uint64 `json:"min-balance,omitempty"`
+
+	// The latest multisig composition of an msig account, recorded from the transactions it signs as a multisig. The signature of a rekeyed account is that of its authorized address.
+	Multisig *AccountMultisig `json:"multisig,omitempty"`
+
 	// AccountParticipation describes the parameters used by this account in consensus protocol.
 	Participation *AccountParticipation `json:"participation,omitempty"`
 
@@ -85,6 +97,111 @@ type Account struct {
 	Status string `json:"status"`
 }
 
+// AccountAppChange defines model for AccountAppChange.
+type AccountAppChange struct {
+	AppId uint64 `json:"app-id"`
+
+	// Whether or not the account closed out or cleared its local state.
+	ClosedOut *bool `json:"closed-out,omitempty"`
+
+	// Whether or not the account opted into the application.
+	OptedIn *bool `json:"opted-in,omitempty"`
+
+	// Whether or not the local state key values changed.
+	StateChanged *bool `json:"state-changed,omitempty"`
+}
+
+// AccountAssetChange defines model for AccountAssetChange.
+type AccountAssetChange struct {
+
+	// Net decrease of the amount held.
+	AmountDecrease *uint64 `json:"amount-decrease,omitempty"`
+
+	// Net increase of the amount held.
+	AmountIncrease *uint64 `json:"amount-increase,omitempty"`
+	AssetId        uint64  `json:"asset-id"`
+
+	// Account which received the remaining amount when opting out.
+	CloseTo *string `json:"close-to,omitempty"`
+
+	// Whether or not the account opted out of the asset.
+	Closed *bool `json:"closed,omitempty"`
+
+	// New frozen state set by an asset freeze.
+	IsFrozen *bool `json:"is-frozen,omitempty"`
+
+	// Whether or not the account opted into the asset.
+	OptedIn *bool `json:"opted-in,omitempty"`
+}
+
+// AccountChange defines model for AccountChange.
+type AccountChange struct {
+
+	// Changes to application local states.
+	Apps *[]AccountAppChange `json:"apps,omitempty"`
+
+	// Changes to asset holdings.
+	Assets *[]AccountAssetChange `json:"assets,omitempty"`
+
+	// New authorized address, it is not set if the account was rekeyed back to itself.
+	AuthAddr *string `json:"auth-addr,omitempty"`
+
+	// Net decrease of the balance in microalgos.
+	BalanceDecrease *uint64 `json:"balance-decrease,omitempty"`
+
+	// Net increase of the balance in microalgos, including rewards.
+	BalanceIncrease *uint64 `json:"balance-increase,omitempty"`
+
+	// Whether or not the account was closed.
+	Closed *bool `json:"closed,omitempty"`
+
+	// Whether or not the authorized address changed.
+	Rekeyed *bool `json:"rekeyed,omitempty"`
+
+	// Rewards paid to the account in microalgos.
+	Rewards *uint64 `json:"rewards,omitempty"`
+
+	// Round which made the changes.
+	Round uint64 `json:"round"`
+
+	// New type of signature used by the account.
+	//
+	// * sig
+	// * msig
+	// * lsig
+	SigType *string `json:"sig-type,omitempty"`
+
+	// New participation status set by a key registration.
+	//
+	// * Offline
+	// * Online
+	// * NotParticipating
+	Status *string `json:"status,omitempty"`
+}
+
+// AccountLogicsig defines model for AccountLogicsig.
+type AccountLogicsig struct {
+
+	// \[l\] Base64 encoded TEAL program.
+	Logic []byte `json:"logic"`
+
+	// Hash of the program, which is the address of a contract account with the program.
+	ProgramHash []byte `json:"program-hash"`
+}
+
+// AccountMultisig defines model for AccountMultisig.
+type AccountMultisig struct {
+
+	// The subsignature public keys, in order, base64 encoded.
+	PublicKeys [][]byte `json:"public-keys"`
+
+	// \[thr\] number of subsignatures required.
+	Threshold uint64 `json:"threshold"`
+
+	// \[v\] multisig version.
+	Version uint64 `json:"version"`
+}
+
 // AccountParticipation defines model for AccountParticipation.
 type AccountParticipation struct {
 
@@ -104,6 +221,22 @@ type AccountParticipation struct {
 	VoteParticipationKey []byte `json:"vote-participation-key"`
 }
 
+// AccountRekey defines model for AccountRekey.
+type AccountRekey struct {
+
+	// The address which signs for the account after the rekey, the account itself if it was rekeyed back.
+	NewAuthAddr string `json:"new-auth-addr"`
+
+	// The address which signed for the account before the rekey, the account itself if it was not rekeyed.
+	OldAuthAddr string `json:"old-auth-addr"`
+
+	// Round of the rekey transaction.
+	Round uint64 `json:"round"`
+
+	// ID of the rekey transaction.
+	Txid string `json:"txid"`
+}
+
 // AccountStateDelta defines model for AccountStateDelta.
 type AccountStateDelta struct {
 	Address string `json:"address"`
@@ -210,6 +343,9 @@ type Asset struct {
 	// Definition:
 	// data/transactions/asset.go : AssetParams
 	Params AssetParams `json:"params"`
+
+	// Relevance of the asset to the search query, only set when searching with query. Exact matches score above 2, prefix matches above 1.
+	Score *float64 `json:"score,omitempty"`
 }
 
 // AssetHolding defines model for AssetHolding.
@@ -277,6 +413,32 @@ type AssetParams struct {
 	Url *string `json:"url,omitempty"`
 }
 
+// AssetStats defines model for AssetStats.
+type AssetStats struct {
+	AssetId uint64 `json:"asset-id"`
+
+	// The total minus the amount held by the reserve address.
+	CirculatingSupply uint64 `json:"circulating-supply"`
+
+	// Whether or not the asset is currently deleted.
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// Number of accounts holding a non-zero amount of the asset.
+	Holders uint64 `json:"holders"`
+
+	// Number of accounts opted into the asset, including those holding zero.
+	OptedIn uint64 `json:"opted-in"`
+
+	// The total number of units of the asset.
+	Total uint64 `json:"total"`
+
+	// Number of transfers with a non-zero amount.
+	Transfers uint64 `json:"transfers"`
+
+	// Total amount transferred, it saturates at the maximum uint64.
+	Volume uint64 `json:"volume"`
+}
+
 // Block defines model for Block.
 type Block struct {
 
@@ -426,9 +588,49 @@ type MiniAssetHolding struct {
 	OptedOutAtRound *uint64 `json:"opted-out-at-round,omitempty"`
 }
 
+// NetworkTotals defines model for NetworkTotals.
+type NetworkTotals struct {
+	Accounts uint64 `json:"accounts"`
+
+	// Number of application local states.
+	AppOptIns uint64 `json:"app-opt-ins"`
+	Apps      uint64 `json:"apps"`
+
+	// Number of asset holdings, including those with a zero amount.
+	AssetOptIns uint64 `json:"asset-opt-ins"`
+	Assets      uint64 `json:"assets"`
+}
+
 // OnCompletion defines model for OnCompletion.
 type OnCompletion string
 
+// ParticipationTotals defines model for ParticipationTotals.
+type ParticipationTotals struct {
+	Accounts uint64 `json:"accounts"`
+
+	// Sum of the balances in microalgos, not including pending rewards.
+	Money uint64 `json:"money"`
+
+	// Sum of the reward units of each account, which are the whole Algos of its balance.
+	RewardUnits uint64 `json:"reward-units"`
+}
+
+// StakeTotals defines model for StakeTotals.
+type StakeTotals struct {
+
+	// Balances of the accounts with one participation status.
+	NotParticipating ParticipationTotals `json:"not-participating"`
+
+	// Balances of the accounts with one participation status.
+	Offline ParticipationTotals `json:"offline"`
+
+	// Balances of the accounts with one participation status.
+	Online ParticipationTotals `json:"online"`
+
+	// Last round, at or before the requested round, which changed the totals.
+	Round uint64 `json:"round"`
+}
+
 // StateDelta defines model for StateDelta.
 type StateDelta []EvalDeltaKeyValue
 
@@ -788,9 +990,44 @@ type TransactionSignatureMultisigSubsignature struct {
 	Signature *[]byte `json:"signature,omitempty"`
 }
 
+// TransactionStats defines model for TransactionStats.
+type TransactionStats struct {
+
+	// Number of addresses taking part in a transaction.
+	ActiveAddresses uint64 `json:"active-addresses"`
+
+	// Number of transactions of each type.
+	Counts TransactionTypeCounts `json:"counts"`
+
+	// Sum of the fees, it saturates at the maximum uint64.
+	Fees uint64 `json:"fees"`
+
+	// Last round of the interval.
+	MaxRound uint64 `json:"max-round"`
+
+	// First round of the interval.
+	MinRound uint64 `json:"min-round"`
+
+	// Start of the interval.
+	Start time.Time `json:"start"`
+}
+
+// TransactionTypeCounts defines model for TransactionTypeCounts.
+type TransactionTypeCounts struct {
+	Acfg   uint64 `json:"acfg"`
+	Afrz   uint64 `json:"afrz"`
+	Appl   uint64 `json:"appl"`
+	Axfer  uint64 `json:"axfer"`
+	Keyreg uint64 `json:"keyreg"`
+	Pay    uint64 `json:"pay"`
+}
+
 // AccountId defines model for account-id.
 type AccountId string
 
+// AccountsOrder defines model for accounts-order.
+type AccountsOrder string
+
 // Address defines model for address.
 type Address string
 
@@ -809,6 +1046,9 @@ type AssetId uint64
 // AuthAddr defines model for auth-addr.
 type AuthAddr string
 
+// BalancesOrder defines model for balances-order.
+type BalancesOrder string
+
 // BeforeTime defines model for before-time.
 type BeforeTime time.Time
 
@@ -821,6 +1061,9 @@ type CurrencyLessThan uint64
 // ExcludeCloseTo defines model for exclude-close-to.
 type ExcludeCloseTo bool
 
+// Format defines model for format.
+type Format string
+
 // IncludeAll defines model for include-all.
 type IncludeAll bool
 
@@ -857,6 +1100,28 @@ type TxType string
 // Txid defines model for txid.
 type Txid string
 
+// AccountChangesResponse defines model for AccountChangesResponse.
+type AccountChangesResponse struct {
+	Changes []AccountChange `json:"changes"`
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+}
+
+// AccountRekeysResponse defines model for AccountRekeysResponse.
+type AccountRekeysResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string        `json:"next-token,omitempty"`
+	Rekeys    []AccountRekey `json:"rekeys"`
+}
+
 // AccountResponse defines model for AccountResponse.
 type AccountResponse struct {
 
@@ -923,6 +1188,16 @@ type AssetResponse struct {
 	CurrentRound uint64 `json:"current-round"`
 }
 
+// AssetStatsResponse defines model for AssetStatsResponse.
+type AssetStatsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Statistics of an asset. Transfers are counted within the requested rounds.
+	Stats AssetStats `json:"stats"`
+}
+
 // AssetsResponse defines model for AssetsResponse.
 type AssetsResponse struct {
 	Assets []Asset `json:"assets"`
@@ -940,6 +1215,26 @@ type BlockResponse Block
 // HealthCheckResponse defines model for HealthCheckResponse.
 type HealthCheckResponse HealthCheck
 
+// NetworkTotalsResponse defines model for NetworkTotalsResponse.
+type NetworkTotalsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Number of accounts, assets, applications and opt ins which are not deleted.
+	Totals NetworkTotals `json:"totals"`
+}
+
+// StakeTotalsResponse defines model for StakeTotalsResponse.
+type StakeTotalsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Balances of the accounts which are not deleted, by participation status.
+	Totals StakeTotals `json:"totals"`
+}
+
 // TransactionResponse defines model for TransactionResponse.
 type TransactionResponse struct {
 
@@ -954,6 +1249,17 @@ type TransactionResponse struct {
 	Transaction Transaction `json:"transaction"`
 }
 
+// TransactionStatsResponse defines model for TransactionStatsResponse.
+type TransactionStatsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64             `json:"current-round"`
+	Intervals    []TransactionStats `json:"intervals"`
+
+	// Used for pagination, when making another request provide this token with the next parameter.
+	NextToken *string `json:"next-token,omitempty"`
+}
+
 // TransactionsResponse defines model for TransactionsResponse.
 type TransactionsResponse struct {
 
@@ -994,6 +1300,58 @@ type SearchForAccountsParams struct {
 
 	// Application ID
 	ApplicationId *uint64 `json:"application-id,omitempty"`
+
+	// Order of the results, by default accounts are returned in address order.
+	// * balance-desc - largest balance first, excluding pending rewards
+	Order *string `json:"order,omitempty"`
+
+	// Include accounts with the specified participation status.
+	Status *string `json:"status,omitempty"`
+
+	// Include accounts whose registered participation key is last valid before the specified round.
+	VoteLastValidBefore *uint64 `json:"vote-last-valid-before,omitempty"`
+
+	// Include accounts whose registered participation key is last valid after the specified round.
+	VoteLastValidAfter *uint64 `json:"vote-last-valid-after,omitempty"`
+
+	// Include accounts whose min-balance is greater than the specified amount.
+	MinBalanceGreaterThan *uint64 `json:"min-balance-greater-than,omitempty"`
+
+	// Include accounts whose min-balance is less than the specified amount.
+	MinBalanceLessThan *uint64 `json:"min-balance-less-than,omitempty"`
+
+	// SigType filters just results using the specified type of signature:
+	// * sig - Standard
+	// * msig - MultiSig
+	// * lsig - LogicSig
+	SigType *string `json:"sig-type,omitempty"`
+
+	// Include accounts first used after the specified round.
+	CreatedAfterRound *uint64 `json:"created-after-round,omitempty"`
+
+	// Include accounts first used before the specified round.
+	CreatedBeforeRound *uint64 `json:"created-before-round,omitempty"`
+
+	// Include accounts last closed after the specified round.
+	ClosedAfterRound *uint64 `json:"closed-after-round,omitempty"`
+
+	// Include accounts last closed before the specified round.
+	ClosedBeforeRound *uint64 `json:"closed-before-round,omitempty"`
+
+	// Include accounts whose authorized address is, or is not, set by a rekey.
+	IsRekeyed *bool `json:"is-rekeyed,omitempty"`
+
+	// Include multisig accounts with the given base64 public key among their subsignature keys.
+	MultisigPublicKey *string `json:"multisig-public-key,omitempty"`
+
+	// Include accounts which were ever rekeyed to the given address.
+	AuthAddrHistory *string `json:"auth-addr-history,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
 }
 
 // LookupAccountByIDParams defines parameters for LookupAccountByID.
@@ -1004,6 +1362,56 @@ type LookupAccountByIDParams struct {
 
 	// Include all items including closed accounts, deleted applications, destroyed assets, opted-out asset holdings, and closed-out application localstates.
 	IncludeAll *bool `json:"include-all,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
+}
+
+// LookupAccountChangesParams defines parameters for LookupAccountChanges.
+type LookupAccountChangesParams struct {
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+
+	// Include results at or after the specified min-round.
+	MinRound *uint64 `json:"min-round,omitempty"`
+
+	// Include results at or before the specified max-round.
+	MaxRound *uint64 `json:"max-round,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
+}
+
+// LookupAccountRekeysParams defines parameters for LookupAccountRekeys.
+type LookupAccountRekeysParams struct {
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+
+	// Include results at or after the specified min-round.
+	MinRound *uint64 `json:"min-round,omitempty"`
+
+	// Include results at or before the specified max-round.
+	MaxRound *uint64 `json:"max-round,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
 }
 
 // LookupAccountTransactionsParams defines parameters for LookupAccountTransactions.
@@ -1054,6 +1462,12 @@ type LookupAccountTransactionsParams struct {
 
 	// Include results which include the rekey-to field.
 	RekeyTo *bool `json:"rekey-to,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
 }
 
 // SearchForApplicationsParams defines parameters for SearchForApplications.
@@ -1070,6 +1484,12 @@ type SearchForApplicationsParams struct {
 
 	// The next page of results. Use the next token provided by the previous results.
 	Next *string `json:"next,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
 }
 
 // LookupApplicationByIDParams defines parameters for LookupApplicationByID.
@@ -1077,6 +1497,12 @@ type LookupApplicationByIDParams struct {
 
 	// Include all items including closed accounts, deleted applications, destroyed assets, opted-out asset holdings, and closed-out application localstates.
 	IncludeAll *bool `json:"include-all,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
 }
 
 // SearchForAssetsParams defines parameters for SearchForAssets.
@@ -1100,8 +1526,41 @@ type SearchForAssetsParams struct {
 	// Filter just assets with the given unit.
 	Unit *string `json:"unit,omitempty"`
 
+	// Search the asset name and unit. Results are ordered by relevance, exact matches first, then prefix matches, then similar names.
+	Query *string `json:"query,omitempty"`
+
+	// Filter just assets with the given manager address.
+	Manager *string `json:"manager,omitempty"`
+
+	// Filter just assets with the given reserve address.
+	Reserve *string `json:"reserve,omitempty"`
+
+	// Filter just assets with the given freeze address.
+	Freeze *string `json:"freeze,omitempty"`
+
+	// Filter just assets with the given clawback address.
+	Clawback *string `json:"clawback,omitempty"`
+
+	// Filter just assets whose URL starts with the given prefix, case sensitive.
+	UrlPrefix *string `json:"url-prefix,omitempty"`
+
+	// Filter just assets with the given base64 metadata hash.
+	MetadataHash *string `json:"metadata-hash,omitempty"`
+
+	// Filter just assets with the given number of decimals.
+	Decimals *uint64 `json:"decimals,omitempty"`
+
+	// Filter just assets whose new holdings are frozen by default, or not.
+	DefaultFrozen *bool `json:"default-frozen,omitempty"`
+
 	// Asset ID
 	AssetId *uint64 `json:"asset-id,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
 }
 
 // LookupAssetByIDParams defines parameters for LookupAssetByID.
@@ -1109,6 +1568,12 @@ type LookupAssetByIDParams struct {
 
 	// Include all items including closed accounts, deleted applications, destroyed assets, opted-out asset holdings, and closed-out application localstates.
 	IncludeAll *bool `json:"include-all,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
 }
 
 // LookupAssetBalancesParams defines parameters for LookupAssetBalances.
@@ -1131,6 +1596,32 @@ type LookupAssetBalancesParams struct {
 
 	// Results should have an amount less than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
 	CurrencyLessThan *uint64 `json:"currency-less-than,omitempty"`
+
+	// Order of the results, by default balances are returned in address order.
+	// * amount-desc - largest amount first
+	Order *string `json:"order,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
+}
+
+// LookupAssetStatsParams defines parameters for LookupAssetStats.
+type LookupAssetStatsParams struct {
+
+	// Include results at or after the specified min-round.
+	MinRound *uint64 `json:"min-round,omitempty"`
+
+	// Include results at or before the specified max-round.
+	MaxRound *uint64 `json:"max-round,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
 }
 
 // LookupAssetTransactionsParams defines parameters for LookupAssetTransactions.
@@ -1187,6 +1678,189 @@ type LookupAssetTransactionsParams struct {
 
 	// Include results which include the rekey-to field.
 	RekeyTo *bool `json:"rekey-to,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
+}
+
+// LookupBlockParams defines parameters for LookupBlock.
+type LookupBlockParams struct {
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
+}
+
+// ExportAssetBalancesCSVParams defines parameters for ExportAssetBalancesCSV.
+type ExportAssetBalancesCSVParams struct {
+
+	// Include all items including closed accounts, deleted applications, destroyed assets, opted-out asset holdings, and closed-out application localstates.
+	IncludeAll *bool `json:"include-all,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+
+	// Include results for the specified round.
+	Round *uint64 `json:"round,omitempty"`
+
+	// Results should have an amount greater than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
+	CurrencyGreaterThan *uint64 `json:"currency-greater-than,omitempty"`
+
+	// Results should have an amount less than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
+	CurrencyLessThan *uint64 `json:"currency-less-than,omitempty"`
+}
+
+// ExportTransactionsCSVParams defines parameters for ExportTransactionsCSV.
+type ExportTransactionsCSVParams struct {
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+
+	// Specifies a prefix which must be contained in the note field.
+	NotePrefix *string `json:"note-prefix,omitempty"`
+	TxType     *string `json:"tx-type,omitempty"`
+
+	// SigType filters just results using the specified type of signature:
+	// * sig - Standard
+	// * msig - MultiSig
+	// * lsig - LogicSig
+	SigType *string `json:"sig-type,omitempty"`
+
+	// Lookup the specific transaction by ID.
+	Txid *string `json:"txid,omitempty"`
+
+	// Include results for the specified round.
+	Round *uint64 `json:"round,omitempty"`
+
+	// Include results at or after the specified min-round.
+	MinRound *uint64 `json:"min-round,omitempty"`
+
+	// Include results at or before the specified max-round.
+	MaxRound *uint64 `json:"max-round,omitempty"`
+
+	// Asset ID
+	AssetId *uint64 `json:"asset-id,omitempty"`
+
+	// Include results before the given time. Must be an RFC 3339 formatted string.
+	BeforeTime *time.Time `json:"before-time,omitempty"`
+
+	// Include results after the given time. Must be an RFC 3339 formatted string.
+	AfterTime *time.Time `json:"after-time,omitempty"`
+
+	// Results should have an amount greater than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
+	CurrencyGreaterThan *uint64 `json:"currency-greater-than,omitempty"`
+
+	// Results should have an amount less than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
+	CurrencyLessThan *uint64 `json:"currency-less-than,omitempty"`
+
+	// Only include transactions with this address in one of the transaction fields.
+	Address *string `json:"address,omitempty"`
+
+	// Combine with the address parameter to define what type of address to search for.
+	AddressRole *string `json:"address-role,omitempty"`
+
+	// Combine with address and address-role parameters to define what type of address to search for. The close to fields are normally treated as a receiver, if you would like to exclude them set this parameter to true.
+	ExcludeCloseTo *bool `json:"exclude-close-to,omitempty"`
+
+	// Include results which include the rekey-to field.
+	RekeyTo *bool `json:"rekey-to,omitempty"`
+
+	// Application ID
+	ApplicationId *uint64 `json:"application-id,omitempty"`
+}
+
+// LookupNetworkTotalsParams defines parameters for LookupNetworkTotals.
+type LookupNetworkTotalsParams struct {
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
+}
+
+// LookupStakeTotalsParams defines parameters for LookupStakeTotals.
+type LookupStakeTotalsParams struct {
+
+	// Return the totals as of the specified round, the latest round by default.
+	Round *uint64 `json:"round,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
+}
+
+// LookupTransactionStatsParams defines parameters for LookupTransactionStats.
+type LookupTransactionStatsParams struct {
+
+	// Length of each interval, UTC days by default.
+	Interval *string `json:"interval,omitempty"`
+
+	// Include results at or after the specified min-round.
+	MinRound *uint64 `json:"min-round,omitempty"`
+
+	// Include results at or before the specified max-round.
+	MaxRound *uint64 `json:"max-round,omitempty"`
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
+}
+
+// StreamTransactionsParams defines parameters for StreamTransactions.
+type StreamTransactionsParams struct {
+
+	// The next page of results. Use the next token provided by the previous results.
+	Next *string `json:"next,omitempty"`
+
+	// Specifies a prefix which must be contained in the note field.
+	NotePrefix *string `json:"note-prefix,omitempty"`
+	TxType     *string `json:"tx-type,omitempty"`
+
+	// SigType filters just results using the specified type of signature:
+	// * sig - Standard
+	// * msig - MultiSig
+	// * lsig - LogicSig
+	SigType *string `json:"sig-type,omitempty"`
+
+	// Asset ID
+	AssetId *uint64 `json:"asset-id,omitempty"`
+
+	// Results should have an amount greater than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
+	CurrencyGreaterThan *uint64 `json:"currency-greater-than,omitempty"`
+
+	// Results should have an amount less than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
+	CurrencyLessThan *uint64 `json:"currency-less-than,omitempty"`
+
+	// Only include transactions with this address in one of the transaction fields.
+	Address *string `json:"address,omitempty"`
+
+	// Combine with the address parameter to define what type of address to search for.
+	AddressRole *string `json:"address-role,omitempty"`
+
+	// Combine with address and address-role parameters to define what type of address to search for. The close to fields are normally treated as a receiver, if you would like to exclude them set this parameter to true.
+	ExcludeCloseTo *bool `json:"exclude-close-to,omitempty"`
+
+	// Include results which include the rekey-to field.
+	RekeyTo *bool `json:"rekey-to,omitempty"`
+
+	// Application ID
+	ApplicationId *uint64 `json:"application-id,omitempty"`
 }
 
 // SearchForTransactionsParams defines parameters for SearchForTransactions.
@@ -1249,4 +1923,20 @@ type SearchForTransactionsParams struct {
 
 	// Application ID
 	ApplicationId *uint64 `json:"application-id,omitempty"`
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
+}
+
+// LookupTransactionParams defines parameters for LookupTransaction.
+type LookupTransactionParams struct {
+
+	// Configures the response encoding, must be one of:
+	// * json - a single JSON document (default)
+	// * msgpack - a single msgpack document
+	// * ndjson - newline delimited JSON, search results are streamed one object per line as they are read from the database
+	Format *string `json:"format,omitempty"`
 }
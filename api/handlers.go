@@ -2,9 +2,12 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/algorand/indexer/accounting"
 	"github.com/algorand/indexer/api/generated/common"
 	"github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/api/middlewares"
 	"github.com/algorand/indexer/idb"
 )
 
@@ -25,8 +29,25 @@ type ServerImplementation struct {
 	// is from long ago).
 	EnableAddressSearchRoundRewind bool
 
+	// EnableExport allows access to the CSV export endpoints. Exports are
+	// not paginated, so they are only enabled for servers which require an
+	// API token.
+	EnableExport bool
+
+	// ExportRowLimit is the maximum number of rows returned by a CSV export
+	// request, 0 for no limit.
+	ExportRowLimit uint64
+
+	// Limits overrides the default and maximum 'limit' of search endpoints,
+	// keyed by limit name. Missing entries use DefaultLimits.
+	Limits map[string]EndpointLimit
+
 	db idb.IndexerDb
 
+	// rounds notifies transaction streams of committed rounds, streaming is
+	// not available when it is nil.
+	rounds RoundSubscriber
+
 	fetcher error
 }
 
@@ -50,6 +71,69 @@ const defaultAssetsLimit = 100
 const maxBalancesLimit = 10000
 const defaultBalancesLimit = 1000
 
+// Account Changes
+const maxChangesLimit = 1000
+const defaultChangesLimit = 100
+
+// Transaction Stats
+const maxStatsLimit = 1000
+const defaultStatsLimit = 100
+
+// Account Rekeys
+const maxRekeysLimit = 1000
+const defaultRekeysLimit = 100
+
+// Applications
+const maxApplicationsLimit = 1000
+const defaultApplicationsLimit = 100
+
+// Names of the search endpoint limits, they may be configured by the server
+// and API tokens may override the maximum.
+const (
+	transactionsLimitName = "transactions"
+	accountsLimitName     = "accounts"
+	assetsLimitName       = "assets"
+	balancesLimitName     = "balances"
+	changesLimitName      = "changes"
+	statsLimitName        = "stats"
+	rekeysLimitName       = "rekeys"
+	applicationsLimitName = "applications"
+)
+
+// statsIntervalDay is the interval of the transaction stats, a UTC day.
+const statsIntervalDay = "day"
+
+// statsDayLayout is the format of the next token of the transaction stats.
+const statsDayLayout = "2006-01-02"
+
+// Orders of the search endpoints, results are in address order by default.
+const (
+	accountsOrderBalanceDesc = "balance-desc"
+	balancesOrderAmountDesc  = "amount-desc"
+)
+
+// LimitNames are the names of the configurable search endpoint limits.
+var LimitNames = []string{transactionsLimitName, accountsLimitName, assetsLimitName, balancesLimitName, changesLimitName, statsLimitName, rekeysLimitName, applicationsLimitName}
+
+// EndpointLimit is the 'limit' used by a search endpoint when none is
+// requested, and the largest 'limit' which may be requested.
+type EndpointLimit struct {
+	Default uint64
+	Max     uint64
+}
+
+// DefaultLimits are the endpoint limits used unless the server overrides them.
+var DefaultLimits = map[string]EndpointLimit{
+	transactionsLimitName: {Default: defaultTransactionsLimit, Max: maxTransactionsLimit},
+	accountsLimitName:     {Default: defaultAccountsLimit, Max: maxAccountsLimit},
+	assetsLimitName:       {Default: defaultAssetsLimit, Max: maxAssetsLimit},
+	balancesLimitName:     {Default: defaultBalancesLimit, Max: maxBalancesLimit},
+	changesLimitName:      {Default: defaultChangesLimit, Max: maxChangesLimit},
+	statsLimitName:        {Default: defaultStatsLimit, Max: maxStatsLimit},
+	rekeysLimitName:       {Default: defaultRekeysLimit, Max: maxRekeysLimit},
+	applicationsLimitName: {Default: defaultApplicationsLimit, Max: maxApplicationsLimit},
+}
+
 ////////////////////////////
 // Handler implementation //
 ////////////////////////////
@@ -87,6 +171,7 @@ func (si *ServerImplementation) MakeHealthCheck(ctx echo.Context) error {
 // (GET /v2/accounts/{account-id})
 func (si *ServerImplementation) LookupAccountByID(ctx echo.Context, accountID string, params generated.LookupAccountByIDParams) error {
 	addr, errors := decodeAddress(&accountID, "account-id", make([]string, 0))
+	format, errors := decodeFormat(params.Format, errors)
 	if len(errors) != 0 {
 		return badRequest(ctx, errors[0])
 	}
@@ -111,7 +196,7 @@ func (si *ServerImplementation) LookupAccountByID(ctx echo.Context, accountID st
 
 	accounts, round, err := si.fetchAccounts(ctx.Request().Context(), options, params.Round)
 	if err != nil {
-		return indexerError(ctx, fmt.Sprintf("%s: %v", errFailedSearchingAccount, err))
+		return dbError(ctx, err, fmt.Sprintf("%s: %v", errFailedSearchingAccount, err))
 	}
 
 	if len(accounts) == 0 {
@@ -122,7 +207,7 @@ func (si *ServerImplementation) LookupAccountByID(ctx echo.Context, accountID st
 		return indexerError(ctx, fmt.Sprintf("%s: %s", errMultipleAccounts, accountID))
 	}
 
-	return ctx.JSON(http.StatusOK, generated.AccountResponse{
+	return writeResponse(ctx, format, http.StatusOK, generated.AccountResponse{
 		CurrentRound: round,
 		Account:      accounts[0],
 	})
@@ -131,23 +216,60 @@ func (si *ServerImplementation) LookupAccountByID(ctx echo.Context, accountID st
 // SearchForAccounts returns accounts matching the provided parameters
 // (GET /v2/accounts)
 func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params generated.SearchForAccountsParams) error {
-	if !si.EnableAddressSearchRoundRewind && params.Round != nil {
+	if params.Round != nil && !si.allowRoundRewind(ctx) {
 		return badRequest(ctx, errMultiAcctRewind)
 	}
 
 	spendingAddr, errors := decodeAddress(params.AuthAddr, "account-id", make([]string, 0))
+	format, errors := decodeFormat(params.Format, errors)
+	orderByBalance, errors := decodeOrder(params.Order, accountsOrderBalanceDesc, errors)
+	status, errors := decodeStatus(params.Status, errors)
+	sigType, errors := decodeSigType(params.SigType, errors)
+	multisigKey, errors := decodeBase64Byte(params.MultisigPublicKey, "multisig-public-key", errors)
+	authAddrHistory, errors := decodeAddress(params.AuthAddrHistory, "auth-addr-history", errors)
 	if len(errors) != 0 {
 		return badRequest(ctx, errors[0])
 	}
 
+	// Rewound balances are not ordered.
+	if orderByBalance && params.Round != nil {
+		return badRequest(ctx, errOrderWithRound)
+	}
+
+	// The participation filters use the current key registration.
+	if (status != nil || params.VoteLastValidBefore != nil || params.VoteLastValidAfter != nil) && params.Round != nil {
+		return badRequest(ctx, errParticipationWithRound)
+	}
+	if (params.MinBalanceGreaterThan != nil || params.MinBalanceLessThan != nil) && params.Round != nil {
+		return badRequest(ctx, errMinBalanceWithRound)
+	}
+	// The close round and authorized address are those of the current account.
+	if (params.ClosedAfterRound != nil || params.ClosedBeforeRound != nil || params.IsRekeyed != nil || params.AuthAddrHistory != nil) && params.Round != nil {
+		return badRequest(ctx, errClosedOrRekeyedWithRound)
+	}
+
 	options := idb.AccountQueryOptions{
-		IncludeAssetHoldings: true,
-		IncludeAssetParams:   true,
-		Limit:                min(uintOrDefaultValue(params.Limit, defaultAccountsLimit), maxAccountsLimit),
-		HasAssetID:           uintOrDefault(params.AssetId),
-		HasAppID:             uintOrDefault(params.ApplicationId),
-		EqualToAuthAddr:      spendingAddr[:],
-		IncludeDeleted:       boolOrDefault(params.IncludeAll),
+		IncludeAssetHoldings:  true,
+		IncludeAssetParams:    true,
+		Limit:                 si.limit(ctx, accountsLimitName, params.Limit),
+		HasAssetID:            uintOrDefault(params.AssetId),
+		HasAppID:              uintOrDefault(params.ApplicationId),
+		EqualToAuthAddr:       spendingAddr[:],
+		IncludeDeleted:        boolOrDefault(params.IncludeAll),
+		OrderByBalance:        orderByBalance,
+		Status:                status,
+		VoteLastValidBefore:   params.VoteLastValidBefore,
+		VoteLastValidAfter:    params.VoteLastValidAfter,
+		MinBalanceGreaterThan: params.MinBalanceGreaterThan,
+		MinBalanceLessThan:    params.MinBalanceLessThan,
+		SigType:               sigType,
+		CreatedAfterRound:     params.CreatedAfterRound,
+		CreatedBeforeRound:    params.CreatedBeforeRound,
+		ClosedAfterRound:      params.ClosedAfterRound,
+		ClosedBeforeRound:     params.ClosedBeforeRound,
+		IsRekeyed:             params.IsRekeyed,
+		MultisigKey:           multisigKey,
+		AuthAddrHistory:       authAddrHistory,
 	}
 
 	// Set GT/LT on Algos or Asset depending on whether or not an assetID was specified
@@ -159,23 +281,38 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 		options.AssetLT = params.CurrencyLessThan
 	}
 
-	if params.Next != nil {
+	if params.Next != nil && orderByBalance {
+		cursor, err := decodeAmountCursor(*params.Next)
+		if err != nil {
+			return badRequest(ctx, errUnableToParseNext)
+		}
+		options.AfterBalance = cursor
+	} else if params.Next != nil {
 		addr, err := sdk_types.DecodeAddress(*params.Next)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, errUnableToParseNext)
+			return badRequest(ctx, errUnableToParseNext)
 		}
 		options.GreaterThanAddress = addr[:]
 	}
 
+	if format == formatNDJSON {
+		return si.streamAccounts(ctx, options, params.Round)
+	}
+
 	accounts, round, err := si.fetchAccounts(ctx.Request().Context(), options, params.Round)
 
 	if err != nil {
-		return indexerError(ctx, fmt.Sprintf("%s: %v", errFailedSearchingAccount, err))
+		return dbError(ctx, err, fmt.Sprintf("%s: %v", errFailedSearchingAccount, err))
 	}
 
 	var next *string
 	if len(accounts) > 0 {
-		next = strPtr(accounts[len(accounts)-1].Address)
+		last := accounts[len(accounts)-1]
+		if orderByBalance {
+			next = strPtr(encodeAmountCursor(last.AmountWithoutPendingRewards, last.Address))
+		} else {
+			next = strPtr(last.Address)
+		}
 	}
 
 	response := generated.AccountsResponse{
@@ -184,7 +321,7 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 		Accounts:     accounts,
 	}
 
-	return ctx.JSON(http.StatusOK, response)
+	return writeResponse(ctx, format, http.StatusOK, response)
 }
 
 // LookupAccountTransactions looks up transactions associated with a particular account.
@@ -217,19 +354,112 @@ func (si *ServerImplementation) LookupAccountTransactions(ctx echo.Context, acco
 		CurrencyGreaterThan: params.CurrencyGreaterThan,
 		CurrencyLessThan:    params.CurrencyLessThan,
 		RekeyTo:             params.RekeyTo,
+		Format:              params.Format,
 	}
 
 	return si.SearchForTransactions(ctx, searchParams)
 }
 
+// LookupAccountChanges returns what each round changed in an account.
+// (GET /v2/accounts/{account-id}/changes)
+func (si *ServerImplementation) LookupAccountChanges(ctx echo.Context, accountID string, params generated.LookupAccountChangesParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	addr, errors := decodeAddress(&accountID, "account-id", errors)
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+	if params.MinRound != nil && params.MaxRound != nil && *params.MinRound > *params.MaxRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	query := idb.AccountChangesQuery{
+		Address:  addr,
+		MinRound: uintOrDefault(params.MinRound),
+		MaxRound: uintOrDefault(params.MaxRound),
+		Limit:    si.limit(ctx, changesLimitName, params.Limit),
+	}
+	if params.Next != nil {
+		prev, err := strconv.ParseUint(*params.Next, 10, 64)
+		if err != nil {
+			return badRequest(ctx, errUnableToParseNext)
+		}
+		query.PrevRound = &prev
+	}
+
+	changes, round, err := si.fetchAccountChanges(ctx.Request().Context(), query)
+	if err != nil {
+		return dbError(ctx, err, fmt.Sprintf("%s: %v", errAccountChanges, err))
+	}
+
+	var next *string
+	if len(changes) > 0 {
+		next = strPtr(strconv.FormatUint(changes[len(changes)-1].Round, 10))
+	}
+
+	return writeResponse(ctx, format, http.StatusOK, generated.AccountChangesResponse{
+		Changes:      changes,
+		CurrentRound: round,
+		NextToken:    next,
+	})
+}
+
+// LookupAccountRekeys returns the changes of the authorized address of an account.
+// (GET /v2/accounts/{account-id}/rekeys)
+func (si *ServerImplementation) LookupAccountRekeys(ctx echo.Context, accountID string, params generated.LookupAccountRekeysParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	addr, errors := decodeAddress(&accountID, "account-id", errors)
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+	if params.MinRound != nil && params.MaxRound != nil && *params.MinRound > *params.MaxRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	query := idb.AccountRekeysQuery{
+		Address:  addr,
+		MinRound: uintOrDefault(params.MinRound),
+		MaxRound: uintOrDefault(params.MaxRound),
+		Limit:    si.limit(ctx, rekeysLimitName, params.Limit),
+	}
+	if params.Next != nil {
+		prevRound, prevIntra, err := decodeRekeyCursor(*params.Next)
+		if err != nil {
+			return badRequest(ctx, errUnableToParseNext)
+		}
+		query.PrevRound = &prevRound
+		query.PrevIntra = prevIntra
+	}
+
+	rekeys, next, round, err := si.fetchAccountRekeys(ctx.Request().Context(), accountID, query)
+	if err != nil {
+		return dbError(ctx, err, fmt.Sprintf("%s: %v", errAccountRekeys, err))
+	}
+
+	return writeResponse(ctx, format, http.StatusOK, generated.AccountRekeysResponse{
+		Rekeys:       rekeys,
+		CurrentRound: round,
+		NextToken:    next,
+	})
+}
+
 // SearchForApplications returns applications for the provided parameters.
 // (GET /v2/applications)
 func (si *ServerImplementation) SearchForApplications(ctx echo.Context, params generated.SearchForApplicationsParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+	params.Limit = uint64Ptr(si.limit(ctx, applicationsLimitName, params.Limit))
+
+	if format == formatNDJSON {
+		return si.streamApplications(ctx, &params)
+	}
+
 	results, round := si.db.Applications(ctx.Request().Context(), &params)
 	apps := make([]generated.Application, 0)
 	for result := range results {
 		if result.Error != nil {
-			return indexerError(ctx, result.Error.Error())
+			return dbError(ctx, result.Error, result.Error.Error())
 		}
 		apps = append(apps, result.Application)
 	}
@@ -244,12 +474,17 @@ func (si *ServerImplementation) SearchForApplications(ctx echo.Context, params g
 		CurrentRound: round,
 		NextToken:    next,
 	}
-	return ctx.JSON(http.StatusOK, out)
+	return writeResponse(ctx, format, http.StatusOK, out)
 }
 
 // LookupApplicationByID returns one application for the requested ID.
 // (GET /v2/applications/{application-id})
 func (si *ServerImplementation) LookupApplicationByID(ctx echo.Context, applicationID uint64, params generated.LookupApplicationByIDParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
 	p := &generated.SearchForApplicationsParams{
 		ApplicationId: &applicationID,
 		IncludeAll:    params.IncludeAll,
@@ -260,17 +495,22 @@ func (si *ServerImplementation) LookupApplicationByID(ctx echo.Context, applicat
 	}
 	for result := range results {
 		if result.Error != nil {
-			return indexerError(ctx, result.Error.Error())
+			return dbError(ctx, result.Error, result.Error.Error())
 		}
 		out.Application = &result.Application
-		return ctx.JSON(http.StatusOK, out)
+		return writeResponse(ctx, format, http.StatusOK, out)
 	}
-	return ctx.JSON(http.StatusNotFound, out)
+	return writeResponse(ctx, format, http.StatusNotFound, out)
 }
 
 // LookupAssetByID looks up a particular asset
 // (GET /v2/assets/{asset-id})
 func (si *ServerImplementation) LookupAssetByID(ctx echo.Context, assetID uint64, params generated.LookupAssetByIDParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
 	search := generated.SearchForAssetsParams{
 		AssetId:    uint64Ptr(assetID),
 		Limit:      uint64Ptr(1),
@@ -283,7 +523,7 @@ func (si *ServerImplementation) LookupAssetByID(ctx echo.Context, assetID uint64
 
 	assets, round, err := si.fetchAssets(ctx.Request().Context(), options)
 	if err != nil {
-		return indexerError(ctx, err.Error())
+		return dbError(ctx, err, err.Error())
 	}
 
 	if len(assets) == 0 {
@@ -294,7 +534,7 @@ func (si *ServerImplementation) LookupAssetByID(ctx echo.Context, assetID uint64
 		return indexerError(ctx, fmt.Sprintf("%s: %d", errMultipleAssets, assetID))
 	}
 
-	return ctx.JSON(http.StatusOK, generated.AssetResponse{
+	return writeResponse(ctx, format, http.StatusOK, generated.AssetResponse{
 		Asset:        assets[0],
 		CurrentRound: round,
 	})
@@ -303,39 +543,199 @@ func (si *ServerImplementation) LookupAssetByID(ctx echo.Context, assetID uint64
 // LookupAssetBalances looks up balances for a particular asset
 // (GET /v2/assets/{asset-id}/balances)
 func (si *ServerImplementation) LookupAssetBalances(ctx echo.Context, assetID uint64, params generated.LookupAssetBalancesParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	orderByAmount, errors := decodeOrder(params.Order, balancesOrderAmountDesc, errors)
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
 	query := idb.AssetBalanceQuery{
 		AssetID:        assetID,
 		AmountGT:       params.CurrencyGreaterThan,
 		AmountLT:       params.CurrencyLessThan,
 		IncludeDeleted: boolOrDefault(params.IncludeAll),
-		Limit:          min(uintOrDefaultValue(params.Limit, defaultBalancesLimit), maxBalancesLimit),
+		Limit:          si.limit(ctx, balancesLimitName, params.Limit),
+		OrderByAmount:  orderByAmount,
 	}
 
-	if params.Next != nil {
+	if params.Next != nil && orderByAmount {
+		cursor, err := decodeAmountCursor(*params.Next)
+		if err != nil {
+			return badRequest(ctx, errUnableToParseNext)
+		}
+		query.AfterAmount = cursor
+	} else if params.Next != nil {
 		addr, err := sdk_types.DecodeAddress(*params.Next)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, errUnableToParseNext)
+			return badRequest(ctx, errUnableToParseNext)
 		}
 		query.PrevAddress = addr[:]
 	}
 
+	if format == formatNDJSON {
+		return si.streamAssetBalances(ctx, query)
+	}
+
 	balances, round, err := si.fetchAssetBalances(ctx.Request().Context(), query)
 	if err != nil {
-		indexerError(ctx, err.Error())
+		return dbError(ctx, err, err.Error())
 	}
 
 	var next *string
 	if len(balances) > 0 {
-		next = strPtr(balances[len(balances)-1].Address)
+		last := balances[len(balances)-1]
+		if orderByAmount {
+			next = strPtr(encodeAmountCursor(last.Amount, last.Address))
+		} else {
+			next = strPtr(last.Address)
+		}
 	}
 
-	return ctx.JSON(http.StatusOK, generated.AssetBalancesResponse{
+	return writeResponse(ctx, format, http.StatusOK, generated.AssetBalancesResponse{
 		CurrentRound: round,
 		NextToken:    next,
 		Balances:     balances,
 	})
 }
 
+// LookupAssetStats returns the holder counts, supply and transfers of an asset.
+// (GET /v2/assets/{asset-id}/stats)
+func (si *ServerImplementation) LookupAssetStats(ctx echo.Context, assetID uint64, params generated.LookupAssetStatsParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+	if params.MinRound != nil && params.MaxRound != nil && *params.MinRound > *params.MaxRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	query := idb.AssetStatsQuery{
+		AssetID:  assetID,
+		MinRound: uintOrDefault(params.MinRound),
+		MaxRound: uintOrDefault(params.MaxRound),
+	}
+	stats, round, err := si.db.AssetStats(ctx.Request().Context(), query)
+	if err == idb.ErrorAssetNotFound {
+		return notFound(ctx, fmt.Sprintf("%s: %d", errNoAssetsFound, assetID))
+	}
+	if err != nil {
+		return dbError(ctx, err, fmt.Sprintf("%s: %v", errAssetStats, err))
+	}
+
+	return writeResponse(ctx, format, http.StatusOK, generated.AssetStatsResponse{
+		CurrentRound: round,
+		Stats: generated.AssetStats{
+			AssetId:           stats.AssetID,
+			Holders:           stats.Holders,
+			OptedIn:           stats.OptedIn,
+			Total:             stats.Total,
+			CirculatingSupply: stats.CirculatingSupply,
+			Transfers:         stats.Transfers,
+			Volume:            stats.Volume,
+			Deleted:           boolPtr(stats.Deleted),
+		},
+	})
+}
+
+// LookupTransactionStats returns the transaction statistics of each interval.
+// (GET /v2/stats/transactions)
+func (si *ServerImplementation) LookupTransactionStats(ctx echo.Context, params generated.LookupTransactionStatsParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+	if params.Interval != nil && *params.Interval != statsIntervalDay {
+		return badRequest(ctx, fmt.Sprintf("%s: '%s'", errUnknownInterval, *params.Interval))
+	}
+	if params.MinRound != nil && params.MaxRound != nil && *params.MinRound > *params.MaxRound {
+		return badRequest(ctx, errInvalidRoundMinMax)
+	}
+
+	query := idb.TransactionStatsQuery{
+		MinRound: uintOrDefault(params.MinRound),
+		MaxRound: uintOrDefault(params.MaxRound),
+		Limit:    si.limit(ctx, statsLimitName, params.Limit),
+	}
+	if params.Next != nil {
+		day, err := time.Parse(statsDayLayout, *params.Next)
+		if err != nil {
+			return badRequest(ctx, errUnableToParseNext)
+		}
+		query.AfterDay = &day
+	}
+
+	intervals, round, err := si.fetchTransactionStats(ctx.Request().Context(), query)
+	if err != nil {
+		return dbError(ctx, err, fmt.Sprintf("%s: %v", errTransactionStats, err))
+	}
+
+	var next *string
+	if len(intervals) > 0 {
+		next = strPtr(intervals[len(intervals)-1].Start.Format(statsDayLayout))
+	}
+
+	return writeResponse(ctx, format, http.StatusOK, generated.TransactionStatsResponse{
+		CurrentRound: round,
+		NextToken:    next,
+		Intervals:    intervals,
+	})
+}
+
+// LookupNetworkTotals returns the number of accounts, assets, applications and opt ins.
+// (GET /v2/stats/accounts)
+func (si *ServerImplementation) LookupNetworkTotals(ctx echo.Context, params generated.LookupNetworkTotalsParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
+	totals, round, err := si.db.NetworkTotals(ctx.Request().Context())
+	if err != nil {
+		return dbError(ctx, err, fmt.Sprintf("%s: %v", errNetworkTotals, err))
+	}
+
+	return writeResponse(ctx, format, http.StatusOK, generated.NetworkTotalsResponse{
+		CurrentRound: round,
+		Totals: generated.NetworkTotals{
+			Accounts:    totals.Accounts,
+			Assets:      totals.Assets,
+			Apps:        totals.Apps,
+			AssetOptIns: totals.AssetOptIns,
+			AppOptIns:   totals.AppOptIns,
+		},
+	})
+}
+
+// LookupStakeTotals returns the stake of the accounts by participation status.
+// (GET /v2/stats/stake)
+func (si *ServerImplementation) LookupStakeTotals(ctx echo.Context, params generated.LookupStakeTotalsParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
+	totals, round, err := si.db.StakeTotals(ctx.Request().Context(), idb.StakeTotalsQuery{Round: params.Round})
+	if err == idb.ErrorStakeTotalsNotFound {
+		if params.Round == nil {
+			return notFound(ctx, errNoStakeTotalsFound)
+		}
+		return notFound(ctx, fmt.Sprintf("%s: %d", errNoStakeTotalsFound, *params.Round))
+	}
+	if err != nil {
+		return dbError(ctx, err, fmt.Sprintf("%s: %v", errStakeTotals, err))
+	}
+
+	return writeResponse(ctx, format, http.StatusOK, generated.StakeTotalsResponse{
+		CurrentRound: round,
+		Totals: generated.StakeTotals{
+			Round:            totals.Round,
+			Online:           participationTotalsToGenerated(totals.Online),
+			Offline:          participationTotalsToGenerated(totals.Offline),
+			NotParticipating: participationTotalsToGenerated(totals.NotParticipating),
+		},
+	})
+}
+
 // LookupAssetTransactions looks up transactions associated with a particular asset
 // (GET /v2/assets/{asset-id}/transactions)
 func (si *ServerImplementation) LookupAssetTransactions(ctx echo.Context, assetID uint64, params generated.LookupAssetTransactionsParams) error {
@@ -359,6 +759,7 @@ func (si *ServerImplementation) LookupAssetTransactions(ctx echo.Context, assetI
 		AddressRole:         params.AddressRole,
 		ExcludeCloseTo:      params.ExcludeCloseTo,
 		RekeyTo:             params.RekeyTo,
+		Format:              params.Format,
 	}
 
 	return si.SearchForTransactions(ctx, searchParams)
@@ -367,22 +768,32 @@ func (si *ServerImplementation) LookupAssetTransactions(ctx echo.Context, assetI
 // SearchForAssets returns assets matching the provided parameters
 // (GET /v2/assets)
 func (si *ServerImplementation) SearchForAssets(ctx echo.Context, params generated.SearchForAssetsParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
 	options, err := assetParamsToAssetQuery(params)
 	if err != nil {
 		return badRequest(ctx, err.Error())
 	}
+	options.Limit = si.limit(ctx, assetsLimitName, params.Limit)
+
+	if format == formatNDJSON {
+		return si.streamAssets(ctx, options)
+	}
 
 	assets, round, err := si.fetchAssets(ctx.Request().Context(), options)
 	if err != nil {
-		return indexerError(ctx, err.Error())
+		return dbError(ctx, err, err.Error())
 	}
 
 	var next *string
 	if len(assets) > 0 {
-		next = strPtr(strconv.FormatUint(assets[len(assets)-1].Index, 10))
+		next = strPtr(encodeAssetCursor(assets[len(assets)-1]))
 	}
 
-	return ctx.JSON(http.StatusOK, generated.AssetsResponse{
+	return writeResponse(ctx, format, http.StatusOK, generated.AssetsResponse{
 		CurrentRound: round,
 		NextToken:    next,
 		Assets:       assets,
@@ -391,17 +802,27 @@ func (si *ServerImplementation) SearchForAssets(ctx echo.Context, params generat
 
 // LookupBlock returns the block for a given round number
 // (GET /v2/blocks/{round-number})
-func (si *ServerImplementation) LookupBlock(ctx echo.Context, roundNumber uint64) error {
+func (si *ServerImplementation) LookupBlock(ctx echo.Context, roundNumber uint64, params generated.LookupBlockParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
 	blk, err := si.fetchBlock(ctx.Request().Context(), roundNumber)
 	if err != nil {
 		return indexerError(ctx, err.Error())
 	}
 
-	return ctx.JSON(http.StatusOK, generated.BlockResponse(blk))
+	return writeResponse(ctx, format, http.StatusOK, generated.BlockResponse(blk))
 }
 
 // LookupTransaction searches for the requested transaction ID.
-func (si *ServerImplementation) LookupTransaction(ctx echo.Context, txid string) error {
+func (si *ServerImplementation) LookupTransaction(ctx echo.Context, txid string, params generated.LookupTransactionParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
 	filter, err := transactionParamsToTransactionFilter(generated.SearchForTransactionsParams{
 		Txid: strPtr(txid),
 	})
@@ -412,7 +833,7 @@ func (si *ServerImplementation) LookupTransaction(ctx echo.Context, txid string)
 	// Fetch the transactions
 	txns, _, round, err := si.fetchTransactions(ctx.Request().Context(), filter)
 	if err != nil {
-		return indexerError(ctx, fmt.Sprintf("%s: %v", errTransactionSearch, err))
+		return dbError(ctx, err, fmt.Sprintf("%s: %v", errTransactionSearch, err))
 	}
 
 	if len(txns) == 0 {
@@ -428,21 +849,31 @@ func (si *ServerImplementation) LookupTransaction(ctx echo.Context, txid string)
 		Transaction:  txns[0],
 	}
 
-	return ctx.JSON(http.StatusOK, response)
+	return writeResponse(ctx, format, http.StatusOK, response)
 }
 
 // SearchForTransactions returns transactions matching the provided parameters
 // (GET /v2/transactions)
 func (si *ServerImplementation) SearchForTransactions(ctx echo.Context, params generated.SearchForTransactionsParams) error {
+	format, errors := decodeFormat(params.Format, make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
 	filter, err := transactionParamsToTransactionFilter(params)
 	if err != nil {
 		return badRequest(ctx, err.Error())
 	}
+	filter.Limit = si.limit(ctx, transactionsLimitName, params.Limit)
+
+	if format == formatNDJSON {
+		return si.streamTransactions(ctx, filter)
+	}
 
 	// Fetch the transactions
 	txns, next, round, err := si.fetchTransactions(ctx.Request().Context(), filter)
 	if err != nil {
-		return indexerError(ctx, fmt.Sprintf("%s: %v", errTransactionSearch, err))
+		return dbError(ctx, err, fmt.Sprintf("%s: %v", errTransactionSearch, err))
 	}
 
 	response := generated.TransactionsResponse{
@@ -451,7 +882,110 @@ func (si *ServerImplementation) SearchForTransactions(ctx echo.Context, params g
 		Transactions: txns,
 	}
 
-	return ctx.JSON(http.StatusOK, response)
+	return writeResponse(ctx, format, http.StatusOK, response)
+}
+
+// ExportTransactionsCSV streams transactions matching the provided parameters as CSV.
+// (GET /v2/export/transactions.csv)
+func (si *ServerImplementation) ExportTransactionsCSV(ctx echo.Context, params generated.ExportTransactionsCSVParams) error {
+	if !si.EnableExport {
+		return forbidden(ctx, errExportDisabled)
+	}
+
+	filter, err := transactionParamsToTransactionFilter(generated.SearchForTransactionsParams{
+		Next:                params.Next,
+		NotePrefix:          params.NotePrefix,
+		TxType:              params.TxType,
+		SigType:             params.SigType,
+		Txid:                params.Txid,
+		Round:               params.Round,
+		MinRound:            params.MinRound,
+		MaxRound:            params.MaxRound,
+		AssetId:             params.AssetId,
+		BeforeTime:          params.BeforeTime,
+		AfterTime:           params.AfterTime,
+		CurrencyGreaterThan: params.CurrencyGreaterThan,
+		CurrencyLessThan:    params.CurrencyLessThan,
+		Address:             params.Address,
+		AddressRole:         params.AddressRole,
+		ExcludeCloseTo:      params.ExcludeCloseTo,
+		RekeyTo:             params.RekeyTo,
+		ApplicationId:       params.ApplicationId,
+	})
+	if err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	filter.Limit = si.exportLimit()
+	filter.Export = true
+
+	txchan, round := si.db.Transactions(ctx.Request().Context(), filter)
+	stream := newResultStream(ctx, round, &csvFormat{columns: transactionCSVColumns})
+	count := uint64(0)
+	next := ""
+	for txrow := range txchan {
+		tx, err := txnRowToTransaction(txrow)
+		if err != nil {
+			return stream.fail(err, fmt.Sprintf("%s: %v", errTransactionSearch, err))
+		}
+		if err := stream.write(tx); err != nil {
+			return err
+		}
+		count++
+		next = txrow.Next()
+	}
+
+	// Only provide a next token if the export was cut short by the row limit.
+	if count < filter.Limit {
+		next = ""
+	}
+	return stream.finish(next)
+}
+
+// ExportAssetBalancesCSV streams the balances of a particular asset as CSV.
+// (GET /v2/export/assets/{asset-id}/balances.csv)
+func (si *ServerImplementation) ExportAssetBalancesCSV(ctx echo.Context, assetID uint64, params generated.ExportAssetBalancesCSVParams) error {
+	if !si.EnableExport {
+		return forbidden(ctx, errExportDisabled)
+	}
+
+	query := idb.AssetBalanceQuery{
+		AssetID:        assetID,
+		AmountGT:       params.CurrencyGreaterThan,
+		AmountLT:       params.CurrencyLessThan,
+		IncludeDeleted: boolOrDefault(params.IncludeAll),
+		Limit:          si.exportLimit(),
+		Export:         true,
+	}
+
+	if params.Next != nil {
+		addr, err := sdk_types.DecodeAddress(*params.Next)
+		if err != nil {
+			return badRequest(ctx, errUnableToParseNext)
+		}
+		query.PrevAddress = addr[:]
+	}
+
+	assetbalchan, round := si.db.AssetBalances(ctx.Request().Context(), query)
+	stream := newResultStream(ctx, round, &csvFormat{columns: assetHoldingCSVColumns})
+	count := uint64(0)
+	next := ""
+	for row := range assetbalchan {
+		bal, err := assetBalanceRowToHolding(row)
+		if err != nil {
+			return stream.fail(err, err.Error())
+		}
+		if err := stream.write(bal); err != nil {
+			return err
+		}
+		count++
+		next = bal.Address
+	}
+
+	// Only provide a next token if the export was cut short by the row limit.
+	if count < query.Limit {
+		next = ""
+	}
+	return stream.finish(next)
 }
 
 ///////////////////
@@ -472,6 +1006,28 @@ func indexerError(ctx echo.Context, err string) error {
 	})
 }
 
+// return the status of an IndexerDb error, queries stopped by the query guards
+// are the result of the request or a temporary condition rather than a 500.
+func dbError(ctx echo.Context, err error, message string) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, idb.ErrorQueryTooExpensive):
+		status = http.StatusBadRequest
+	case errors.Is(err, idb.ErrorQueryTimeout):
+		status = http.StatusServiceUnavailable
+	}
+	return ctx.JSON(status, generated.ErrorResponse{
+		Message: message,
+	})
+}
+
+// return a 403
+func forbidden(ctx echo.Context, err string) error {
+	return ctx.JSON(http.StatusForbidden, generated.ErrorResponse{
+		Message: err,
+	})
+}
+
 // return a 404
 func notFound(ctx echo.Context, err string) error {
 	return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{
@@ -488,38 +1044,9 @@ func (si *ServerImplementation) fetchAssets(ctx context.Context, options idb.Ass
 	assetchan, round := si.db.Assets(ctx, options)
 	assets := make([]generated.Asset, 0)
 	for row := range assetchan {
-		if row.Error != nil {
-			return nil, round, row.Error
-		}
-
-		creator := sdk_types.Address{}
-		if len(row.Creator) != len(creator) {
-			return nil, round, fmt.Errorf(errInvalidCreatorAddress)
-		}
-		copy(creator[:], row.Creator[:])
-
-		mdhash := make([]byte, 32)
-		copy(mdhash, row.Params.MetadataHash[:])
-
-		asset := generated.Asset{
-			Index:            row.AssetID,
-			CreatedAtRound:   row.CreatedRound,
-			DestroyedAtRound: row.ClosedRound,
-			Deleted:          row.Deleted,
-			Params: generated.AssetParams{
-				Creator:       creator.String(),
-				Name:          strPtr(row.Params.AssetName),
-				UnitName:      strPtr(row.Params.UnitName),
-				Url:           strPtr(row.Params.URL),
-				Total:         row.Params.Total,
-				Decimals:      uint64(row.Params.Decimals),
-				DefaultFrozen: boolPtr(row.Params.DefaultFrozen),
-				MetadataHash:  bytePtr(mdhash),
-				Clawback:      strPtr(row.Params.Clawback.String()),
-				Reserve:       strPtr(row.Params.Reserve.String()),
-				Freeze:        strPtr(row.Params.Freeze.String()),
-				Manager:       strPtr(row.Params.Manager.String()),
-			},
+		asset, err := assetRowToAsset(row)
+		if err != nil {
+			return nil, round, err
 		}
 
 		assets = append(assets, asset)
@@ -533,29 +1060,62 @@ func (si *ServerImplementation) fetchAssetBalances(ctx context.Context, options
 	assetbalchan, round := si.db.AssetBalances(ctx, options)
 	balances := make([]generated.MiniAssetHolding, 0)
 	for row := range assetbalchan {
-		if row.Error != nil {
-			return nil, round, row.Error
+		bal, err := assetBalanceRowToHolding(row)
+		if err != nil {
+			return nil, round, err
 		}
 
-		addr := sdk_types.Address{}
-		if len(row.Address) != len(addr) {
-			return nil, round, fmt.Errorf(errInvalidCreatorAddress)
+		balances = append(balances, bal)
+	}
+
+	return balances, round, nil
+}
+
+// fetchAccountChanges fetches all results and converts them into
+// generated.AccountChange objects
+func (si *ServerImplementation) fetchAccountChanges(ctx context.Context, query idb.AccountChangesQuery) ([]generated.AccountChange, uint64 /*round*/, error) {
+	changechan, round := si.db.AccountChanges(ctx, query)
+	changes := make([]generated.AccountChange, 0)
+	for row := range changechan {
+		change, err := accountChangeRowToAccountChange(row)
+		if err != nil {
+			return nil, round, err
 		}
-		copy(addr[:], row.Address[:])
-
-		bal := generated.MiniAssetHolding{
-			Address:         addr.String(),
-			Amount:          row.Amount,
-			IsFrozen:        row.Frozen,
-			OptedInAtRound:  row.CreatedRound,
-			OptedOutAtRound: row.ClosedRound,
-			Deleted:         row.Deleted,
+
+		changes = append(changes, change)
+	}
+
+	return changes, round, nil
+}
+
+// fetchAccountRekeys fetches all results and converts them into
+// generated.AccountRekey objects, along with the next token.
+func (si *ServerImplementation) fetchAccountRekeys(ctx context.Context, accountID string, query idb.AccountRekeysQuery) ([]generated.AccountRekey, *string /*next*/, uint64 /*round*/, error) {
+	rekeychan, round := si.db.AccountRekeys(ctx, query)
+	rekeys := make([]generated.AccountRekey, 0)
+	var next *string
+	for row := range rekeychan {
+		if row.Error != nil {
+			return nil, nil, round, row.Error
 		}
+		rekeys = append(rekeys, accountRekeyToAccountRekey(accountID, row.Rekey))
+		next = strPtr(encodeRekeyCursor(row.Rekey))
+	}
 
-		balances = append(balances, bal)
+	return rekeys, next, round, nil
+}
+
+func (si *ServerImplementation) fetchTransactionStats(ctx context.Context, query idb.TransactionStatsQuery) ([]generated.TransactionStats, uint64 /*round*/, error) {
+	statschan, round := si.db.TransactionStats(ctx, query)
+	intervals := make([]generated.TransactionStats, 0)
+	for row := range statschan {
+		if row.Error != nil {
+			return nil, round, row.Error
+		}
+		intervals = append(intervals, transactionStatsRowToTransactionStats(row))
 	}
 
-	return balances, round, nil
+	return intervals, round, nil
 }
 
 // fetchBlock looks up a block and converts it into a generated.Block object
@@ -632,44 +1192,58 @@ func (si *ServerImplementation) fetchAccounts(ctx context.Context, options idb.A
 
 	accounts := make([]generated.Account, 0)
 	for row := range accountchan {
-		if row.Error != nil {
-			return nil, round, row.Error
-		}
-
-		// Check if it's a special account, if so, skip. We don't want it in our results.
-		isSpecialAccount, err := si.isSpecialAccount(row.Account.Address)
+		account, ok, err := si.accountRowToAccount(row, options, atRound)
 		if err != nil {
 			return nil, round, err
 		}
 
-		if isSpecialAccount {
-			continue
+		if ok {
+			accounts = append(accounts, account)
 		}
+	}
+
+	return accounts, round, nil
+}
+
+// accountRowToAccount converts an account row into a generated.Account object,
+// optionally rewinding its value back to a particular round. Returns false if
+// the account should be omitted from the results.
+func (si *ServerImplementation) accountRowToAccount(row idb.AccountRow, options idb.AccountQueryOptions, atRound *uint64) (generated.Account, bool, error) {
+	if row.Error != nil {
+		return generated.Account{}, false, row.Error
+	}
 
-		// Compute for a given round if requested.
-		var account generated.Account
-		if atRound != nil {
-			acct, err := accounting.AccountAtRound(row.Account, *atRound, si.db)
-			if err != nil {
-				// Ignore the error if this is an account search rewind error
-				_, isSpecialAccountRewindError := err.(*accounting.SpecialAccountRewindError)
-				if len(options.EqualToAddress) != 0 || !isSpecialAccountRewindError {
-					return nil, round, fmt.Errorf("%s: %v", errRewindingAccount, err)
-				}
-				// If we didn't return, continue to the next account
-				continue
+	// Check if it's a special account, if so, skip. We don't want it in our results.
+	isSpecialAccount, err := si.isSpecialAccount(row.Account.Address)
+	if err != nil {
+		return generated.Account{}, false, err
+	}
+
+	if isSpecialAccount {
+		return generated.Account{}, false, nil
+	}
+
+	// Compute for a given round if requested.
+	var account generated.Account
+	if atRound != nil {
+		acct, err := accounting.AccountAtRound(row.Account, *atRound, si.db)
+		if err != nil {
+			// Ignore the error if this is an account search rewind error
+			_, isSpecialAccountRewindError := err.(*accounting.SpecialAccountRewindError)
+			if len(options.EqualToAddress) != 0 || !isSpecialAccountRewindError {
+				return generated.Account{}, false, fmt.Errorf("%s: %v", errRewindingAccount, err)
 			}
-			account = acct
-		} else {
-			account = row.Account
+			// If we didn't return, continue to the next account
+			return generated.Account{}, false, nil
 		}
-
-		// match the algod equivalent which includes pending rewards
-		account.Rewards += account.PendingRewards
-		accounts = append(accounts, account)
+		account = acct
+	} else {
+		account = row.Account
 	}
 
-	return accounts, round, nil
+	// match the algod equivalent which includes pending rewards
+	account.Rewards += account.PendingRewards
+	return account, true, nil
 }
 
 // fetchTransactions is used to query the backend for transactions, and compute the next token
@@ -693,6 +1267,43 @@ func (si *ServerImplementation) fetchTransactions(ctx context.Context, filter id
 // Helper functions //
 //////////////////////
 
+// limit returns the 'limit' of a search request. The server configures the
+// default and maximum of each endpoint, and the API token may override the
+// maximum.
+func (si *ServerImplementation) limit(ctx echo.Context, name string, requested *uint64) uint64 {
+	limits, ok := si.Limits[name]
+	if !ok {
+		limits = DefaultLimits[name]
+	}
+
+	max := limits.Max
+	if token := middlewares.GetToken(ctx); token != nil {
+		if tokenMax, ok := token.MaxLimits[name]; ok {
+			max = tokenMax
+		}
+	}
+	return min(uintOrDefaultValue(requested, limits.Default), max)
+}
+
+// allowRoundRewind returns true if the request may search for accounts at a
+// particular round, either because of the server or the API token.
+func (si *ServerImplementation) allowRoundRewind(ctx echo.Context) bool {
+	if si.EnableAddressSearchRoundRewind {
+		return true
+	}
+	token := middlewares.GetToken(ctx)
+	return token != nil && token.DevMode
+}
+
+// exportLimit returns the row limit used for CSV exports.
+func (si *ServerImplementation) exportLimit() uint64 {
+	if si.ExportRowLimit == 0 {
+		// LIMIT is a signed bigint in postgres.
+		return math.MaxInt64
+	}
+	return si.ExportRowLimit
+}
+
 func min(x, y uint64) uint64 {
 	if x < y {
 		return x
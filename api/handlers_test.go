@@ -1,20 +1,28 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	sdk_types "github.com/algorand/go-algorand-sdk/types"
 	"github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/api/middlewares"
 	"github.com/algorand/indexer/idb"
 	"github.com/algorand/indexer/idb/mocks"
 )
@@ -245,6 +253,58 @@ func loadTransactionFromFile(path string) generated.Transaction {
 	return ret
 }
 
+func TestAssetParamsToAssetQuery(t *testing.T) {
+	addr := "PT4K5LK4KYIQYYRAYPAZIEF47NVEQRDX3CPYWJVH25LKO2METIRBKRHRAE"
+	decoded, err := sdk_types.DecodeAddress(addr)
+	require.NoError(t, err)
+
+	query, err := assetParamsToAssetQuery(generated.SearchForAssetsParams{
+		Manager:       strPtr(addr),
+		Clawback:      strPtr(addr),
+		UrlPrefix:     strPtr("https://"),
+		MetadataHash:  strPtr("AQID"),
+		Decimals:      uint64Ptr(6),
+		DefaultFrozen: boolPtr(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, decoded[:], query.Manager)
+	assert.Nil(t, query.Reserve)
+	assert.Nil(t, query.Freeze)
+	assert.Equal(t, decoded[:], query.Clawback)
+	assert.Equal(t, "https://", query.URLPrefix)
+	assert.Equal(t, []byte{1, 2, 3}, query.MetadataHash)
+	assert.Equal(t, uint64(6), *query.Decimals)
+	assert.True(t, *query.DefaultFrozen)
+
+	_, err = assetParamsToAssetQuery(generated.SearchForAssetsParams{Freeze: strPtr("not-an-address")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "freeze")
+
+	_, err = assetParamsToAssetQuery(generated.SearchForAssetsParams{MetadataHash: strPtr("%%")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), errUnableToParseBase64)
+}
+
+func TestAssetSearchCursor(t *testing.T) {
+	score := 2.5
+	asset := generated.Asset{Index: 31566704, Score: &score}
+	next := encodeAssetCursor(asset)
+	assert.Equal(t, "2.5:31566704", next)
+	assert.Equal(t, "31566704", encodeAssetCursor(generated.Asset{Index: 31566704}))
+
+	query, err := assetParamsToAssetQuery(generated.SearchForAssetsParams{Query: strPtr("usdc"), Next: &next})
+	require.NoError(t, err)
+	assert.Equal(t, "usdc", query.Query)
+	require.NotNil(t, query.PrevScore)
+	assert.Equal(t, score, *query.PrevScore)
+	assert.Equal(t, uint64(31566704), query.AssetIDGreaterThan)
+
+	// Searches need a score in the next token.
+	_, err = assetParamsToAssetQuery(generated.SearchForAssetsParams{Query: strPtr("usdc"), Next: strPtr("31566704")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), errUnableToParseNext)
+}
+
 func TestFetchTransactions(t *testing.T) {
 	// Add in txnRows (with TxnBytes to parse), verify that they are properly serialized to generated.TransactionResponse
 	tests := []struct {
@@ -501,3 +561,614 @@ func TestFetchAccountsRewindRoundTooLarge(t *testing.T) {
 	assert.Error(t, err)
 	assert.True(t, strings.HasPrefix(err.Error(), errRewindingAccount), err.Error())
 }
+
+func TestTokenOverrides(t *testing.T) {
+	tokens := middlewares.MakeTokenStore([]middlewares.Token{
+		{Name: "default", Token: "default-token"},
+		{
+			Name:      "dev",
+			Token:     "dev-token",
+			DevMode:   true,
+			MaxLimits: map[string]uint64{transactionsLimitName: maxTransactionsLimit * 2},
+		},
+	})
+
+	serve := func(token string, handler echo.HandlerFunc) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Indexer-API-Token", token)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		assert.NoError(t, middlewares.MakeAuth("X-Indexer-API-Token", tokens)(handler)(ctx))
+		return rec
+	}
+
+	t.Run("Max limit", func(t *testing.T) {
+		ch := make(chan idb.TxnRow)
+		close(ch)
+		var outCh <-chan idb.TxnRow = ch
+
+		var limits []uint64
+		db := &mocks.IndexerDb{}
+		db.On("Transactions", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			limits = append(limits, args.Get(1).(idb.TransactionFilter).Limit)
+		}).Return(outCh, uint64(7))
+		si := ServerImplementation{db: db}
+
+		limit := uint64(maxTransactionsLimit * 3)
+		params := generated.SearchForTransactionsParams{Limit: &limit}
+		for _, token := range []string{"default-token", "dev-token"} {
+			rec := serve(token, func(ctx echo.Context) error {
+				return si.SearchForTransactions(ctx, params)
+			})
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+		assert.Equal(t, []uint64{maxTransactionsLimit, maxTransactionsLimit * 2}, limits)
+	})
+
+	t.Run("Round rewind", func(t *testing.T) {
+		ch := make(chan idb.AccountRow)
+		close(ch)
+		var outCh <-chan idb.AccountRow = ch
+
+		db := &mocks.IndexerDb{}
+		db.On("GetAccounts", mock.Anything, mock.Anything).Return(outCh, uint64(7))
+		si := ServerImplementation{db: db}
+
+		round := uint64(5)
+		params := generated.SearchForAccountsParams{Round: &round}
+		handler := func(ctx echo.Context) error {
+			return si.SearchForAccounts(ctx, params)
+		}
+		assert.Equal(t, http.StatusBadRequest, serve("default-token", handler).Code)
+		assert.Equal(t, http.StatusOK, serve("dev-token", handler).Code)
+	})
+}
+
+func TestConfiguredLimits(t *testing.T) {
+	si := ServerImplementation{
+		Limits: map[string]EndpointLimit{
+			accountsLimitName: {Default: 5, Max: 20},
+		},
+	}
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+	assert.Equal(t, uint64(5), si.limit(ctx, accountsLimitName, nil))
+	assert.Equal(t, uint64(10), si.limit(ctx, accountsLimitName, uint64Ptr(10)))
+	assert.Equal(t, uint64(20), si.limit(ctx, accountsLimitName, uint64Ptr(100)))
+
+	// Limits which aren't configured use the defaults.
+	assert.Equal(t, uint64(defaultAssetsLimit), si.limit(ctx, assetsLimitName, nil))
+	assert.Equal(t, uint64(maxAssetsLimit), si.limit(ctx, assetsLimitName, uint64Ptr(maxAssetsLimit+1)))
+}
+
+func TestSearchForApplicationsLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested *uint64
+		expected  uint64
+	}{
+		{name: "Default", requested: nil, expected: defaultApplicationsLimit},
+		{name: "Requested", requested: uint64Ptr(10), expected: 10},
+		{name: "Above maximum", requested: uint64Ptr(maxApplicationsLimit + 1), expected: maxApplicationsLimit},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ch := make(chan idb.ApplicationRow)
+			close(ch)
+			var outCh <-chan idb.ApplicationRow = ch
+
+			db := &mocks.IndexerDb{}
+			hasLimit := mock.MatchedBy(func(params *generated.SearchForApplicationsParams) bool {
+				return params.Limit != nil && *params.Limit == test.expected
+			})
+			db.On("Applications", mock.Anything, hasLimit).Return(outCh, uint64(7))
+			si := ServerImplementation{db: db}
+
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+			require.NoError(t, si.SearchForApplications(ctx, generated.SearchForApplicationsParams{Limit: test.requested}))
+			db.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDBErrorStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"Internal", errors.New("failure"), http.StatusInternalServerError},
+		{"Too expensive", fmt.Errorf("txn query err %w", idb.ErrorQueryTooExpensive), http.StatusBadRequest},
+		{"Timeout", fmt.Errorf("txn query err %w", idb.ErrorQueryTimeout), http.StatusServiceUnavailable},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+			assert.NoError(t, dbError(ctx, test.err, test.err.Error()))
+			assert.Equal(t, test.code, rec.Code)
+		})
+	}
+}
+
+func TestLookupAccountChanges(t *testing.T) {
+	addr := "PT4K5LK4KYIQYYRAYPAZIEF47NVEQRDX3CPYWJVH25LKO2METIRBKRHRAE"
+	decoded, err := sdk_types.DecodeAddress(addr)
+	require.NoError(t, err)
+
+	ch := make(chan idb.AccountChangeRow, 2)
+	ch <- idb.AccountChangeRow{
+		Round: 5,
+		Change: idb.AccountChange{
+			BalanceDecrease: 1000,
+			Rekeyed:         true,
+			AuthAddr:        decoded,
+			Assets:          []idb.AccountAssetChange{{AssetID: 7, AmountIncrease: 3, OptedIn: true, Freeze: true}},
+		},
+	}
+	ch <- idb.AccountChangeRow{
+		Round:  8,
+		Change: idb.AccountChange{Apps: []idb.AccountAppChange{{AppID: 9, ClosedOut: true}}},
+	}
+	close(ch)
+	var outCh <-chan idb.AccountChangeRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("AccountChanges", mock.Anything, mock.MatchedBy(func(query idb.AccountChangesQuery) bool {
+		return bytes.Equal(query.Address, decoded[:]) && query.MinRound == 2 && *query.PrevRound == 4 && query.Limit == defaultChangesLimit
+	})).Return(outCh, uint64(10))
+	si := ServerImplementation{db: db}
+
+	serve := func(accountID string, params generated.LookupAccountChangesParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.LookupAccountChanges(ctx, accountID, params))
+		return rec
+	}
+
+	rec := serve(addr, generated.LookupAccountChangesParams{MinRound: uint64Ptr(2), Next: strPtr("4")})
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response generated.AccountChangesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint64(10), response.CurrentRound)
+	assert.Equal(t, "8", *response.NextToken)
+	require.Len(t, response.Changes, 2)
+
+	first := response.Changes[0]
+	assert.Equal(t, uint64(5), first.Round)
+	assert.Equal(t, uint64(1000), *first.BalanceDecrease)
+	assert.Nil(t, first.BalanceIncrease)
+	assert.True(t, *first.Rekeyed)
+	assert.Equal(t, addr, *first.AuthAddr)
+	require.Len(t, *first.Assets, 1)
+	asset := (*first.Assets)[0]
+	assert.Equal(t, uint64(7), asset.AssetId)
+	assert.Equal(t, uint64(3), *asset.AmountIncrease)
+	assert.True(t, *asset.OptedIn)
+	assert.False(t, *asset.IsFrozen)
+	assert.Nil(t, asset.CloseTo)
+
+	second := response.Changes[1]
+	assert.Nil(t, second.Assets)
+	assert.Equal(t, []generated.AccountAppChange{{AppId: 9, ClosedOut: boolPtr(true)}}, *second.Apps)
+	db.AssertExpectations(t)
+
+	assert.Equal(t, http.StatusBadRequest, serve("invalid", generated.LookupAccountChangesParams{}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(addr, generated.LookupAccountChangesParams{Next: strPtr("x")}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(addr, generated.LookupAccountChangesParams{MinRound: uint64Ptr(5), MaxRound: uint64Ptr(4)}).Code)
+}
+
+func TestLookupAccountRekeys(t *testing.T) {
+	addr := "PT4K5LK4KYIQYYRAYPAZIEF47NVEQRDX3CPYWJVH25LKO2METIRBKRHRAE"
+	decoded, err := sdk_types.DecodeAddress(addr)
+	require.NoError(t, err)
+	authAddr := "GJR76Q6OXNZ2CYIVCFCDTJRBAAR6TYEJJENEII3G2U3JH546SPBQA62IFY"
+	decodedAuth, err := sdk_types.DecodeAddress(authAddr)
+	require.NoError(t, err)
+
+	ch := make(chan idb.AccountRekeyRow, 2)
+	ch <- idb.AccountRekeyRow{Rekey: idb.AccountRekey{Round: 5, Intra: 2, Txid: "TX1", NewAuthAddr: decodedAuth[:]}}
+	ch <- idb.AccountRekeyRow{Rekey: idb.AccountRekey{Round: 8, Intra: 0, Txid: "TX2", OldAuthAddr: decodedAuth[:]}}
+	close(ch)
+	var outCh <-chan idb.AccountRekeyRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("AccountRekeys", mock.Anything, mock.MatchedBy(func(query idb.AccountRekeysQuery) bool {
+		return bytes.Equal(query.Address, decoded[:]) && *query.PrevRound == 4 && query.PrevIntra == 1 && query.Limit == defaultRekeysLimit
+	})).Return(outCh, uint64(10))
+	si := ServerImplementation{db: db}
+
+	serve := func(accountID string, params generated.LookupAccountRekeysParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.LookupAccountRekeys(ctx, accountID, params))
+		return rec
+	}
+
+	rec := serve(addr, generated.LookupAccountRekeysParams{Next: strPtr("4:1")})
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response generated.AccountRekeysResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint64(10), response.CurrentRound)
+	assert.Equal(t, "8:0", *response.NextToken)
+	assert.Equal(t, []generated.AccountRekey{
+		{Round: 5, Txid: "TX1", OldAuthAddr: addr, NewAuthAddr: authAddr},
+		{Round: 8, Txid: "TX2", OldAuthAddr: authAddr, NewAuthAddr: addr},
+	}, response.Rekeys)
+	db.AssertExpectations(t)
+
+	assert.Equal(t, http.StatusBadRequest, serve("invalid", generated.LookupAccountRekeysParams{}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(addr, generated.LookupAccountRekeysParams{Next: strPtr("4")}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(addr, generated.LookupAccountRekeysParams{MinRound: uint64Ptr(5), MaxRound: uint64Ptr(4)}).Code)
+}
+
+func TestSearchForAccountsAuthAddrHistory(t *testing.T) {
+	addr := "PT4K5LK4KYIQYYRAYPAZIEF47NVEQRDX3CPYWJVH25LKO2METIRBKRHRAE"
+	decoded, err := sdk_types.DecodeAddress(addr)
+	require.NoError(t, err)
+
+	ch := make(chan idb.AccountRow)
+	close(ch)
+	var outCh <-chan idb.AccountRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("GetAccounts", mock.Anything, mock.MatchedBy(func(options idb.AccountQueryOptions) bool {
+		return bytes.Equal(options.AuthAddrHistory, decoded[:])
+	})).Return(outCh, uint64(10))
+	si := ServerImplementation{db: db, EnableAddressSearchRoundRewind: true}
+
+	serve := func(params generated.SearchForAccountsParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.SearchForAccounts(ctx, params))
+		return rec
+	}
+
+	rec := serve(generated.SearchForAccountsParams{AuthAddrHistory: strPtr(addr)})
+	require.Equal(t, http.StatusOK, rec.Code)
+	db.AssertExpectations(t)
+
+	assert.Equal(t, http.StatusBadRequest, serve(generated.SearchForAccountsParams{AuthAddrHistory: strPtr("invalid")}).Code)
+	rec = serve(generated.SearchForAccountsParams{AuthAddrHistory: strPtr(addr), Round: uint64Ptr(5)})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), errClosedOrRekeyedWithRound)
+}
+
+func TestSearchForAccountsOrderByBalance(t *testing.T) {
+	addr := "PT4K5LK4KYIQYYRAYPAZIEF47NVEQRDX3CPYWJVH25LKO2METIRBKRHRAE"
+	decoded, err := sdk_types.DecodeAddress(addr)
+	require.NoError(t, err)
+
+	ch := make(chan idb.AccountRow, 1)
+	ch <- idb.AccountRow{Account: generated.Account{Address: addr, AmountWithoutPendingRewards: 500}}
+	close(ch)
+	var outCh <-chan idb.AccountRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("GetSpecialAccounts").Return(idb.SpecialAccounts{}, nil)
+	db.On("GetAccounts", mock.Anything, mock.MatchedBy(func(options idb.AccountQueryOptions) bool {
+		return options.OrderByBalance && options.AfterBalance.Amount == 1000 &&
+			bytes.Equal(options.AfterBalance.Address, decoded[:]) && options.GreaterThanAddress == nil
+	})).Return(outCh, uint64(10))
+	si := ServerImplementation{db: db, EnableAddressSearchRoundRewind: true}
+
+	serve := func(params generated.SearchForAccountsParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.SearchForAccounts(ctx, params))
+		return rec
+	}
+
+	rec := serve(generated.SearchForAccountsParams{Order: strPtr("balance-desc"), Next: strPtr("1000:" + addr)})
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response generated.AccountsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Accounts, 1)
+	assert.Equal(t, "500:"+addr, *response.NextToken)
+	db.AssertExpectations(t)
+
+	assert.Equal(t, http.StatusBadRequest, serve(generated.SearchForAccountsParams{Order: strPtr("amount-desc")}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(generated.SearchForAccountsParams{Order: strPtr("balance-desc"), Next: strPtr(addr)}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(generated.SearchForAccountsParams{Order: strPtr("balance-desc"), Round: uint64Ptr(5)}).Code)
+}
+
+func TestSearchForAccountsParticipation(t *testing.T) {
+	ch := make(chan idb.AccountRow)
+	close(ch)
+	var outCh <-chan idb.AccountRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("GetAccounts", mock.Anything, mock.MatchedBy(func(options idb.AccountQueryOptions) bool {
+		return options.Status != nil && *options.Status == 2 &&
+			*options.VoteLastValidBefore == 100 && *options.VoteLastValidAfter == 50
+	})).Return(outCh, uint64(10))
+	si := ServerImplementation{db: db, EnableAddressSearchRoundRewind: true}
+
+	serve := func(params generated.SearchForAccountsParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.SearchForAccounts(ctx, params))
+		return rec
+	}
+
+	rec := serve(generated.SearchForAccountsParams{
+		Status:              strPtr("Not-Participating"),
+		VoteLastValidBefore: uint64Ptr(100),
+		VoteLastValidAfter:  uint64Ptr(50),
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	db.AssertExpectations(t)
+
+	rec = serve(generated.SearchForAccountsParams{Status: strPtr("asleep")})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), errUnknownStatus)
+	assert.Equal(t, http.StatusBadRequest, serve(generated.SearchForAccountsParams{VoteLastValidBefore: uint64Ptr(100), Round: uint64Ptr(5)}).Code)
+}
+
+func TestSearchForAccountsMinBalance(t *testing.T) {
+	ch := make(chan idb.AccountRow)
+	close(ch)
+	var outCh <-chan idb.AccountRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("GetAccounts", mock.Anything, mock.MatchedBy(func(options idb.AccountQueryOptions) bool {
+		return *options.MinBalanceGreaterThan == 100000 && *options.MinBalanceLessThan == 500000
+	})).Return(outCh, uint64(10))
+	si := ServerImplementation{db: db, EnableAddressSearchRoundRewind: true}
+
+	serve := func(params generated.SearchForAccountsParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.SearchForAccounts(ctx, params))
+		return rec
+	}
+
+	rec := serve(generated.SearchForAccountsParams{
+		MinBalanceGreaterThan: uint64Ptr(100000),
+		MinBalanceLessThan:    uint64Ptr(500000),
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	db.AssertExpectations(t)
+
+	assert.Equal(t, http.StatusBadRequest, serve(generated.SearchForAccountsParams{MinBalanceGreaterThan: uint64Ptr(100000), Round: uint64Ptr(5)}).Code)
+}
+
+func TestSearchForAccountsKeyTypeAndRounds(t *testing.T) {
+	ch := make(chan idb.AccountRow)
+	close(ch)
+	var outCh <-chan idb.AccountRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("GetAccounts", mock.Anything, mock.MatchedBy(func(options idb.AccountQueryOptions) bool {
+		return options.SigType == "msig" &&
+			*options.CreatedAfterRound == 100 && *options.CreatedBeforeRound == 200 &&
+			*options.ClosedAfterRound == 300 && *options.ClosedBeforeRound == 400 &&
+			*options.IsRekeyed
+	})).Return(outCh, uint64(10))
+	si := ServerImplementation{db: db, EnableAddressSearchRoundRewind: true}
+
+	serve := func(params generated.SearchForAccountsParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.SearchForAccounts(ctx, params))
+		return rec
+	}
+
+	rec := serve(generated.SearchForAccountsParams{
+		SigType:            strPtr("MSIG"),
+		CreatedAfterRound:  uint64Ptr(100),
+		CreatedBeforeRound: uint64Ptr(200),
+		ClosedAfterRound:   uint64Ptr(300),
+		ClosedBeforeRound:  uint64Ptr(400),
+		IsRekeyed:          boolPtr(true),
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	db.AssertExpectations(t)
+
+	rec = serve(generated.SearchForAccountsParams{SigType: strPtr("multisig")})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), errUnknownSigType)
+	rec = serve(generated.SearchForAccountsParams{IsRekeyed: boolPtr(false), Round: uint64Ptr(5)})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), errClosedOrRekeyedWithRound)
+}
+
+func TestSearchForAccountsMultisigKey(t *testing.T) {
+	ch := make(chan idb.AccountRow)
+	close(ch)
+	var outCh <-chan idb.AccountRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("GetAccounts", mock.Anything, mock.MatchedBy(func(options idb.AccountQueryOptions) bool {
+		return bytes.Equal(options.MultisigKey, []byte{1, 2, 3})
+	})).Return(outCh, uint64(10))
+	si := ServerImplementation{db: db}
+
+	serve := func(params generated.SearchForAccountsParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.SearchForAccounts(ctx, params))
+		return rec
+	}
+
+	rec := serve(generated.SearchForAccountsParams{MultisigPublicKey: strPtr("AQID")})
+	require.Equal(t, http.StatusOK, rec.Code)
+	db.AssertExpectations(t)
+
+	rec = serve(generated.SearchForAccountsParams{MultisigPublicKey: strPtr("%%")})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), errUnableToParseBase64)
+}
+
+func TestLookupAssetBalancesOrderByAmount(t *testing.T) {
+	addr := "PT4K5LK4KYIQYYRAYPAZIEF47NVEQRDX3CPYWJVH25LKO2METIRBKRHRAE"
+	decoded, err := sdk_types.DecodeAddress(addr)
+	require.NoError(t, err)
+
+	ch := make(chan idb.AssetBalanceRow, 1)
+	ch <- idb.AssetBalanceRow{Address: decoded[:], AssetID: 7, Amount: 30}
+	close(ch)
+	var outCh <-chan idb.AssetBalanceRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("AssetBalances", mock.Anything, mock.MatchedBy(func(query idb.AssetBalanceQuery) bool {
+		return query.AssetID == 7 && query.OrderByAmount && query.AfterAmount.Amount == 40 &&
+			bytes.Equal(query.AfterAmount.Address, decoded[:]) && query.PrevAddress == nil
+	})).Return(outCh, uint64(10))
+	si := ServerImplementation{db: db}
+
+	serve := func(params generated.LookupAssetBalancesParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.LookupAssetBalances(ctx, 7, params))
+		return rec
+	}
+
+	rec := serve(generated.LookupAssetBalancesParams{Order: strPtr("amount-desc"), Next: strPtr("40:" + addr)})
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response generated.AssetBalancesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Balances, 1)
+	assert.Equal(t, "30:"+addr, *response.NextToken)
+	db.AssertExpectations(t)
+
+	assert.Equal(t, http.StatusBadRequest, serve(generated.LookupAssetBalancesParams{Order: strPtr("balance-desc")}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(generated.LookupAssetBalancesParams{Order: strPtr("amount-desc"), Next: strPtr("x:" + addr)}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(generated.LookupAssetBalancesParams{Next: strPtr("invalid")}).Code)
+}
+
+func TestLookupAssetStats(t *testing.T) {
+	db := &mocks.IndexerDb{}
+	db.On("AssetStats", mock.Anything, idb.AssetStatsQuery{AssetID: 7, MinRound: 2}).
+		Return(idb.AssetStats{AssetID: 7, Holders: 3, OptedIn: 4, Total: 100, CirculatingSupply: 60, Transfers: 5, Volume: 70}, uint64(10), nil)
+	db.On("AssetStats", mock.Anything, idb.AssetStatsQuery{AssetID: 8}).
+		Return(idb.AssetStats{}, uint64(10), idb.ErrorAssetNotFound)
+	si := ServerImplementation{db: db}
+
+	serve := func(assetID uint64, params generated.LookupAssetStatsParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.LookupAssetStats(ctx, assetID, params))
+		return rec
+	}
+
+	rec := serve(7, generated.LookupAssetStatsParams{MinRound: uint64Ptr(2)})
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response generated.AssetStatsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint64(10), response.CurrentRound)
+	assert.Equal(t, generated.AssetStats{
+		AssetId:           7,
+		Holders:           3,
+		OptedIn:           4,
+		Total:             100,
+		CirculatingSupply: 60,
+		Transfers:         5,
+		Volume:            70,
+		Deleted:           boolPtr(false),
+	}, response.Stats)
+
+	assert.Equal(t, http.StatusNotFound, serve(8, generated.LookupAssetStatsParams{}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(7, generated.LookupAssetStatsParams{MinRound: uint64Ptr(5), MaxRound: uint64Ptr(4)}).Code)
+	db.AssertExpectations(t)
+}
+
+func TestLookupTransactionStats(t *testing.T) {
+	day := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	ch := make(chan idb.TransactionStatsRow, 1)
+	ch <- idb.TransactionStatsRow{
+		Day:             day,
+		MinRound:        100,
+		MaxRound:        200,
+		Counts:          map[int]uint64{idb.TypeEnumPay: 5, idb.TypeEnumApplication: 2},
+		Fees:            7000,
+		ActiveAddresses: 3,
+	}
+	close(ch)
+	var outCh <-chan idb.TransactionStatsRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("TransactionStats", mock.Anything, mock.MatchedBy(func(query idb.TransactionStatsQuery) bool {
+		return query.MinRound == 50 && query.AfterDay.Equal(day.AddDate(0, 0, -1)) && query.Limit == defaultStatsLimit
+	})).Return(outCh, uint64(300))
+	si := ServerImplementation{db: db}
+
+	serve := func(params generated.LookupTransactionStatsParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NoError(t, si.LookupTransactionStats(ctx, params))
+		return rec
+	}
+
+	rec := serve(generated.LookupTransactionStatsParams{Interval: strPtr("day"), MinRound: uint64Ptr(50), Next: strPtr("2021-05-31")})
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response generated.TransactionStatsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint64(300), response.CurrentRound)
+	assert.Equal(t, "2021-06-01", *response.NextToken)
+	require.Len(t, response.Intervals, 1)
+	interval := response.Intervals[0]
+	assert.True(t, day.Equal(interval.Start))
+	assert.Equal(t, generated.TransactionTypeCounts{Pay: 5, Appl: 2}, interval.Counts)
+	assert.Equal(t, uint64(7000), interval.Fees)
+	assert.Equal(t, uint64(3), interval.ActiveAddresses)
+	db.AssertExpectations(t)
+
+	assert.Equal(t, http.StatusBadRequest, serve(generated.LookupTransactionStatsParams{Interval: strPtr("hour")}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(generated.LookupTransactionStatsParams{Next: strPtr("x")}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(generated.LookupTransactionStatsParams{MinRound: uint64Ptr(5), MaxRound: uint64Ptr(4)}).Code)
+}
+
+func TestLookupNetworkTotals(t *testing.T) {
+	db := &mocks.IndexerDb{}
+	db.On("NetworkTotals", mock.Anything).
+		Return(idb.NetworkTotals{Accounts: 10, Assets: 2, Apps: 3, AssetOptIns: 4, AppOptIns: 5}, uint64(7), nil)
+	si := ServerImplementation{db: db}
+
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	require.NoError(t, si.LookupNetworkTotals(ctx, generated.LookupNetworkTotalsParams{}))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.NetworkTotalsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint64(7), response.CurrentRound)
+	assert.Equal(t, generated.NetworkTotals{Accounts: 10, Assets: 2, Apps: 3, AssetOptIns: 4, AppOptIns: 5}, response.Totals)
+}
+
+func TestLookupStakeTotals(t *testing.T) {
+	round := uint64(5)
+	db := &mocks.IndexerDb{}
+	db.On("StakeTotals", mock.Anything, idb.StakeTotalsQuery{Round: &round}).
+		Return(idb.StakeTotals{
+			Round:            4,
+			Online:           idb.ParticipationTotals{Money: 3000000, RewardUnits: 3, Accounts: 1},
+			Offline:          idb.ParticipationTotals{Money: 2500000, RewardUnits: 2, Accounts: 2},
+			NotParticipating: idb.ParticipationTotals{Money: 100, Accounts: 1},
+		}, uint64(7), nil)
+	db.On("StakeTotals", mock.Anything, idb.StakeTotalsQuery{}).
+		Return(idb.StakeTotals{}, uint64(7), idb.ErrorStakeTotalsNotFound)
+	si := ServerImplementation{db: db}
+
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	require.NoError(t, si.LookupStakeTotals(ctx, generated.LookupStakeTotalsParams{Round: &round}))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response generated.StakeTotalsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, uint64(7), response.CurrentRound)
+	assert.Equal(t, generated.StakeTotals{
+		Round:            4,
+		Online:           generated.ParticipationTotals{Money: 3000000, RewardUnits: 3, Accounts: 1},
+		Offline:          generated.ParticipationTotals{Money: 2500000, RewardUnits: 2, Accounts: 2},
+		NotParticipating: generated.ParticipationTotals{Money: 100, Accounts: 1},
+	}, response.Totals)
+
+	rec = httptest.NewRecorder()
+	ctx = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	require.NoError(t, si.LookupStakeTotals(ctx, generated.LookupStakeTotalsParams{}))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
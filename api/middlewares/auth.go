@@ -1,7 +1,6 @@
 package middlewares
 
 import (
-	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"strings"
@@ -16,19 +15,14 @@ type authMiddleware struct {
 	header string
 
 	// Tokens is the set of tokens which can be set to allow access.
-	tokens [][]byte
+	tokens *TokenStore
 }
 
 // MakeAuth constructs the auth middleware function
-func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
-	apiTokenBytes := make([][]byte, 0)
-	for _, token := range tokens {
-		apiTokenBytes = append(apiTokenBytes, []byte(token))
-	}
-
+func MakeAuth(header string, tokens *TokenStore) echo.MiddlewareFunc {
 	auth := authMiddleware{
 		header: header,
-		tokens: apiTokenBytes,
+		tokens: tokens,
 	}
 
 	return auth.handler
@@ -43,15 +37,7 @@ func (auth *authMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 			return next(ctx)
 		}
 
-		// Grab the apiToken from the HTTP header, or as a bearer token
-		providedToken := []byte(ctx.Request().Header.Get(auth.header))
-		if len(providedToken) == 0 {
-			// Accept tokens provided in a bearer token format.
-			authentication := strings.SplitN(ctx.Request().Header.Get("Authorization"), " ", 2)
-			if len(authentication) == 2 && strings.EqualFold("Bearer", authentication[0]) {
-				providedToken = []byte(authentication[1])
-			}
-		}
+		providedToken := []byte(requestToken(ctx, auth.header))
 
 		// Handle debug routes with /urlAuth/:token prefix.
 		if ctx.Param("token") != "" {
@@ -69,13 +55,30 @@ func (auth *authMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 		}
 
 		// Check the tokens in constant time
-		for _, tokenBytes := range auth.tokens {
-			if subtle.ConstantTimeCompare(providedToken, tokenBytes) == 1 {
-				// Token was correct, keep serving request
-				return next(ctx)
-			}
+		token := auth.tokens.lookup(providedToken)
+		if token == nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid API Token")
+		}
+
+		if !token.allowsPath(ctx.Request().URL.Path) {
+			return echo.NewHTTPError(http.StatusForbidden, "API Token is not allowed to access this route")
 		}
 
-		return echo.NewHTTPError(http.StatusUnauthorized, "Invalid API Token")
+		// Token was correct, keep serving request
+		ctx.Set(tokenContextKey, token)
+		return next(ctx)
+	}
+}
+
+// requestToken grabs the apiToken from the HTTP header, or as a bearer token.
+func requestToken(ctx echo.Context, header string) string {
+	providedToken := ctx.Request().Header.Get(header)
+	if len(providedToken) == 0 {
+		// Accept tokens provided in a bearer token format.
+		authentication := strings.SplitN(ctx.Request().Header.Get("Authorization"), " ", 2)
+		if len(authentication) == 2 && strings.EqualFold("Bearer", authentication[0]) {
+			providedToken = authentication[1]
+		}
 	}
+	return providedToken
 }
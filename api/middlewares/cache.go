@@ -0,0 +1,189 @@
+package middlewares
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/version"
+)
+
+const (
+	// immutableCacheControl is used for resources which can never change.
+	immutableCacheControl = "max-age=31536000, immutable"
+
+	// currentRoundCacheControl requires caches to revalidate every request,
+	// which is cheap since the ETag only changes when a new round is committed.
+	currentRoundCacheControl = "no-cache"
+)
+
+// CurrentRoundHeader carries the round of the results in a response. It keys
+// the ETag instead of the notified round, which a read replica may lag behind.
+const CurrentRoundHeader = "X-Indexer-Current-Round"
+
+// immutablePaths are routes which always return the same response once they
+// succeed. Other responses include the current round, so they change with
+// every round even when the results don't. This includes confirmed
+// transactions: /v2/transactions/:txid never changes apart from its
+// current-round, so it is revalidated with a round keyed ETag rather than
+// cached forever with a stale current-round.
+var immutablePaths = map[string]bool{
+	"/v2/blocks/:round-number": true,
+}
+
+// uncachedPaths are routes which stream events as rounds are committed, and
+// the health check which must always reach the server.
+var uncachedPaths = map[string]bool{
+	"/v2/stream/transactions": true,
+	"/health":                 true,
+}
+
+type cacheMiddleware struct {
+	idb idb.IndexerDb
+
+	// visibility is prefixed to the Cache-Control header, "public" or "private".
+	visibility string
+}
+
+// MakeCache constructs the cache middleware. It sets strong ETags and
+// Cache-Control headers on successful responses, and answers requests with a
+// matching If-None-Match header with 304 Not Modified. Responses are marked
+// private when the API requires a token, so that shared caches don't serve
+// them to unauthenticated clients.
+func MakeCache(idb idb.IndexerDb, private bool) echo.MiddlewareFunc {
+	cache := cacheMiddleware{
+		idb:        idb,
+		visibility: "public",
+	}
+	if private {
+		cache.visibility = "private"
+	}
+
+	return cache.handler
+}
+
+// handler computes the ETag of the request before calling the handler, so
+// that unchanged resources are not looked up again.
+func (cache *cacheMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		method := ctx.Request().Method
+		if method != http.MethodGet && method != http.MethodHead || uncachedPaths[ctx.Path()] {
+			return next(ctx)
+		}
+
+		var etag, cacheControl string
+		immutable := immutablePaths[ctx.Path()]
+		if immutable {
+			etag = makeETag(ctx, "")
+			cacheControl = cache.visibility + ", " + immutableCacheControl
+		} else {
+			round, err := cache.currentRound()
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Indexer health error: %s", err.Error()))
+			}
+
+			etag = makeETag(ctx, strconv.FormatUint(round, 10))
+			cacheControl = cache.visibility + ", " + currentRoundCacheControl
+		}
+
+		if etagMatches(ctx.Request().Header.Get("If-None-Match"), etag) {
+			ctx.Response().Header().Set("ETag", etag)
+			ctx.Response().Header().Set("Cache-Control", cacheControl)
+			return ctx.NoContent(http.StatusNotModified)
+		}
+
+		ctx.Response().Writer = &cacheHeaderWriter{
+			ResponseWriter: ctx.Response().Writer,
+			ctx:            ctx,
+			etag:           etag,
+			cacheControl:   cacheControl,
+			roundKeyed:     !immutable,
+		}
+		return next(ctx)
+	}
+}
+
+// currentRound returns the latest round. Backends notified of new rounds
+// answer from memory, so ETags change as soon as a round is committed without
+// querying the database for every request.
+func (cache *cacheMiddleware) currentRound() (uint64, error) {
+	if notifier, ok := cache.idb.(idb.RoundNotifier); ok {
+		return notifier.CurrentRound()
+	}
+	h, err := cache.idb.Health()
+	return h.Round, err
+}
+
+// makeETag derives a strong ETag from the request. The indexer version is
+// included because responses may change between releases.
+func makeETag(ctx echo.Context, round string) string {
+	h := sha256.New()
+	h.Write([]byte(version.Version()))
+	h.Write([]byte{0})
+	h.Write([]byte(ctx.Request().URL.Path))
+	h.Write([]byte{0})
+	// Encode sorts the parameters by key.
+	h.Write([]byte(ctx.QueryParams().Encode()))
+	h.Write([]byte{0})
+	h.Write([]byte(round))
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// etagMatches implements the weak comparison used by If-None-Match.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheHeaderWriter adds the caching headers to successful responses. Errors,
+// for example a transaction which has not been committed yet, must not be
+// cached.
+type cacheHeaderWriter struct {
+	http.ResponseWriter
+	ctx          echo.Context
+	etag         string
+	cacheControl string
+
+	// roundKeyed is set when the ETag includes the round, the round of the
+	// results is used when the response has one.
+	roundKeyed bool
+}
+
+func (w *cacheHeaderWriter) WriteHeader(code int) {
+	if code == http.StatusOK {
+		etag := w.etag
+		if round := w.Header().Get(CurrentRoundHeader); w.roundKeyed && round != "" {
+			etag = makeETag(w.ctx, round)
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", w.cacheControl)
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Unwrap returns the underlying writer, so that handlers can reach the
+// connection, for example to clear the write deadline of a stream.
+func (w *cacheHeaderWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Flush implements http.Flusher when the underlying writer does.
+func (w *cacheHeaderWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,206 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/idb/mocks"
+)
+
+func ok(ctx echo.Context) error {
+	return ctx.String(http.StatusOK, "ok")
+}
+
+func notFound(ctx echo.Context) error {
+	return ctx.String(http.StatusNotFound, "not found")
+}
+
+func serveCached(t *testing.T, db idb.IndexerDb, path, url, ifNoneMatch string, next echo.HandlerFunc) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+	ctx.SetPath(path)
+
+	require.NoError(t, MakeCache(db, false)(next)(ctx))
+	return rec
+}
+
+func TestCacheImmutable(t *testing.T) {
+	// Health must not be called for immutable resources.
+	db := &mocks.IndexerDb{}
+
+	rec := serveCached(t, db, "/v2/blocks/:round-number", "/v2/blocks/10", "", ok)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	assert.Equal(t, "public, "+immutableCacheControl, rec.Header().Get("Cache-Control"))
+
+	rec = serveCached(t, db, "/v2/blocks/:round-number", "/v2/blocks/10", etag, notFound)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+
+	rec = serveCached(t, db, "/v2/blocks/:round-number", "/v2/blocks/11", etag, ok)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+}
+
+func TestCacheErrorNotCached(t *testing.T) {
+	db := &mocks.IndexerDb{}
+
+	rec := serveCached(t, db, "/v2/blocks/:round-number", "/v2/blocks/10", "", notFound)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Empty(t, rec.Header().Get("ETag"))
+	assert.Empty(t, rec.Header().Get("Cache-Control"))
+}
+
+func TestCacheCurrentRound(t *testing.T) {
+	db := &mocks.IndexerDb{}
+	db.On("Health").Return(idb.Health{Round: 100}, nil).Once()
+	db.On("Health").Return(idb.Health{Round: 101}, nil)
+
+	rec := serveCached(t, db, "/v2/transactions", "/v2/transactions?limit=1", "", ok)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "public, "+currentRoundCacheControl, rec.Header().Get("Cache-Control"))
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	// A new round has been committed, so the ETag no longer matches.
+	rec = serveCached(t, db, "/v2/transactions", "/v2/transactions?limit=1", etag, ok)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+
+	rec = serveCached(t, db, "/v2/transactions", "/v2/transactions?limit=1", rec.Header().Get("ETag"), ok)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+}
+
+func TestCacheHistoricalRound(t *testing.T) {
+	// Responses pinned to a round still include the current round.
+	db := &mocks.IndexerDb{}
+	db.On("Health").Return(idb.Health{Round: 100}, nil).Once()
+	db.On("Health").Return(idb.Health{Round: 101}, nil)
+
+	rec := serveCached(t, db, "/v2/transactions", "/v2/transactions?round=50", "", ok)
+	assert.Equal(t, "public, "+currentRoundCacheControl, rec.Header().Get("Cache-Control"))
+	etag := rec.Header().Get("ETag")
+
+	rec = serveCached(t, db, "/v2/transactions", "/v2/transactions?round=50", etag, ok)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+
+	rec = serveCached(t, db, "/v2/transactions/:txid", "/v2/transactions/ABC", "", ok)
+	assert.Equal(t, "public, "+currentRoundCacheControl, rec.Header().Get("Cache-Control"))
+}
+
+func TestCacheStreamNotCached(t *testing.T) {
+	// Health must not be called for streams.
+	db := &mocks.IndexerDb{}
+
+	rec := serveCached(t, db, "/v2/stream/transactions", "/v2/stream/transactions", "", ok)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("ETag"))
+	assert.Empty(t, rec.Header().Get("Cache-Control"))
+}
+
+func TestCacheHealthNotCached(t *testing.T) {
+	// Health must only be called by the health check itself.
+	db := &mocks.IndexerDb{}
+
+	rec := serveCached(t, db, "/health", "/health", "", ok)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("ETag"))
+	assert.Empty(t, rec.Header().Get("Cache-Control"))
+}
+
+func TestCacheHealthError(t *testing.T) {
+	db := &mocks.IndexerDb{}
+	db.On("Health").Return(idb.Health{}, errors.New("no database"))
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/transactions", nil)
+	ctx := e.NewContext(req, httptest.NewRecorder())
+	ctx.SetPath("/v2/transactions")
+
+	err := MakeCache(db, false)(ok)(ctx)
+	var httpErr *echo.HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusInternalServerError, httpErr.Code)
+	assert.Equal(t, "Indexer health error: no database", httpErr.Message)
+}
+
+func TestCachePrivate(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v2/blocks/10", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+	ctx.SetPath("/v2/blocks/:round-number")
+
+	require.NoError(t, MakeCache(&mocks.IndexerDb{}, true)(ok)(ctx))
+	assert.Equal(t, "private, "+immutableCacheControl, rec.Header().Get("Cache-Control"))
+}
+
+func TestETagMatches(t *testing.T) {
+	assert.False(t, etagMatches("", `"a"`))
+	assert.True(t, etagMatches(`"a"`, `"a"`))
+	assert.True(t, etagMatches(`"b", W/"a"`, `"a"`))
+	assert.True(t, etagMatches("*", `"a"`))
+	assert.False(t, etagMatches(`"b"`, `"a"`))
+}
+
+// notifiedDb is an IndexerDb which is notified of new rounds.
+type notifiedDb struct {
+	mocks.IndexerDb
+	round uint64
+}
+
+func (db *notifiedDb) CurrentRound() (uint64, error) {
+	return db.round, nil
+}
+
+func (db *notifiedDb) SubscribeRounds(ctx context.Context) <-chan uint64 {
+	return nil
+}
+
+func TestCacheNotifiedRound(t *testing.T) {
+	// Health must not be called when the round is known from notifications.
+	db := &notifiedDb{round: 100}
+
+	rec := serveCached(t, db, "/v2/transactions", "/v2/transactions", "", ok)
+	etag := rec.Header().Get("ETag")
+
+	db.round = 101
+	rec = serveCached(t, db, "/v2/transactions", "/v2/transactions", etag, ok)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+}
+
+func TestCacheResponseRound(t *testing.T) {
+	// A read replica answers for an older round than the notified one.
+	db := &notifiedDb{round: 101}
+	lagging := func(ctx echo.Context) error {
+		ctx.Response().Header().Set(CurrentRoundHeader, "100")
+		return ok(ctx)
+	}
+
+	rec := serveCached(t, db, "/v2/transactions", "/v2/transactions", "", lagging)
+	etag := rec.Header().Get("ETag")
+	rec = serveCached(t, db, "/v2/transactions", "/v2/transactions", "", ok)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+
+	// The stale response doesn't match the notified round.
+	rec = serveCached(t, db, "/v2/transactions", "/v2/transactions", etag, ok)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// The ETag matches while the notified round is that of the response.
+	db.round = 100
+	rec = serveCached(t, db, "/v2/transactions", "/v2/transactions", etag, notFound)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+}
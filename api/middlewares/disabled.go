@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DisabledEndpointError is returned for endpoints which have been disabled.
+var DisabledEndpointError = "This endpoint has been disabled on this server."
+
+type disabledMiddleware struct {
+	// routes are the URL path patterns of the disabled endpoints.
+	routes []string
+}
+
+// MakeDisabled constructs the middleware which turns off endpoints matching
+// any of the URL path patterns, see matchesRoute for the pattern syntax.
+// Disabled endpoints return 501 Not Implemented so that clients can tell them
+// apart from missing resources.
+func MakeDisabled(routes []string) echo.MiddlewareFunc {
+	disabled := disabledMiddleware{
+		routes: routes,
+	}
+
+	return disabled.handler
+}
+
+// handler returns a 501 if the endpoint is disabled.
+func (disabled *disabledMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		if matchesRoute(disabled.routes, ctx.Request().URL.Path) {
+			return echo.NewHTTPError(http.StatusNotImplemented, DisabledEndpointError)
+		}
+		return next(ctx)
+	}
+}
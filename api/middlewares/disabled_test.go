@@ -0,0 +1,44 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisabled(t *testing.T) {
+	disabled := MakeDisabled([]string{"/v2/assets/*/balances", "/v2/export/*"})
+
+	tests := []struct {
+		path     string
+		disabled bool
+	}{
+		{"/v2/assets/10/balances", true},
+		{"/v2/assets/10/transactions", false},
+		{"/v2/export/transactions.csv", true},
+		{"/v2/transactions", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			ctx := e.NewContext(httptest.NewRequest(http.MethodGet, test.path, nil), httptest.NewRecorder())
+			err := disabled(ok)(ctx)
+			if !test.disabled {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, http.StatusNotImplemented, err.(*echo.HTTPError).Code)
+		})
+	}
+}
+
+func TestValidateRoute(t *testing.T) {
+	assert.NoError(t, ValidateRoute("/v2/accounts"))
+	assert.NoError(t, ValidateRoute("/v2/assets/*/balances"))
+	assert.Error(t, ValidateRoute("/v2/[a"))
+}
@@ -35,10 +35,16 @@ func (logger *loggerMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc
 			ctx.Error(err)
 		}
 
+		// The authenticated token name takes the place of the user.
+		user := "-"
+		if token := GetToken(ctx); token != nil && token.Name != "" {
+			user = token.Name
+		}
+
 		logger.log.Infof("%s %s %s [%v] \"%s %s %s\" %d %s \"%s\" %s",
 			req.RemoteAddr,
 			"-",
-			"-",
+			user,
 			start,
 			req.Method,
 			req.RequestURI,
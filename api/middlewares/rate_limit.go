@@ -0,0 +1,240 @@
+package middlewares
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RateLimitedError is the error returned when a client has exceeded its rate limit.
+var RateLimitedError = "Rate limit exceeded, try again later."
+
+// bucketIdleTimeout is how long an unused bucket is kept before being removed.
+const bucketIdleTimeout = 10 * time.Minute
+
+// rateLimitRequests counts the requests checked by the rate limiter.
+var rateLimitRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: "indexer_api",
+		Name:      "rate_limit_requests_total",
+		Help:      "Requests checked by the rate limiter by limit (token, ip) and result (allowed, rejected).",
+	},
+	[]string{"limit", "result"})
+
+// Limit is a token bucket configuration. Requests are allowed while the bucket
+// holds enough units for the weight of the request, and the bucket refills at
+// Rate units per second up to Burst units.
+type Limit struct {
+	Rate  float64
+	Burst float64
+}
+
+// enabled returns true if the limit should be enforced.
+func (l Limit) enabled() bool {
+	return l.Rate > 0
+}
+
+// withDefaultBurst allows one second worth of requests when no burst is set.
+func (l Limit) withDefaultBurst() Limit {
+	if l.Burst <= 0 {
+		l.Burst = math.Max(l.Rate, 1)
+	}
+	return l
+}
+
+// RateLimitConfig configures the rate limiting middleware.
+type RateLimitConfig struct {
+	// TokenHeader is the header which may contain an API token.
+	TokenHeader string
+
+	// Token is the default limit for requests made with a known API token.
+	// Tokens may override it with their own limit.
+	Token Limit
+
+	// IP is the limit for requests made without a known API token.
+	IP Limit
+
+	// TrustProxyHeaders uses X-Forwarded-For and X-Real-IP to find the client
+	// IP address. Only enable it when indexer is behind a proxy which sets them.
+	TrustProxyHeaders bool
+
+	// RewindWeight is the cost of requesting accounts at a particular round.
+	RewindWeight float64
+
+	// AssetWeight is the cost of searching by asset, which is not indexed by default.
+	AssetWeight float64
+}
+
+// Enabled returns true if the default token limit or the IP limit is configured.
+func (config RateLimitConfig) Enabled() bool {
+	return config.Token.enabled() || config.IP.enabled()
+}
+
+type tokenBucket struct {
+	units float64
+	last  time.Time
+}
+
+// take removes n units from the bucket if there are enough, otherwise it
+// returns how long to wait until there will be.
+func (b *tokenBucket) take(now time.Time, limit Limit, n float64) (bool, time.Duration) {
+	b.units = math.Min(limit.Burst, b.units+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+
+	// A request heavier than the burst is allowed once the bucket is full.
+	n = math.Min(n, limit.Burst)
+	if b.units >= n {
+		b.units -= n
+		return true, 0
+	}
+	return false, time.Duration((n - b.units) / limit.Rate * float64(time.Second))
+}
+
+type rateLimitMiddleware struct {
+	config RateLimitConfig
+
+	// tokens are the API tokens which get their own bucket, requests with
+	// any other token are limited by IP.
+	tokens *TokenStore
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	nextSweep time.Time
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// MakeRateLimit constructs the rate limit middleware. Requests made with one of
+// the API tokens are limited per token, all others are limited per client IP.
+func MakeRateLimit(config RateLimitConfig, tokens *TokenStore) echo.MiddlewareFunc {
+	return makeRateLimit(config, tokens).handler
+}
+
+func makeRateLimit(config RateLimitConfig, tokens *TokenStore) *rateLimitMiddleware {
+	// register metric with global prometheus metrics handler
+	prometheus.Register(rateLimitRequests)
+
+	config.Token = config.Token.withDefaultBurst()
+	config.IP = config.IP.withDefaultBurst()
+
+	return &rateLimitMiddleware{
+		config:  config,
+		tokens:  tokens,
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+// handler returns a 429 if the client has exceeded its limit.
+func (rl *rateLimitMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		// OPTIONS requests are never limited
+		if ctx.Request().Method == "OPTIONS" {
+			return next(ctx)
+		}
+
+		kind, key, limit := rl.limitFor(ctx)
+		if !limit.enabled() {
+			return next(ctx)
+		}
+
+		ok, wait := rl.take(kind+":"+key, limit, rl.requestWeight(ctx))
+		if !ok {
+			rateLimitRequests.WithLabelValues(kind, "rejected").Inc()
+			seconds := int64(math.Max(1, math.Ceil(wait.Seconds())))
+			ctx.Response().Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+			return echo.NewHTTPError(http.StatusTooManyRequests, RateLimitedError)
+		}
+
+		rateLimitRequests.WithLabelValues(kind, "allowed").Inc()
+		return next(ctx)
+	}
+}
+
+// limitFor returns the kind of limit, the bucket key and limit used for a request.
+func (rl *rateLimitMiddleware) limitFor(ctx echo.Context) (string, string, Limit) {
+	// Buckets are keyed by name so that they survive rotating the secret.
+	if token := rl.tokens.lookup([]byte(requestToken(ctx, rl.config.TokenHeader))); token != nil {
+		if token.RateLimit != nil {
+			return "token", token.Name, token.RateLimit.withDefaultBurst()
+		}
+		return "token", token.Name, rl.config.Token
+	}
+
+	return "ip", rl.clientIP(ctx), rl.config.IP
+}
+
+func (rl *rateLimitMiddleware) clientIP(ctx echo.Context) string {
+	if rl.config.TrustProxyHeaders {
+		return ctx.RealIP()
+	}
+	host, _, err := net.SplitHostPort(ctx.Request().RemoteAddr)
+	if err != nil {
+		return ctx.Request().RemoteAddr
+	}
+	return host
+}
+
+// requestWeight returns the cost of a request, expensive queries use more of
+// the client's limit.
+func (rl *rateLimitMiddleware) requestWeight(ctx echo.Context) float64 {
+	weight := 1.0
+
+	switch ctx.Path() {
+	case "/v2/accounts", "/v2/accounts/:account-id":
+		if ctx.QueryParam("round") != "" {
+			weight = math.Max(weight, rl.config.RewindWeight)
+		}
+	}
+
+	switch ctx.Path() {
+	case "/v2/assets/:asset-id/transactions", "/v2/assets/:asset-id/balances", "/v2/export/assets/:asset-id/balances.csv":
+		weight = math.Max(weight, rl.config.AssetWeight)
+	case "/v2/accounts", "/v2/transactions", "/v2/export/transactions.csv":
+		if ctx.QueryParam("asset-id") != "" {
+			weight = math.Max(weight, rl.config.AssetWeight)
+		}
+	}
+
+	return weight
+}
+
+// take removes n units from the bucket identified by key.
+func (rl *rateLimitMiddleware) take(key string, limit Limit, n float64) (bool, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	rl.sweep(now)
+
+	bucket, ok := rl.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{
+			units: limit.Burst,
+			last:  now,
+		}
+		rl.buckets[key] = bucket
+	}
+	return bucket.take(now, limit, n)
+}
+
+// sweep periodically removes idle buckets so that the number of client IPs
+// which are tracked isn't unbounded. A removed bucket starts over full.
+func (rl *rateLimitMiddleware) sweep(now time.Time) {
+	if now.Before(rl.nextSweep) {
+		return
+	}
+	for key, bucket := range rl.buckets {
+		if now.Sub(bucket.last) > bucketIdleTimeout {
+			delete(rl.buckets, key)
+		}
+	}
+	rl.nextSweep = now.Add(bucketIdleTimeout)
+}
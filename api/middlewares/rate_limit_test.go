@@ -0,0 +1,133 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rateLimitTest struct {
+	rl  *rateLimitMiddleware
+	now time.Time
+}
+
+func makeRateLimitTest(config RateLimitConfig, tokens []Token) *rateLimitTest {
+	test := &rateLimitTest{
+		rl:  makeRateLimit(config, MakeTokenStore(tokens)),
+		now: time.Unix(1000, 0),
+	}
+	test.rl.now = func() time.Time { return test.now }
+	return test
+}
+
+// request returns the status code and Retry-After header of a request.
+func (test *rateLimitTest) request(t *testing.T, path, url, remoteAddr, token string) (int, string) {
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	req.RemoteAddr = remoteAddr
+	if token != "" {
+		req.Header.Set("X-Indexer-API-Token", token)
+	}
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+	ctx.SetPath(path)
+
+	err := test.rl.handler(ok)(ctx)
+	if err != nil {
+		httpErr, isHTTPErr := err.(*echo.HTTPError)
+		require.True(t, isHTTPErr)
+		return httpErr.Code, rec.Header().Get("Retry-After")
+	}
+	return rec.Code, rec.Header().Get("Retry-After")
+}
+
+func TestRateLimitIP(t *testing.T) {
+	test := makeRateLimitTest(RateLimitConfig{IP: Limit{Rate: 1, Burst: 2}}, nil)
+
+	code, _ := test.request(t, "/v2/transactions", "/v2/transactions", "1.2.3.4:100", "")
+	assert.Equal(t, http.StatusOK, code)
+	code, _ = test.request(t, "/v2/transactions", "/v2/transactions", "1.2.3.4:101", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	code, retryAfter := test.request(t, "/v2/transactions", "/v2/transactions", "1.2.3.4:102", "")
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	assert.Equal(t, "1", retryAfter)
+
+	// Other clients have their own bucket.
+	code, _ = test.request(t, "/v2/transactions", "/v2/transactions", "5.6.7.8:100", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	// The bucket refills over time.
+	test.now = test.now.Add(time.Second)
+	code, _ = test.request(t, "/v2/transactions", "/v2/transactions", "1.2.3.4:103", "")
+	assert.Equal(t, http.StatusOK, code)
+}
+
+func TestRateLimitToken(t *testing.T) {
+	config := RateLimitConfig{
+		TokenHeader: "X-Indexer-API-Token",
+		Token:       Limit{Rate: 1, Burst: 1},
+	}
+	tokens := []Token{
+		{Name: "basic", Token: "basic"},
+		{Name: "premium", Token: "premium", RateLimit: &Limit{Rate: 100, Burst: 100}},
+	}
+	test := makeRateLimitTest(config, tokens)
+
+	code, _ := test.request(t, "/v2/transactions", "/v2/transactions", "1.2.3.4:100", "basic")
+	assert.Equal(t, http.StatusOK, code)
+	code, _ = test.request(t, "/v2/transactions", "/v2/transactions", "5.6.7.8:100", "basic")
+	assert.Equal(t, http.StatusTooManyRequests, code)
+
+	for i := 0; i < 10; i++ {
+		code, _ = test.request(t, "/v2/transactions", "/v2/transactions", "1.2.3.4:100", "premium")
+		assert.Equal(t, http.StatusOK, code)
+	}
+
+	// Unknown tokens are limited by IP, which isn't configured.
+	for i := 0; i < 10; i++ {
+		code, _ = test.request(t, "/v2/transactions", "/v2/transactions", "1.2.3.4:100", "unknown")
+		assert.Equal(t, http.StatusOK, code)
+	}
+}
+
+func TestRateLimitWeights(t *testing.T) {
+	config := RateLimitConfig{
+		IP:           Limit{Rate: 1, Burst: 10},
+		RewindWeight: 5,
+		AssetWeight:  20,
+	}
+	test := makeRateLimitTest(config, nil)
+
+	// Rewinds cost 5 units.
+	code, _ := test.request(t, "/v2/accounts/:account-id", "/v2/accounts/ADDR?round=5", "1.2.3.4:100", "")
+	assert.Equal(t, http.StatusOK, code)
+	code, _ = test.request(t, "/v2/accounts/:account-id", "/v2/accounts/ADDR?round=5", "1.2.3.4:100", "")
+	assert.Equal(t, http.StatusOK, code)
+	code, retryAfter := test.request(t, "/v2/accounts/:account-id", "/v2/accounts/ADDR?round=5", "1.2.3.4:100", "")
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	assert.Equal(t, "5", retryAfter)
+
+	// Requests heavier than the burst need a full bucket.
+	test.now = test.now.Add(9 * time.Second)
+	code, _ = test.request(t, "/v2/transactions", "/v2/transactions?asset-id=7", "1.2.3.4:100", "")
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	test.now = test.now.Add(time.Second)
+	code, _ = test.request(t, "/v2/transactions", "/v2/transactions?asset-id=7", "1.2.3.4:100", "")
+	assert.Equal(t, http.StatusOK, code)
+}
+
+func TestRateLimitSweep(t *testing.T) {
+	test := makeRateLimitTest(RateLimitConfig{IP: Limit{Rate: 1}}, nil)
+
+	test.request(t, "/v2/transactions", "/v2/transactions", "1.2.3.4:100", "")
+	assert.Len(t, test.rl.buckets, 1)
+
+	test.now = test.now.Add(2 * bucketIdleTimeout)
+	test.request(t, "/v2/transactions", "/v2/transactions", "5.6.7.8:100", "")
+	assert.Len(t, test.rl.buckets, 1)
+}
@@ -0,0 +1,129 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+// tokenContextKey is the echo context key of the authenticated *Token.
+const tokenContextKey = "indexer-api-token"
+
+// Token is an API token and the permissions granted to it.
+type Token struct {
+	// Name identifies the token in logs and metrics.
+	Name string
+
+	// Token is the secret provided by clients.
+	Token string
+
+	// Routes are the URL path patterns the token may access, all routes are
+	// allowed when empty. See matchesRoute for the pattern syntax.
+	Routes []string
+
+	// DevMode allows performance intensive operations like searching for
+	// accounts at a particular round.
+	DevMode bool
+
+	// MaxLimits overrides the maximum 'limit' of search endpoints, keyed by
+	// the name of the endpoint limit, i.e. "transactions".
+	MaxLimits map[string]uint64
+
+	// RateLimit overrides the default token rate limit.
+	RateLimit *Limit
+}
+
+// allowsPath returns true if the token may access the URL path.
+func (t *Token) allowsPath(urlPath string) bool {
+	return len(t.Routes) == 0 || matchesRoute(t.Routes, urlPath)
+}
+
+// ValidateRoute returns an error if the URL path pattern is malformed.
+func ValidateRoute(pattern string) error {
+	if strings.HasSuffix(pattern, "*") {
+		return nil
+	}
+	_, err := path.Match(pattern, "")
+	return err
+}
+
+// matchesRoute returns true if the URL path matches any of the patterns. A
+// pattern ending with '*' matches any path with that prefix, otherwise
+// patterns are matched with path.Match.
+func matchesRoute(patterns []string, urlPath string) bool {
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(urlPath, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+			continue
+		}
+		if match, err := path.Match(pattern, urlPath); err == nil && match {
+			return true
+		}
+	}
+	return false
+}
+
+// GetToken returns the API token used to authenticate the request, or nil if
+// the request wasn't authenticated.
+func GetToken(ctx echo.Context) *Token {
+	token, _ := ctx.Get(tokenContextKey).(*Token)
+	return token
+}
+
+// TokenStore holds the API tokens. It is safe for concurrent use, so the
+// tokens can be replaced while the server is running.
+type TokenStore struct {
+	mu     sync.RWMutex
+	tokens []*Token
+}
+
+// MakeTokenStore constructs a TokenStore holding tokens.
+func MakeTokenStore(tokens []Token) *TokenStore {
+	store := &TokenStore{}
+	store.Set(tokens)
+	return store
+}
+
+// Set replaces all of the tokens.
+func (store *TokenStore) Set(tokens []Token) {
+	copies := make([]*Token, 0, len(tokens))
+	for i := range tokens {
+		token := tokens[i]
+		copies = append(copies, &token)
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.tokens = copies
+}
+
+// Len returns the number of tokens.
+func (store *TokenStore) Len() int {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return len(store.tokens)
+}
+
+// lookup finds the token matching the provided secret. All of the tokens are
+// checked in constant time.
+func (store *TokenStore) lookup(provided []byte) *Token {
+	if len(provided) == 0 {
+		return nil
+	}
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	var found *Token
+	for _, token := range store.tokens {
+		if subtle.ConstantTimeCompare(provided, []byte(token.Token)) == 1 {
+			found = token
+		}
+	}
+	return found
+}
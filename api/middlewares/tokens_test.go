@@ -0,0 +1,89 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenAllowsPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  []string
+		path    string
+		allowed bool
+	}{
+		{"No routes", nil, "/v2/accounts", true},
+		{"Exact", []string{"/v2/accounts"}, "/v2/accounts", true},
+		{"Exact mismatch", []string{"/v2/accounts"}, "/v2/accounts/ABC", false},
+		{"Prefix", []string{"/v2/accounts*"}, "/v2/accounts/ABC/transactions", true},
+		{"Prefix mismatch", []string{"/v2/accounts*"}, "/v2/transactions", false},
+		{"Pattern", []string{"/v2/assets/*/balances"}, "/v2/assets/10/balances", true},
+		{"Pattern mismatch", []string{"/v2/assets/*/balances"}, "/v2/assets/10/transactions", false},
+		{"Second route", []string{"/v2/blocks/*", "/health"}, "/health", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			token := Token{Routes: test.routes}
+			assert.Equal(t, test.allowed, token.allowsPath(test.path))
+		})
+	}
+}
+
+func TestTokenStoreLookup(t *testing.T) {
+	store := MakeTokenStore([]Token{{Name: "a", Token: "secret-a"}, {Name: "b", Token: "secret-b"}})
+	assert.Equal(t, 2, store.Len())
+	assert.Equal(t, "b", store.lookup([]byte("secret-b")).Name)
+	assert.Nil(t, store.lookup([]byte("secret-c")))
+	assert.Nil(t, store.lookup(nil))
+
+	// Replacing the tokens revokes the old ones.
+	store.Set([]Token{{Name: "c", Token: "secret-c"}})
+	assert.Nil(t, store.lookup([]byte("secret-a")))
+	assert.Equal(t, "c", store.lookup([]byte("secret-c")).Name)
+}
+
+func TestAuthScopedTokens(t *testing.T) {
+	store := MakeTokenStore([]Token{{Name: "explorer", Token: "secret", Routes: []string{"/v2/accounts*"}}})
+
+	tests := []struct {
+		name  string
+		path  string
+		token string
+		code  int
+	}{
+		{"Allowed", "/v2/accounts", "secret", http.StatusOK},
+		{"Route not allowed", "/v2/transactions", "secret", http.StatusForbidden},
+		{"Invalid token", "/v2/accounts", "wrong", http.StatusUnauthorized},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, test.path, nil)
+			req.Header.Set("X-Indexer-API-Token", test.token)
+			ctx := e.NewContext(req, httptest.NewRecorder())
+
+			var token *Token
+			next := func(ctx echo.Context) error {
+				token = GetToken(ctx)
+				return nil
+			}
+			err := MakeAuth("X-Indexer-API-Token", store)(next)(ctx)
+
+			if test.code == http.StatusOK {
+				require.NoError(t, err)
+				require.NotNil(t, token)
+				assert.Equal(t, "explorer", token.Name)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, test.code, err.(*echo.HTTPError).Code)
+			assert.Nil(t, token)
+		})
+	}
+}
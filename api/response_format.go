@@ -0,0 +1,299 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-codec/codec"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/api/middlewares"
+	"github.com/algorand/indexer/idb"
+)
+
+// responseFormat is the encoding selected with the 'format' query parameter.
+type responseFormat string
+
+const (
+	formatJSON    responseFormat = "json"
+	formatMsgpack responseFormat = "msgpack"
+	formatNDJSON  responseFormat = "ndjson"
+)
+
+const (
+	// mimeApplicationMsgpack matches the content type used by algod.
+	mimeApplicationMsgpack = "application/msgpack"
+	mimeApplicationNDJSON  = "application/x-ndjson"
+
+	// headerCurrentRound carries the current round of a response.
+	headerCurrentRound = middlewares.CurrentRoundHeader
+	// headerNextToken is sent as a trailer once a streamed response is complete.
+	headerNextToken = "X-Indexer-Next-Token"
+	// headerError is sent as a trailer when a streamed response could not be completed.
+	headerError = "X-Indexer-Error"
+)
+
+// writeResponse encodes a complete response object in the requested format.
+// When ndjson is requested the object is written as a single line.
+func writeResponse(ctx echo.Context, format responseFormat, code int, obj interface{}) error {
+	if round, ok := responseRound(obj); ok {
+		ctx.Response().Header().Set(headerCurrentRound, strconv.FormatUint(round, 10))
+	}
+	switch format {
+	case formatMsgpack:
+		ctx.Response().Header().Set(echo.HeaderContentType, mimeApplicationMsgpack)
+		ctx.Response().WriteHeader(code)
+		return codec.NewEncoder(ctx.Response(), msgpack.CodecHandle).Encode(obj)
+	case formatNDJSON:
+		ctx.Response().Header().Set(echo.HeaderContentType, mimeApplicationNDJSON)
+		ctx.Response().WriteHeader(code)
+		return json.NewEncoder(ctx.Response()).Encode(obj)
+	default:
+		return ctx.JSON(code, obj)
+	}
+}
+
+// streamFormat encodes the results of a streamed response.
+type streamFormat interface {
+	// contentType is the MIME type of the response.
+	contentType() string
+
+	// start prepares the format for writing results to w.
+	start(w io.Writer) error
+
+	// write encodes one result.
+	write(obj interface{}) error
+
+	// writeError reports an error after results have already been written.
+	writeError(header http.Header, err string) error
+
+	// flush writes any buffered data.
+	flush() error
+}
+
+// ndjsonFormat writes one JSON object per line.
+type ndjsonFormat struct {
+	enc *json.Encoder
+}
+
+func (f *ndjsonFormat) contentType() string {
+	return mimeApplicationNDJSON
+}
+
+func (f *ndjsonFormat) start(w io.Writer) error {
+	f.enc = json.NewEncoder(w)
+	return nil
+}
+
+func (f *ndjsonFormat) write(obj interface{}) error {
+	return f.enc.Encode(obj)
+}
+
+func (f *ndjsonFormat) writeError(header http.Header, err string) error {
+	return f.enc.Encode(generated.ErrorResponse{
+		Message: err,
+	})
+}
+
+func (f *ndjsonFormat) flush() error {
+	return nil
+}
+
+// responseRound returns the current-round of a response object, if it has one.
+func responseRound(obj interface{}) (uint64, bool) {
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+	field := v.FieldByName("CurrentRound")
+	if !field.IsValid() || field.Kind() != reflect.Uint64 {
+		return 0, false
+	}
+	return field.Uint(), true
+}
+
+// resultStream writes search results as they are read from the IndexerDb.
+// Nothing is written until the first result arrives, so that errors which
+// happen before any results can still be returned as regular error responses.
+type resultStream struct {
+	ctx     echo.Context
+	round   uint64
+	format  streamFormat
+	started bool
+}
+
+func newResultStream(ctx echo.Context, round uint64, format streamFormat) *resultStream {
+	return &resultStream{
+		ctx:    ctx,
+		round:  round,
+		format: format,
+	}
+}
+
+func (s *resultStream) start() error {
+	// Streamed results may take longer than the server write timeout.
+	clearWriteDeadline(s.ctx.Response().Writer)
+
+	header := s.ctx.Response().Header()
+	header.Set(echo.HeaderContentType, s.format.contentType())
+	header.Set(headerCurrentRound, strconv.FormatUint(s.round, 10))
+	header.Set("Trailer", headerNextToken+", "+headerError)
+	s.ctx.Response().WriteHeader(http.StatusOK)
+	s.started = true
+	return s.format.start(s.ctx.Response())
+}
+
+// write sends one result to the client.
+func (s *resultStream) write(obj interface{}) error {
+	if !s.started {
+		if err := s.start(); err != nil {
+			return err
+		}
+	}
+	return s.format.write(obj)
+}
+
+// fail reports an error. Once results have been sent the status code can no
+// longer change, so the error is reported by the stream format instead.
+func (s *resultStream) fail(err error, message string) error {
+	if !s.started {
+		return dbError(s.ctx, err, message)
+	}
+	if ferr := s.format.writeError(s.ctx.Response().Header(), message); ferr != nil {
+		return ferr
+	}
+	return s.format.flush()
+}
+
+// finish completes the response, setting the next token trailer if there is one.
+func (s *resultStream) finish(next string) error {
+	if !s.started {
+		if err := s.start(); err != nil {
+			return err
+		}
+	}
+	if err := s.format.flush(); err != nil {
+		return err
+	}
+	if next != "" {
+		s.ctx.Response().Header().Set(headerNextToken, next)
+	}
+	return nil
+}
+
+///////////////////////
+// Streaming helpers //
+///////////////////////
+
+// streamAccounts writes accounts to the client as they are read from the IndexerDb.
+func (si *ServerImplementation) streamAccounts(ctx echo.Context, options idb.AccountQueryOptions, atRound *uint64) error {
+	accountchan, round := si.db.GetAccounts(ctx.Request().Context(), options)
+
+	if (atRound != nil) && (*atRound > round) {
+		return indexerError(ctx, fmt.Sprintf("%s: %s: the requested round %d > the current round %d",
+			errFailedSearchingAccount, errRewindingAccount, *atRound, round))
+	}
+
+	stream := newResultStream(ctx, round, &ndjsonFormat{})
+	next := ""
+	for row := range accountchan {
+		account, ok, err := si.accountRowToAccount(row, options, atRound)
+		if err != nil {
+			return stream.fail(err, fmt.Sprintf("%s: %v", errFailedSearchingAccount, err))
+		}
+		if !ok {
+			continue
+		}
+		if err := stream.write(account); err != nil {
+			return err
+		}
+		next = account.Address
+	}
+
+	return stream.finish(next)
+}
+
+// streamApplications writes applications to the client as they are read from the IndexerDb.
+func (si *ServerImplementation) streamApplications(ctx echo.Context, params *generated.SearchForApplicationsParams) error {
+	results, round := si.db.Applications(ctx.Request().Context(), params)
+
+	stream := newResultStream(ctx, round, &ndjsonFormat{})
+	next := ""
+	for result := range results {
+		if result.Error != nil {
+			return stream.fail(result.Error, result.Error.Error())
+		}
+		if err := stream.write(result.Application); err != nil {
+			return err
+		}
+		next = strconv.FormatUint(result.Application.Id, 10)
+	}
+
+	return stream.finish(next)
+}
+
+// streamAssets writes assets to the client as they are read from the IndexerDb.
+func (si *ServerImplementation) streamAssets(ctx echo.Context, options idb.AssetsQuery) error {
+	assetchan, round := si.db.Assets(ctx.Request().Context(), options)
+
+	stream := newResultStream(ctx, round, &ndjsonFormat{})
+	next := ""
+	for row := range assetchan {
+		asset, err := assetRowToAsset(row)
+		if err != nil {
+			return stream.fail(err, err.Error())
+		}
+		if err := stream.write(asset); err != nil {
+			return err
+		}
+		next = encodeAssetCursor(asset)
+	}
+
+	return stream.finish(next)
+}
+
+// streamAssetBalances writes asset holdings to the client as they are read from the IndexerDb.
+func (si *ServerImplementation) streamAssetBalances(ctx echo.Context, options idb.AssetBalanceQuery) error {
+	assetbalchan, round := si.db.AssetBalances(ctx.Request().Context(), options)
+
+	stream := newResultStream(ctx, round, &ndjsonFormat{})
+	next := ""
+	for row := range assetbalchan {
+		bal, err := assetBalanceRowToHolding(row)
+		if err != nil {
+			return stream.fail(err, err.Error())
+		}
+		if err := stream.write(bal); err != nil {
+			return err
+		}
+		next = bal.Address
+	}
+
+	return stream.finish(next)
+}
+
+// streamTransactions writes transactions to the client as they are read from the IndexerDb.
+func (si *ServerImplementation) streamTransactions(ctx echo.Context, filter idb.TransactionFilter) error {
+	txchan, round := si.db.Transactions(ctx.Request().Context(), filter)
+
+	stream := newResultStream(ctx, round, &ndjsonFormat{})
+	next := ""
+	for txrow := range txchan {
+		tx, err := txnRowToTransaction(txrow)
+		if err != nil {
+			return stream.fail(err, fmt.Sprintf("%s: %v", errTransactionSearch, err))
+		}
+		if err := stream.write(tx); err != nil {
+			return err
+		}
+		next = txrow.Next()
+	}
+
+	return stream.finish(next)
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/api/middlewares"
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/idb/mocks"
+)
+
+func TestDecodeFormat(t *testing.T) {
+	format, errs := decodeFormat(nil, make([]string, 0))
+	assert.Empty(t, errs)
+	assert.Equal(t, formatJSON, format)
+
+	format, errs = decodeFormat(strPtr("MsgPack"), make([]string, 0))
+	assert.Empty(t, errs)
+	assert.Equal(t, formatMsgpack, format)
+
+	_, errs = decodeFormat(strPtr("xml"), make([]string, 0))
+	require.Len(t, errs, 1)
+	assert.True(t, strings.HasPrefix(errs[0], errUnknownFormat))
+}
+
+func TestWriteResponseMsgpack(t *testing.T) {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+	response := generated.AssetsResponse{
+		CurrentRound: 10,
+		NextToken:    strPtr("3"),
+		Assets: []generated.Asset{
+			{Index: 3, Params: generated.AssetParams{Creator: "creator", Total: 100}},
+		},
+	}
+	require.NoError(t, writeResponse(ctx, formatMsgpack, http.StatusOK, response))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, mimeApplicationMsgpack, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "10", rec.Header().Get(headerCurrentRound))
+
+	var decoded generated.AssetsResponse
+	require.NoError(t, msgpack.Decode(rec.Body.Bytes(), &decoded))
+	assert.Equal(t, response, decoded)
+}
+
+func TestStreamTransactionsNDJSON(t *testing.T) {
+	txnBytes := loadResourceFileOrPanic("test_resources/payment.txn")
+	ch := make(chan idb.TxnRow, 2)
+	ch <- idb.TxnRow{Round: 1, Intra: 2, TxnBytes: txnBytes}
+	ch <- idb.TxnRow{Round: 1, Intra: 3, TxnBytes: txnBytes}
+	close(ch)
+	var outCh <-chan idb.TxnRow = ch
+
+	db := &mocks.IndexerDb{}
+	db.On("Transactions", mock.Anything, mock.Anything).Return(outCh, uint64(7))
+	si := ServerImplementation{db: db}
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	require.NoError(t, si.SearchForTransactions(ctx, generated.SearchForTransactionsParams{Format: strPtr("ndjson")}))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, mimeApplicationNDJSON, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "7", rec.Header().Get(headerCurrentRound))
+	assert.Equal(t, idb.TxnRow{Round: 1, Intra: 3}.Next(), rec.Header().Get(headerNextToken))
+
+	lines := 0
+	scanner := bufio.NewScanner(rec.Body)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var txn generated.Transaction
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &txn))
+		assert.Equal(t, uint64(1), *txn.ConfirmedRound)
+		lines++
+	}
+	assert.Equal(t, 2, lines)
+}
+
+func TestStreamTransactionsNDJSONError(t *testing.T) {
+	txnBytes := loadResourceFileOrPanic("test_resources/payment.txn")
+
+	tests := []struct {
+		name     string
+		rows     []idb.TxnRow
+		code     int
+		numLines int
+	}{
+		{
+			name:     "Error before results",
+			rows:     []idb.TxnRow{{Error: errors.New("boom")}},
+			code:     http.StatusInternalServerError,
+			numLines: 1,
+		},
+		{
+			name:     "Error after results",
+			rows:     []idb.TxnRow{{Round: 1, TxnBytes: txnBytes}, {Error: errors.New("boom")}},
+			code:     http.StatusOK,
+			numLines: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ch := make(chan idb.TxnRow, len(test.rows))
+			for _, row := range test.rows {
+				ch <- row
+			}
+			close(ch)
+			var outCh <-chan idb.TxnRow = ch
+
+			db := &mocks.IndexerDb{}
+			db.On("Transactions", mock.Anything, mock.Anything).Return(outCh, uint64(7))
+			si := ServerImplementation{db: db}
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+			require.NoError(t, si.SearchForTransactions(ctx, generated.SearchForTransactionsParams{Format: strPtr("ndjson")}))
+
+			assert.Equal(t, test.code, rec.Code)
+			lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+			require.Len(t, lines, test.numLines)
+
+			// The error is always the last object in the response.
+			var errResponse generated.ErrorResponse
+			require.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &errResponse))
+			assert.Contains(t, errResponse.Message, "boom")
+		})
+	}
+}
+
+func TestStreamPastWriteTimeout(t *testing.T) {
+	// The cache middleware wraps the response writer.
+	db := &mocks.IndexerDb{}
+	db.On("Health").Return(idb.Health{Round: 7}, nil)
+
+	e := echo.New()
+	e.GET("/v2/transactions", func(ctx echo.Context) error {
+		stream := newResultStream(ctx, 7, &ndjsonFormat{})
+		for i := 0; i < 3; i++ {
+			require.NoError(t, stream.write(map[string]int{"i": i}))
+			require.NoError(t, stream.format.flush())
+			time.Sleep(100 * time.Millisecond)
+		}
+		return stream.finish("next")
+	}, middlewares.MakeCache(db, false))
+	server := httptest.NewUnstartedServer(e)
+	server.Config.WriteTimeout = 50 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/v2/transactions")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, strings.Count(string(body), "\n"))
+	assert.Equal(t, "next", resp.Trailer.Get(headerNextToken))
+}
@@ -19,8 +19,10 @@ import (
 
 // ExtraOptions are options which change the behavior or the HTTP server.
 type ExtraOptions struct {
-	// Tokens are the access tokens which can access the API.
-	Tokens []string
+	// Tokens are the access tokens which can access the API. The tokens may
+	// be replaced while the server is running, but authentication is only
+	// required if there are tokens when the server starts.
+	Tokens *middlewares.TokenStore
 
 	// DeveloperMode turns on features like AddressSearchRoundRewind
 	DeveloperMode bool
@@ -30,8 +32,27 @@ type ExtraOptions struct {
 
 	// MetricsEndpointVerbose generates separate histograms based on query parameters on the /metrics endpoint.
 	MetricsEndpointVerbose bool
+
+	// ExportRowLimit is the maximum number of rows returned by a CSV export request, 0 for no limit.
+	ExportRowLimit uint64
+
+	// RateLimit configures per token and per IP request limits.
+	RateLimit middlewares.RateLimitConfig
+
+	// Limits overrides the default and maximum 'limit' of search endpoints.
+	Limits map[string]EndpointLimit
+
+	// DisabledEndpoints are URL path patterns of endpoints which return 501.
+	DisabledEndpoints []string
+
+	// Rounds notifies transaction streams of committed rounds. The database
+	// is used when it is nil and supports round notifications.
+	Rounds RoundSubscriber
 }
 
+// apiTokenHeader is the header used to provide an API token.
+const apiTokenHeader = "X-Indexer-API-Token"
+
 // Serve starts an http server for the indexer API. This call blocks.
 func Serve(ctx context.Context, serveAddr string, db idb.IndexerDb, fetcherError error, log *log.Logger, options ExtraOptions) {
 	e := echo.New()
@@ -54,15 +75,42 @@ func Serve(ctx context.Context, serveAddr string, db idb.IndexerDb, fetcherError
 
 	middleware := make([]echo.MiddlewareFunc, 0)
 
+	if len(options.DisabledEndpoints) > 0 {
+		middleware = append(middleware, middlewares.MakeDisabled(options.DisabledEndpoints))
+	}
+
 	middleware = append(middleware, middlewares.MakeMigrationMiddleware(db))
 
-	if len(options.Tokens) > 0 {
-		middleware = append(middleware, middlewares.MakeAuth("X-Indexer-API-Token", options.Tokens))
+	if options.Tokens == nil {
+		options.Tokens = middlewares.MakeTokenStore(nil)
+	}
+	requireToken := options.Tokens.Len() > 0
+
+	// Tokens may have their own rate limit.
+	if options.RateLimit.Enabled() || requireToken {
+		rateLimit := options.RateLimit
+		rateLimit.TokenHeader = apiTokenHeader
+		middleware = append(middleware, middlewares.MakeRateLimit(rateLimit, options.Tokens))
+	}
+
+	if requireToken {
+		middleware = append(middleware, middlewares.MakeAuth(apiTokenHeader, options.Tokens))
+	}
+
+	middleware = append(middleware, middlewares.MakeCache(db, requireToken))
+
+	rounds := options.Rounds
+	if notifier, ok := db.(idb.RoundNotifier); ok && rounds == nil {
+		rounds = notifier
 	}
 
 	api := ServerImplementation{
 		EnableAddressSearchRoundRewind: options.DeveloperMode,
+		EnableExport:                   requireToken,
+		ExportRowLimit:                 options.ExportRowLimit,
+		Limits:                         options.Limits,
 		db:                             db,
+		rounds:                         rounds,
 		fetcher:                        fetcherError,
 	}
 
@@ -0,0 +1,289 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/idb"
+)
+
+// RoundSubscriber notifies the server when rounds are committed.
+type RoundSubscriber interface {
+	// SubscribeRounds returns a channel receiving committed rounds until ctx is
+	// done. A subscriber which falls behind only receives the latest round.
+	SubscribeRounds(ctx context.Context) <-chan uint64
+}
+
+const (
+	mimeTextEventStream = "text/event-stream"
+
+	// streamRoundWindow is the number of rounds queried at once while a stream
+	// catches up with the committed round.
+	streamRoundWindow = 10
+
+	// streamKeepAlive is how often a comment is sent while waiting for a
+	// round, so that proxies don't close idle streams.
+	streamKeepAlive = 15 * time.Second
+
+	// endOfRound is the intra offset of the event id sent once every
+	// transaction of a round has been sent. Blocks hold fewer transactions.
+	endOfRound = math.MaxInt32
+)
+
+// streamPosition is the last transaction sent to a stream. Event ids encode
+// the position with the same format as the 'next' token of searches.
+type streamPosition struct {
+	round uint64
+	intra uint32
+}
+
+// endOfRoundPosition is the position after every transaction of round.
+func endOfRoundPosition(round uint64) streamPosition {
+	return streamPosition{round: round, intra: endOfRound}
+}
+
+// id returns the event id of the position.
+func (p streamPosition) id() string {
+	return idb.TxnRow{Round: p.round, Intra: int(p.intra)}.Next()
+}
+
+// before returns true if row has not been sent yet.
+func (p streamPosition) before(row idb.TxnRow) bool {
+	return row.Round > p.round || (row.Round == p.round && uint32(row.Intra) > p.intra)
+}
+
+// nextRound returns the first round which may have transactions left to send.
+func (p streamPosition) nextRound() uint64 {
+	if p.intra >= endOfRound {
+		return p.round + 1
+	}
+	return p.round
+}
+
+// roundEvent is the data of the event sent once a round is complete.
+type roundEvent struct {
+	Round uint64 `json:"round"`
+}
+
+// eventStream writes Server-Sent Events to the client.
+type eventStream struct {
+	res *echo.Response
+}
+
+func newEventStream(ctx echo.Context) *eventStream {
+	res := ctx.Response()
+	// Streams are open much longer than the server write timeout.
+	clearWriteDeadline(res.Writer)
+
+	header := res.Header()
+	header.Set(echo.HeaderContentType, mimeTextEventStream)
+	header.Set("Cache-Control", "no-cache")
+	// Disable response buffering in nginx.
+	header.Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	return &eventStream{res: res}
+}
+
+// send writes one event with JSON encoded data.
+func (s *eventStream) send(event string, id string, data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		_, err = fmt.Fprintf(s.res, "event: %s\nid: %s\ndata: %s\n\n", event, id, encoded)
+	} else {
+		_, err = fmt.Fprintf(s.res, "event: %s\ndata: %s\n\n", event, encoded)
+	}
+	if err != nil {
+		return err
+	}
+	s.res.Flush()
+	return nil
+}
+
+// comment writes a comment, which clients ignore.
+func (s *eventStream) comment(text string) error {
+	if _, err := fmt.Fprintf(s.res, ": %s\n\n", text); err != nil {
+		return err
+	}
+	s.res.Flush()
+	return nil
+}
+
+// fail reports an error to the client, which may reconnect to resume the
+// stream from the last event id it received.
+func (s *eventStream) fail(message string) error {
+	return s.send("error", "", generated.ErrorResponse{
+		Message: message,
+	})
+}
+
+// clearWriteDeadline removes the server write timeout from the connection,
+// when the response writer supports it.
+func clearWriteDeadline(w http.ResponseWriter) {
+	for {
+		switch writer := w.(type) {
+		case interface{ SetWriteDeadline(time.Time) error }:
+			writer.SetWriteDeadline(time.Time{})
+			return
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = writer.Unwrap()
+		default:
+			return
+		}
+	}
+}
+
+// StreamTransactions sends transactions matching the provided parameters as
+// Server-Sent Events once their round is committed.
+// (GET /v2/stream/transactions)
+func (si *ServerImplementation) StreamTransactions(ctx echo.Context, params generated.StreamTransactionsParams) error {
+	if si.rounds == nil {
+		return ctx.JSON(http.StatusNotImplemented, generated.ErrorResponse{
+			Message: errStreamingUnavailable,
+		})
+	}
+
+	filter, err := transactionParamsToTransactionFilter(generated.SearchForTransactionsParams{
+		NotePrefix:          params.NotePrefix,
+		TxType:              params.TxType,
+		SigType:             params.SigType,
+		AssetId:             params.AssetId,
+		CurrencyGreaterThan: params.CurrencyGreaterThan,
+		CurrencyLessThan:    params.CurrencyLessThan,
+		Address:             params.Address,
+		AddressRole:         params.AddressRole,
+		ExcludeCloseTo:      params.ExcludeCloseTo,
+		RekeyTo:             params.RekeyTo,
+		ApplicationId:       params.ApplicationId,
+	})
+	if err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	// Every matching transaction of the queried rounds is sent.
+	filter.Limit = 0
+
+	// Browsers reconnect with the id of the last event they received.
+	next := ctx.Request().Header.Get("Last-Event-ID")
+	if params.Next != nil {
+		next = *params.Next
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	// Subscribe before reading the current round so that no round is missed.
+	rounds := si.rounds.SubscribeRounds(reqCtx)
+	health, err := si.db.Health()
+	if err != nil {
+		return indexerError(ctx, fmt.Sprintf("%s: %v", errStreamFailed, err))
+	}
+	committed := health.Round
+
+	position := endOfRoundPosition(committed)
+	if next != "" {
+		round, intra, err := idb.DecodeTxnRowNext(next)
+		if err != nil {
+			return badRequest(ctx, errUnableToParseNext)
+		}
+		position = streamPosition{round: round, intra: intra}
+	}
+
+	stream := newEventStream(ctx)
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		for position.nextRound() <= committed {
+			sent, err := si.sendRoundWindow(reqCtx, stream, filter, position, committed)
+			if err != nil {
+				if reqCtx.Err() != nil {
+					return nil
+				}
+				return stream.fail(fmt.Sprintf("%s: %v", errStreamFailed, err))
+			}
+			// The database has not caught up with the notified round yet.
+			if sent == position {
+				break
+			}
+			position = sent
+		}
+
+		select {
+		case <-reqCtx.Done():
+			return nil
+		case round := <-rounds:
+			if round > committed {
+				committed = round
+			}
+		case <-keepAlive.C:
+			if err := stream.comment("keep-alive"); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendRoundWindow sends the transactions following position in up to
+// streamRoundWindow rounds, but no further than the committed round. A round
+// event follows the last complete round. It returns the new position.
+func (si *ServerImplementation) sendRoundWindow(ctx context.Context, stream *eventStream, filter idb.TransactionFilter, position streamPosition, committed uint64) (streamPosition, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	filter.MinRound = position.nextRound()
+	filter.MaxRound = min(filter.MinRound+streamRoundWindow-1, committed)
+
+	txchan, round := si.db.Transactions(ctx, filter)
+	// A read replica may lag behind the notified round, only the rounds it
+	// has committed are complete.
+	if round < filter.MinRound {
+		return position, nil
+	}
+	lastRound := min(filter.MaxRound, round)
+
+	var rows []idb.TxnRow
+	for row := range txchan {
+		if row.Error != nil {
+			return position, row.Error
+		}
+		if row.Round <= lastRound && position.before(row) {
+			rows = append(rows, row)
+		}
+	}
+
+	// Searches by address return the newest transactions first.
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Round != rows[j].Round {
+			return rows[i].Round < rows[j].Round
+		}
+		return rows[i].Intra < rows[j].Intra
+	})
+
+	for _, row := range rows {
+		txn, err := txnRowToTransaction(row)
+		if err != nil {
+			return position, err
+		}
+		if err := stream.send("transaction", row.Next(), txn); err != nil {
+			return position, err
+		}
+		position = streamPosition{round: row.Round, intra: uint32(row.Intra)}
+	}
+
+	position = endOfRoundPosition(lastRound)
+	if err := stream.send("round", position.id(), roundEvent{Round: lastRound}); err != nil {
+		return position, err
+	}
+	return position, nil
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/idb/mocks"
+	"github.com/algorand/indexer/util"
+)
+
+func txnRows(rows ...idb.TxnRow) <-chan idb.TxnRow {
+	ch := make(chan idb.TxnRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	return ch
+}
+
+func roundWindow(min, max uint64) interface{} {
+	return mock.MatchedBy(func(filter idb.TransactionFilter) bool {
+		return filter.MinRound == min && filter.MaxRound == max && filter.Limit == 0
+	})
+}
+
+// parseEvents returns the event name and id of each event in an event stream.
+func parseEvents(body string) [][2]string {
+	var events [][2]string
+	for _, block := range strings.Split(strings.TrimSpace(body), "\n\n") {
+		var event [2]string
+		for _, line := range strings.Split(block, "\n") {
+			if strings.HasPrefix(line, "event: ") {
+				event[0] = strings.TrimPrefix(line, "event: ")
+			}
+			if strings.HasPrefix(line, "id: ") {
+				event[1] = strings.TrimPrefix(line, "id: ")
+			}
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestStreamTransactions(t *testing.T) {
+	txnBytes := loadResourceFileOrPanic("test_resources/payment.txn")
+	rounds := util.MakeRoundBroadcaster()
+	reqCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := &mocks.IndexerDb{}
+	// The client is subscribed once the current round is read.
+	db.On("Health").Return(idb.Health{Round: 5}, nil).Run(func(mock.Arguments) {
+		go rounds.Publish(6)
+	})
+	// Searches by address return the newest transactions first.
+	db.On("Transactions", mock.Anything, roundWindow(5, 5)).Return(txnRows(
+		idb.TxnRow{Round: 5, Intra: 1, TxnBytes: txnBytes},
+		idb.TxnRow{Round: 5, Intra: 0, TxnBytes: txnBytes},
+	), uint64(5)).Once()
+	db.On("Transactions", mock.Anything, roundWindow(6, 6)).Return(txnRows(
+		idb.TxnRow{Round: 6, Intra: 0, TxnBytes: txnBytes},
+	), uint64(6)).Once().Run(func(mock.Arguments) {
+		cancel()
+	})
+	si := ServerImplementation{db: db, rounds: rounds}
+
+	// Resume after the first transaction of round 5.
+	params := generated.StreamTransactionsParams{Next: strPtr(idb.TxnRow{Round: 5, Intra: 0}.Next())}
+	req := httptest.NewRequest(http.MethodGet, "/v2/stream/transactions", nil).WithContext(reqCtx)
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, rec)
+	require.NoError(t, si.StreamTransactions(ctx, params))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, mimeTextEventStream, rec.Header().Get(echo.HeaderContentType))
+	expected := [][2]string{
+		{"transaction", idb.TxnRow{Round: 5, Intra: 1}.Next()},
+		{"round", endOfRoundPosition(5).id()},
+		{"transaction", idb.TxnRow{Round: 6, Intra: 0}.Next()},
+		{"round", endOfRoundPosition(6).id()},
+	}
+	assert.Equal(t, expected, parseEvents(rec.Body.String()))
+	assert.Contains(t, rec.Body.String(), "data: {\"round\":6}\n")
+	db.AssertExpectations(t)
+}
+
+func TestStreamTransactionsReplicaLag(t *testing.T) {
+	// The database has not caught up with the current round yet.
+	position := endOfRoundPosition(4)
+	db := &mocks.IndexerDb{}
+	db.On("Transactions", mock.Anything, roundWindow(5, 5)).Return(txnRows(), uint64(4))
+	si := ServerImplementation{db: db}
+
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	sent, err := si.sendRoundWindow(context.Background(), newEventStream(ctx), idb.TransactionFilter{}, position, 5)
+	require.NoError(t, err)
+	assert.Equal(t, position, sent)
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestStreamTransactionsErrors(t *testing.T) {
+	db := &mocks.IndexerDb{}
+	db.On("Health").Return(idb.Health{Round: 5}, nil)
+
+	tests := []struct {
+		name   string
+		rounds RoundSubscriber
+		params generated.StreamTransactionsParams
+		code   int
+	}{
+		{"Unavailable", nil, generated.StreamTransactionsParams{}, http.StatusNotImplemented},
+		{"Bad next", util.MakeRoundBroadcaster(), generated.StreamTransactionsParams{Next: strPtr("AAAA")}, http.StatusBadRequest},
+		{"Bad filter", util.MakeRoundBroadcaster(), generated.StreamTransactionsParams{TxType: strPtr("nope")}, http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			si := ServerImplementation{db: db, rounds: test.rounds}
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+			require.NoError(t, si.StreamTransactions(ctx, test.params))
+			assert.Equal(t, test.code, rec.Code)
+		})
+	}
+}
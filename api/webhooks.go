@@ -0,0 +1,363 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/types"
+)
+
+const (
+	// webhookRoundWindow is the number of rounds queried at once while the
+	// deliveries of a subscription catch up with the imported round.
+	webhookRoundWindow = 100
+
+	// webhookBatchSize is the number of due deliveries loaded at once.
+	webhookBatchSize = 100
+
+	// webhookPollInterval is how often deliveries waiting for a retry are
+	// checked.
+	webhookPollInterval = 5 * time.Second
+
+	// webhookTimeout bounds each delivery request.
+	webhookTimeout = 10 * time.Second
+
+	// The delay before retrying a failed delivery doubles with every attempt,
+	// from webhookMinBackoff up to webhookMaxBackoff.
+	webhookMinBackoff = 5 * time.Second
+	webhookMaxBackoff = time.Hour
+
+	// headerWebhookID identifies a delivery, it is the same for every attempt.
+	headerWebhookID = "X-Indexer-Webhook-Id"
+	// headerWebhookSubscription is the name of the subscription.
+	headerWebhookSubscription = "X-Indexer-Webhook-Subscription"
+	// headerWebhookSignature is the hex encoded HMAC-SHA256 of the body.
+	headerWebhookSignature = "X-Indexer-Webhook-Signature"
+)
+
+// WebhookSubscription delivers the transactions matching its filters to a URL.
+type WebhookSubscription struct {
+	// Name identifies the subscription, its delivery state is kept under
+	// this name.
+	Name string
+
+	// URL receives a POST request for each matching transaction.
+	URL string
+
+	// Secret is the HMAC-SHA256 key used to sign the request bodies.
+	Secret string
+
+	// Filters are transaction search parameters. Paging and round parameters
+	// are not allowed.
+	Filters generated.SearchForTransactionsParams
+}
+
+// webhookPayload is the body of a delivery.
+type webhookPayload struct {
+	Subscription string                `json:"subscription"`
+	Round        uint64                `json:"round"`
+	Transaction  generated.Transaction `json:"transaction"`
+}
+
+type webhookSubscription struct {
+	WebhookSubscription
+	filter idb.TransactionFilter
+}
+
+// Webhooks delivers webhook notifications for the transactions of each
+// imported round. Deliveries are persisted before they are attempted and only
+// removed once the receiver accepts them with a 2xx status, so each one is
+// delivered at least once. It is a fetcher.BlockHandler, and must be added
+// after the handler importing blocks.
+type Webhooks struct {
+	db            idb.IndexerDb
+	queue         idb.WebhookQueue
+	subscriptions map[string]*webhookSubscription
+	names         []string
+	client        *http.Client
+	log           *log.Logger
+
+	// wake signals that deliveries were enqueued.
+	wake chan struct{}
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// MakeWebhooks validates the subscriptions and constructs Webhooks. The
+// database must implement idb.WebhookQueue.
+func MakeWebhooks(db idb.IndexerDb, subscriptions []WebhookSubscription, log *log.Logger) (*Webhooks, error) {
+	queue, ok := db.(idb.WebhookQueue)
+	if !ok {
+		return nil, errors.New("webhooks are not supported by the database")
+	}
+
+	w := &Webhooks{
+		db:            db,
+		queue:         queue,
+		subscriptions: make(map[string]*webhookSubscription),
+		client:        &http.Client{Timeout: webhookTimeout},
+		log:           log,
+		wake:          make(chan struct{}, 1),
+		now:           time.Now,
+	}
+	for _, subscription := range subscriptions {
+		s, err := makeWebhookSubscription(subscription)
+		if err != nil {
+			return nil, fmt.Errorf("webhook '%s': %v", subscription.Name, err)
+		}
+		if _, ok := w.subscriptions[s.Name]; ok {
+			return nil, fmt.Errorf("webhook name '%s' is used more than once", s.Name)
+		}
+		w.subscriptions[s.Name] = s
+		w.names = append(w.names, s.Name)
+	}
+	return w, nil
+}
+
+func makeWebhookSubscription(subscription WebhookSubscription) (*webhookSubscription, error) {
+	if subscription.Name == "" {
+		return nil, errors.New("no name")
+	}
+	u, err := url.Parse(subscription.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid url '%s'", subscription.URL)
+	}
+	if subscription.Secret == "" {
+		return nil, errors.New("no secret")
+	}
+
+	params := subscription.Filters
+	if params.Limit != nil || params.Next != nil || params.Round != nil || params.MinRound != nil ||
+		params.MaxRound != nil || params.BeforeTime != nil || params.AfterTime != nil || params.Format != nil {
+		return nil, errors.New("paging, round, time and format filters are not supported")
+	}
+	filter, err := transactionParamsToTransactionFilter(params)
+	if err != nil {
+		return nil, err
+	}
+	// Every matching transaction of the queried rounds is delivered.
+	filter.Limit = 0
+
+	return &webhookSubscription{
+		WebhookSubscription: subscription,
+		filter:              filter,
+	}, nil
+}
+
+// HandleBlock enqueues the deliveries of the imported round. Failures are
+// logged and retried with the next round, since each subscription resumes
+// after the last round it enqueued.
+func (w *Webhooks) HandleBlock(block *types.EncodedBlockCert) {
+	round := uint64(block.Block.Round)
+	for _, name := range w.names {
+		if err := w.enqueue(w.subscriptions[name], round); err != nil {
+			w.log.WithError(err).Errorf("webhook '%s': failed to enqueue round %d", name, round)
+		}
+	}
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// enqueue stores the deliveries of the subscription up to round. A new
+// subscription starts with round.
+func (w *Webhooks) enqueue(s *webhookSubscription, round uint64) error {
+	cursor, ok, err := w.queue.WebhookCursor(s.Name)
+	if err != nil {
+		return err
+	}
+	start := round
+	if ok {
+		start = cursor + 1
+	}
+
+	for start <= round {
+		last := min(start+webhookRoundWindow-1, round)
+		end, err := w.enqueueWindow(s, start, last)
+		if err != nil {
+			return err
+		}
+		// The database has not caught up with the round yet.
+		if end < last {
+			return nil
+		}
+		start = end + 1
+	}
+	return nil
+}
+
+// enqueueWindow stores the deliveries of the subscription in the rounds from
+// first to last, and returns the last round which was complete.
+func (w *Webhooks) enqueueWindow(s *webhookSubscription, first, last uint64) (uint64, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	filter := s.filter
+	filter.MinRound = first
+	filter.MaxRound = last
+	txchan, round := w.db.Transactions(ctx, filter)
+	// A read replica may lag behind the imported round.
+	if round < first {
+		return round, nil
+	}
+	last = min(last, round)
+
+	var deliveries []idb.WebhookDelivery
+	for row := range txchan {
+		if row.Error != nil {
+			return 0, row.Error
+		}
+		if row.Round > last {
+			continue
+		}
+		txn, err := txnRowToTransaction(row)
+		if err != nil {
+			return 0, err
+		}
+		payload, err := json.Marshal(webhookPayload{
+			Subscription: s.Name,
+			Round:        row.Round,
+			Transaction:  txn,
+		})
+		if err != nil {
+			return 0, err
+		}
+		deliveries = append(deliveries, idb.WebhookDelivery{
+			Round:   row.Round,
+			Intra:   uint32(row.Intra),
+			Payload: payload,
+		})
+	}
+
+	return last, w.queue.EnqueueWebhooks(s.Name, last, deliveries)
+}
+
+// Run delivers the queued webhooks until ctx is done.
+func (w *Webhooks) Run(ctx context.Context) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.deliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverDue attempts the deliveries which are due. The deliveries of each
+// subscription are sent in order, and after a failed attempt the queue holds
+// back the subscription until the retry, so that a receiver which is down
+// isn't sent every pending delivery. The retry is stored with the delivery, so
+// the order is kept across restarts.
+func (w *Webhooks) deliverDue(ctx context.Context) {
+	for _, name := range w.names {
+		if ctx.Err() != nil {
+			return
+		}
+		w.deliverSubscription(ctx, name)
+	}
+}
+
+// deliverSubscription attempts the due deliveries of one subscription until
+// one of them fails.
+func (w *Webhooks) deliverSubscription(ctx context.Context, name string) {
+	for ctx.Err() == nil {
+		due, err := w.queue.DueWebhooks([]string{name}, w.now(), webhookBatchSize)
+		if err != nil {
+			w.log.WithError(err).Errorf("webhook '%s': failed to load deliveries", name)
+			return
+		}
+
+		for _, delivery := range due {
+			err := w.deliver(ctx, delivery)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				next := w.now().Add(webhookBackoff(delivery.Attempts + 1))
+				w.log.WithError(err).Warnf("webhook '%s': delivery %d failed, retrying at %s", name, delivery.ID, next)
+				if err := w.queue.WebhookFailed(delivery.ID, next, err.Error()); err != nil {
+					w.log.WithError(err).Errorf("webhook '%s': failed to record delivery %d", name, delivery.ID)
+				}
+				return
+			}
+			if err := w.queue.WebhookDelivered(delivery.ID); err != nil {
+				w.log.WithError(err).Errorf("webhook '%s': failed to remove delivery %d", name, delivery.ID)
+				return
+			}
+		}
+
+		if len(due) < webhookBatchSize {
+			return
+		}
+	}
+}
+
+// deliver sends one delivery to the receiver.
+func (w *Webhooks) deliver(ctx context.Context, delivery idb.WebhookDelivery) error {
+	s := w.subscriptions[delivery.Subscription]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerWebhookID, strconv.FormatUint(delivery.ID, 10))
+	req.Header.Set(headerWebhookSubscription, s.Name)
+	req.Header.Set(headerWebhookSignature, "sha256="+webhookSignature(s.Secret, delivery.Payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("receiver returned %s", resp.Status)
+	}
+	return nil
+}
+
+// webhookSignature returns the hex encoded HMAC-SHA256 of the payload.
+func webhookSignature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff returns the delay before the given attempt.
+func webhookBackoff(attempt uint64) time.Duration {
+	backoff := webhookMinBackoff
+	for i := uint64(1); i < attempt && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > webhookMaxBackoff {
+		return webhookMaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,256 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/idb/mocks"
+	"github.com/algorand/indexer/types"
+)
+
+// memoryWebhookQueue implements idb.WebhookQueue in memory.
+type memoryWebhookQueue struct {
+	mu         sync.Mutex
+	cursors    map[string]uint64
+	deliveries map[uint64]*webhookEntry
+	nextID     uint64
+}
+
+type webhookEntry struct {
+	delivery idb.WebhookDelivery
+	next     time.Time
+	err      string
+}
+
+func makeMemoryWebhookQueue() *memoryWebhookQueue {
+	return &memoryWebhookQueue{
+		cursors:    make(map[string]uint64),
+		deliveries: make(map[uint64]*webhookEntry),
+	}
+}
+
+func (q *memoryWebhookQueue) WebhookCursor(subscription string) (uint64, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	round, ok := q.cursors[subscription]
+	return round, ok, nil
+}
+
+func (q *memoryWebhookQueue) EnqueueWebhooks(subscription string, round uint64, deliveries []idb.WebhookDelivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, delivery := range deliveries {
+		q.nextID++
+		delivery.ID = q.nextID
+		delivery.Subscription = subscription
+		q.deliveries[delivery.ID] = &webhookEntry{delivery: delivery}
+	}
+	q.cursors[subscription] = round
+	return nil
+}
+
+func (q *memoryWebhookQueue) DueWebhooks(subscriptions []string, now time.Time, limit uint64) ([]idb.WebhookDelivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	names := make(map[string]bool)
+	for _, name := range subscriptions {
+		names[name] = true
+	}
+	// held is the first delivery of each subscription which waits for a retry.
+	held := make(map[string]uint64)
+	for id, entry := range q.deliveries {
+		name := entry.delivery.Subscription
+		if first, ok := held[name]; entry.next.After(now) && (!ok || id < first) {
+			held[name] = id
+		}
+	}
+	var due []idb.WebhookDelivery
+	for id, entry := range q.deliveries {
+		name := entry.delivery.Subscription
+		first, ok := held[name]
+		if names[name] && !entry.next.After(now) && (!ok || id < first) {
+			due = append(due, entry.delivery)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ID < due[j].ID })
+	if uint64(len(due)) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (q *memoryWebhookQueue) WebhookDelivered(id uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.deliveries, id)
+	return nil
+}
+
+func (q *memoryWebhookQueue) WebhookFailed(id uint64, next time.Time, message string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	entry := q.deliveries[id]
+	entry.delivery.Attempts++
+	entry.next = next
+	entry.err = message
+	return nil
+}
+
+// webhookDb is a mocked IndexerDb with an in memory webhook queue.
+type webhookDb struct {
+	*mocks.IndexerDb
+	*memoryWebhookQueue
+}
+
+func makeBlock(round uint64) *types.EncodedBlockCert {
+	var block types.EncodedBlockCert
+	block.Block.Round = types.Round(round)
+	return &block
+}
+
+func TestMakeWebhooksValidation(t *testing.T) {
+	db := webhookDb{&mocks.IndexerDb{}, makeMemoryWebhookQueue()}
+	valid := WebhookSubscription{Name: "a", URL: "https://example.com/hook", Secret: "secret"}
+
+	tests := []struct {
+		name   string
+		change func(*WebhookSubscription)
+	}{
+		{"No name", func(s *WebhookSubscription) { s.Name = "" }},
+		{"Bad URL", func(s *WebhookSubscription) { s.URL = "example.com/hook" }},
+		{"No secret", func(s *WebhookSubscription) { s.Secret = "" }},
+		{"Round filter", func(s *WebhookSubscription) { s.Filters.MinRound = uint64Ptr(5) }},
+		{"Bad filter", func(s *WebhookSubscription) { s.Filters.TxType = strPtr("nope") }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			subscription := valid
+			test.change(&subscription)
+			_, err := MakeWebhooks(db, []WebhookSubscription{subscription}, log.New())
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := MakeWebhooks(db, []WebhookSubscription{valid, valid}, log.New())
+	assert.Error(t, err)
+
+	_, err = MakeWebhooks(&mocks.IndexerDb{}, []WebhookSubscription{valid}, log.New())
+	assert.Error(t, err)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, 5*time.Second, webhookBackoff(1))
+	assert.Equal(t, 10*time.Second, webhookBackoff(2))
+	assert.Equal(t, 40*time.Second, webhookBackoff(4))
+	assert.Equal(t, webhookMaxBackoff, webhookBackoff(100))
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	txnBytes := loadResourceFileOrPanic("test_resources/payment.txn")
+
+	// The receiver fails the first request.
+	var mu sync.Mutex
+	var requests []*http.Request
+	var bodies [][]byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+		if len(requests) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer receiver.Close()
+
+	mockIndexer := &mocks.IndexerDb{}
+	queue := makeMemoryWebhookQueue()
+	db := webhookDb{mockIndexer, queue}
+	filterMatches := func(first, last uint64) interface{} {
+		return mock.MatchedBy(func(filter idb.TransactionFilter) bool {
+			return filter.MinRound == first && filter.MaxRound == last && filter.Limit == 0 && filter.TypeEnum == idb.TypeEnumPay
+		})
+	}
+	mockIndexer.On("Transactions", mock.Anything, filterMatches(10, 10)).Return(txnRows(
+		idb.TxnRow{Round: 10, Intra: 3, TxnBytes: txnBytes},
+	), uint64(10)).Once()
+	// The database has not caught up with round 12 yet.
+	mockIndexer.On("Transactions", mock.Anything, filterMatches(11, 12)).Return(txnRows(
+		idb.TxnRow{Round: 11, Intra: 0, TxnBytes: txnBytes},
+	), uint64(11)).Once()
+
+	subscription := WebhookSubscription{
+		Name:    "payments",
+		URL:     receiver.URL,
+		Secret:  "secret",
+		Filters: generated.SearchForTransactionsParams{TxType: strPtr("pay")},
+	}
+	w, err := MakeWebhooks(db, []WebhookSubscription{subscription}, log.New())
+	require.NoError(t, err)
+	now := time.Now()
+	w.now = func() time.Time { return now }
+
+	// A new subscription starts with the imported round.
+	w.HandleBlock(makeBlock(10))
+	w.HandleBlock(makeBlock(12))
+	cursor, ok, _ := queue.WebhookCursor("payments")
+	require.True(t, ok)
+	assert.Equal(t, uint64(11), cursor)
+
+	// The first attempt fails and is retried after the backoff, the other
+	// delivery of the subscription waits for the retry.
+	w.deliverDue(context.Background())
+	require.Len(t, requests, 1)
+	require.Len(t, queue.deliveries, 2)
+	assert.Equal(t, uint64(1), queue.deliveries[1].delivery.Attempts)
+	assert.Equal(t, now.Add(webhookMinBackoff), queue.deliveries[1].next)
+	assert.Contains(t, queue.deliveries[1].err, "503")
+
+	w.deliverDue(context.Background())
+	require.Len(t, requests, 1)
+
+	// The retry is kept by the queue, so a restarted daemon waits as well.
+	w, err = MakeWebhooks(db, []WebhookSubscription{subscription}, log.New())
+	require.NoError(t, err)
+	w.now = func() time.Time { return now }
+	w.deliverDue(context.Background())
+	require.Len(t, requests, 1)
+
+	now = now.Add(webhookMinBackoff)
+	w.deliverDue(context.Background())
+	require.Len(t, requests, 3)
+	assert.Empty(t, queue.deliveries)
+
+	// Retries are sent with the same id and body.
+	assert.Equal(t, "1", requests[1].Header.Get(headerWebhookID))
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.Equal(t, "2", requests[2].Header.Get(headerWebhookID))
+
+	var payload webhookPayload
+	require.NoError(t, json.Unmarshal(bodies[2], &payload))
+	assert.Equal(t, "payments", payload.Subscription)
+	assert.Equal(t, uint64(11), payload.Round)
+	assert.Equal(t, "payments", requests[2].Header.Get(headerWebhookSubscription))
+	assert.Equal(t, "sha256="+webhookSignature("secret", bodies[2]), requests[2].Header.Get(headerWebhookSignature))
+
+	var stxn types.SignedTxnWithAD
+	require.NoError(t, msgpack.Decode(txnBytes, &stxn))
+	assert.Equal(t, uint64(stxn.Txn.Fee), payload.Transaction.Fee)
+	mockIndexer.AssertExpectations(t)
+}
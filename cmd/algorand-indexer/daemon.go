@@ -12,11 +12,14 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/algorand/indexer/api"
+	"github.com/algorand/indexer/api/middlewares"
 	"github.com/algorand/indexer/config"
+	"github.com/algorand/indexer/exporters"
 	"github.com/algorand/indexer/fetcher"
 	"github.com/algorand/indexer/idb"
 	"github.com/algorand/indexer/importer"
 	"github.com/algorand/indexer/types"
+	"github.com/algorand/indexer/util"
 )
 
 var (
@@ -29,6 +32,16 @@ var (
 	allowMigration   bool
 	metricsMode      string
 	tokenString      string
+	tokenFile        string
+	exportRowLimit   uint64
+	rateLimit        middlewares.RateLimitConfig
+	endpointLimits   = make(map[string]*api.EndpointLimit)
+	disabledRoutes   []string
+	queryTimeouts    = make(map[string]*time.Duration)
+	maxQueryCost     float64
+	maxExportCost    float64
+	postgresReplicas []string
+	noWriterLock     bool
 )
 
 // importTimeHistogramSeconds is used to record the block import time metric.
@@ -75,50 +88,133 @@ var daemonCmd = &cobra.Command{
 			// no algod was found
 			noAlgod = true
 		}
-		opts := idb.IndexerDbOptions{}
+		opts := idb.IndexerDbOptions{
+			QueryGuards:  makeQueryGuards(),
+			ReadReplicas: postgresReplicas,
+		}
 		if noAlgod && !allowMigration {
 			opts.ReadOnly = true
 		}
+		// Every daemon importing from algod is a writer candidate, only the
+		// elected writer imports and runs migrations.
+		opts.ElectWriter = !noWriterLock && bot != nil && !opts.ReadOnly
 		db := indexerDbFromFlags(opts)
-		if bot != nil {
-			logger.Info("Initializing block import handler.")
-
-			nextRound, err := db.GetNextRoundToLoad()
-			maybeFail(err, "failed to get next round, %v", err)
-			bot.SetNextRound(nextRound)
-
-			cache, err := db.GetDefaultFrozen()
-			maybeFail(err, "failed to get default frozen cache")
-
-			bih := blockImporterHandler{
-				imp:   importer.NewDBImporter(db),
-				db:    db,
-				cache: cache,
+		var hooks importerHooks
+		hooks.webhooks, err = loadWebhooks(db)
+		maybeFail(err, "invalid webhook configuration, %v", err)
+		hooks.exporter, err = loadExporters()
+		maybeFail(err, "invalid exporter configuration, %v", err)
+		if (hooks.webhooks != nil || hooks.exporter != nil) && bot == nil {
+			logger.Warn("webhooks and exporters are only run by a daemon importing blocks")
+		}
+		if bot != nil && opts.ElectWriter {
+			elector, ok := db.(idb.WriterElector)
+			if !ok {
+				maybeFail(fmt.Errorf("unsupported database"), "writer election is not supported by the database")
 			}
-			bot.AddBlockHandler(&bih)
-			bot.SetContext(ctx)
-
 			go func() {
-				waitForDBAvailable(db)
+				logger.Info("Waiting to be elected as the writer.")
+				lost, err := elector.ElectWriter(ctx)
+				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					maybeFail(err, "writer election failed, %v", err)
+				}
+				logger.Info("Elected as the writer.")
 
-				// Initial import if needed.
-				importer.InitialImport(db, genesisJSONPath, bot.Algod(), logger)
+				// Another daemon may take over once the lock is lost, so
+				// importing must stop immediately. The daemon exits with an
+				// error so that it is restarted as a candidate.
+				go func() {
+					<-lost
+					logger.Error("lost the writer lock, stopping the importer and exiting")
+					cf()
+					os.Exit(1)
+				}()
 
-				logger.Info("Starting block importer.")
-				bot.Run()
-				cf()
+				startImporter(ctx, cf, db, bot, hooks)
 			}()
+		} else if bot != nil {
+			// Transaction streams are notified by the importer as soon as
+			// rounds are imported. Daemons which may not be the writer rely
+			// on the database instead.
+			hooks.rounds = util.MakeRoundBroadcaster()
+			startImporter(ctx, cf, db, bot, hooks)
 		} else {
 			logger.Info("No block importer configured.")
 		}
 
+		options := makeOptions()
+		if hooks.rounds != nil {
+			options.Rounds = hooks.rounds
+		}
+		if tokenFile != "" {
+			reloadTokensOnSIGHUP(options.Tokens)
+		}
+
 		// TODO: trap SIGTERM and call cf() to exit gracefully
 		fmt.Printf("serving on %s\n", daemonServerAddr)
 		logger.Infof("serving on %s", daemonServerAddr)
-		api.Serve(ctx, daemonServerAddr, db, bot, logger, makeOptions())
+		api.Serve(ctx, daemonServerAddr, db, bot, logger, options)
 	},
 }
 
+// importerHooks receive the rounds imported by startImporter, nil hooks are
+// skipped.
+type importerHooks struct {
+	// rounds publishes each imported round.
+	rounds *util.RoundBroadcaster
+
+	// webhooks delivers the matching transactions of each imported round.
+	webhooks *api.Webhooks
+
+	// exporter receives each round once its accounting is committed.
+	exporter exporters.Exporter
+}
+
+// startImporter follows algod and imports blocks into the IndexerDb.
+func startImporter(ctx context.Context, cf context.CancelFunc, db idb.IndexerDb, bot fetcher.Fetcher, hooks importerHooks) {
+	logger.Info("Initializing block import handler.")
+
+	nextRound, err := db.GetNextRoundToLoad()
+	maybeFail(err, "failed to get next round, %v", err)
+	bot.SetNextRound(nextRound)
+
+	cache, err := db.GetDefaultFrozen()
+	maybeFail(err, "failed to get default frozen cache")
+
+	bih := blockImporterHandler{
+		imp:      importer.NewDBImporter(db),
+		db:       db,
+		cache:    cache,
+		exporter: hooks.exporter,
+	}
+	bot.AddBlockHandler(&bih)
+	if hooks.rounds != nil {
+		// Block handlers run in order, so the round has been imported.
+		bot.AddBlockHandler(&roundPublisher{rounds: hooks.rounds})
+	}
+	if hooks.webhooks != nil {
+		bot.AddBlockHandler(hooks.webhooks)
+	}
+	bot.SetContext(ctx)
+
+	go func() {
+		waitForDBAvailable(db)
+		if hooks.webhooks != nil {
+			go hooks.webhooks.Run(ctx)
+		}
+
+		// Initial import if needed.
+		importer.InitialImport(db, genesisJSONPath, bot.Algod(), logger)
+
+		logger.Info("Starting block importer.")
+		bot.Run()
+		cf()
+	}()
+}
+
 // waitForDBAvailable wait for the IndexerDb to report that it is available.
 func waitForDBAvailable(db idb.IndexerDb) {
 	statusInterval := 5 * time.Minute
@@ -155,10 +251,36 @@ func init() {
 	daemonCmd.Flags().StringVarP(&genesisJSONPath, "genesis", "g", "", "path to genesis.json (defaults to genesis.json in algod data dir if that was set)")
 	daemonCmd.Flags().StringVarP(&daemonServerAddr, "server", "S", ":8980", "host:port to serve API on (default :8980)")
 	daemonCmd.Flags().BoolVarP(&noAlgod, "no-algod", "", false, "disable connecting to algod for block following")
+	daemonCmd.Flags().StringSliceVarP(&postgresReplicas, "postgres-replicas", "", nil, "connection strings of read only postgres replicas, API queries are spread over the replicas while imports and migrations use the primary")
+	daemonCmd.Flags().BoolVarP(&noWriterLock, "no-writer-lock", "", false, "import without taking the writer lock. By default an advisory lock elects the one daemon which imports and runs migrations, while the others only serve the API until the writer stops. Only disable it when no other daemon imports into the same database")
 	daemonCmd.Flags().StringVarP(&tokenString, "token", "t", "", "an optional auth token, when set REST calls must use this token in a bearer format, or in a 'X-Indexer-API-Token' header")
 	daemonCmd.Flags().BoolVarP(&developerMode, "dev-mode", "", false, "allow performance intensive operations like searching for accounts at a particular round")
 	daemonCmd.Flags().BoolVarP(&allowMigration, "allow-migration", "", false, "allow migrations to happen even when no algod connected")
 	daemonCmd.Flags().StringVarP(&metricsMode, "metrics-mode", "", "OFF", "configure the /metrics endpoint to [ON, OFF, VERBOSE]")
+	daemonCmd.Flags().StringVarP(&tokenFile, "token-file", "", "", "an optional file with named API tokens, each of which may restrict routes and override limits. The file is reloaded on SIGHUP")
+	daemonCmd.Flags().Uint64VarP(&exportRowLimit, "export-row-limit", "", 1000000, "maximum number of rows returned by a single CSV export request, 0 for no limit. CSV export is only available when a token is configured")
+	daemonCmd.Flags().Float64VarP(&rateLimit.Token.Rate, "rate-limit-token", "", 0, "requests per second allowed for each API token, 0 for no limit")
+	daemonCmd.Flags().Float64VarP(&rateLimit.Token.Burst, "rate-limit-token-burst", "", 0, "maximum burst of requests allowed for each API token (defaults to one second of requests)")
+	daemonCmd.Flags().Float64VarP(&rateLimit.IP.Rate, "rate-limit-ip", "", 0, "requests per second allowed for each client IP when no API token is used, 0 for no limit")
+	daemonCmd.Flags().Float64VarP(&rateLimit.IP.Burst, "rate-limit-ip-burst", "", 0, "maximum burst of requests allowed for each client IP (defaults to one second of requests)")
+	daemonCmd.Flags().BoolVarP(&rateLimit.TrustProxyHeaders, "rate-limit-trust-proxy", "", false, "use the X-Forwarded-For and X-Real-IP headers to find the client IP, only enable behind a proxy which sets them")
+	daemonCmd.Flags().Float64VarP(&rateLimit.RewindWeight, "rate-limit-rewind-weight", "", 10, "number of requests counted for an account search at a particular round")
+	daemonCmd.Flags().Float64VarP(&rateLimit.AssetWeight, "rate-limit-asset-weight", "", 10, "number of requests counted for a transaction or balance search by asset")
+
+	for _, name := range api.LimitNames {
+		limit := api.DefaultLimits[name]
+		endpointLimits[name] = &limit
+		daemonCmd.Flags().Uint64VarP(&limit.Default, fmt.Sprintf("default-%s-limit", name), "", limit.Default, fmt.Sprintf("number of %s returned by a search when no limit is requested", name))
+		daemonCmd.Flags().Uint64VarP(&limit.Max, fmt.Sprintf("max-%s-limit", name), "", limit.Max, fmt.Sprintf("maximum number of %s returned by a search", name))
+	}
+	for _, kind := range idb.QueryKinds {
+		timeout := new(time.Duration)
+		queryTimeouts[kind] = timeout
+		daemonCmd.Flags().DurationVarP(timeout, fmt.Sprintf("%s-query-timeout", kind), "", 0, fmt.Sprintf("cancel %s queries which run longer than this, for example '5s', 0 for no timeout. Cancelled queries return 503", kind))
+	}
+	daemonCmd.Flags().Float64VarP(&maxQueryCost, "max-query-cost", "", 0, "reject queries which postgres estimates cost more than this with a 400, 0 to disable. The estimate is made with EXPLAIN before the query runs")
+	daemonCmd.Flags().Float64VarP(&maxExportCost, "max-export-query-cost", "", 0, "reject CSV exports which postgres estimates cost more than this with a 400, 0 to disable. Exports are not limited by --max-query-cost")
+	daemonCmd.Flags().StringSliceVarP(&disabledRoutes, "disabled-endpoints", "", nil, "URL path patterns of endpoints which should return 501 Not Implemented, for example '/v2/assets/*/balances'. A pattern ending with '*' matches any path with that prefix")
 
 	viper.RegisterAlias("algod", "algod-data-dir")
 	viper.RegisterAlias("algod-net", "algod-address")
@@ -166,12 +288,40 @@ func init() {
 	viper.RegisterAlias("token", "api-token")
 }
 
+// makeQueryGuards converts CLI options to the query guards of the IndexerDb
+func makeQueryGuards() idb.QueryGuards {
+	guards := idb.QueryGuards{
+		Timeouts:      make(map[string]time.Duration),
+		MaxCost:       maxQueryCost,
+		ExportMaxCost: maxExportCost,
+	}
+	for kind, timeout := range queryTimeouts {
+		guards.Timeouts[kind] = *timeout
+	}
+	return guards
+}
+
 // makeOptions converts CLI options to server options
 func makeOptions() (options api.ExtraOptions) {
 	options.DeveloperMode = developerMode
-	if tokenString != "" {
-		options.Tokens = append(options.Tokens, tokenString)
+	options.ExportRowLimit = exportRowLimit
+	options.RateLimit = rateLimit
+	tokens, err := loadTokens()
+	maybeFail(err, "failed to load API tokens, %v", err)
+	options.Tokens = middlewares.MakeTokenStore(tokens)
+	options.Limits = make(map[string]api.EndpointLimit)
+	for name, limit := range endpointLimits {
+		if limit.Max == 0 || limit.Default > limit.Max {
+			err := fmt.Errorf("the max (%d) must be at least 1 and no less than the default (%d)", limit.Max, limit.Default)
+			maybeFail(err, "invalid %s limit, %v", name, err)
+		}
+		options.Limits[name] = *limit
+	}
+	for _, route := range disabledRoutes {
+		err := middlewares.ValidateRoute(route)
+		maybeFail(err, "invalid disabled endpoint '%s', %v", route, err)
 	}
+	options.DisabledEndpoints = disabledRoutes
 	switch strings.ToUpper(metricsMode) {
 	case "OFF":
 		options.MetricsEndpoint = false
@@ -188,9 +338,10 @@ func makeOptions() (options api.ExtraOptions) {
 }
 
 type blockImporterHandler struct {
-	imp   importer.Importer
-	db    idb.IndexerDb
-	cache map[uint64]bool
+	imp      importer.Importer
+	db       idb.IndexerDb
+	cache    map[uint64]bool
+	exporter exporters.Exporter
 }
 
 func (bih *blockImporterHandler) HandleBlock(block *types.EncodedBlockCert) {
@@ -211,9 +362,18 @@ func (bih *blockImporterHandler) HandleBlock(block *types.EncodedBlockCert) {
 		StartRound: nextUnaccountedRound,
 		MaxRound:   uint64(block.Block.Round),
 	}
-	importer.UpdateAccounting(bih.db, bih.cache, filter, logger)
+	importer.UpdateAccounting(bih.db, bih.cache, filter, logger, bih.exporter)
 	dt := time.Now().Sub(start)
 	// record metric
 	importTimeHistogramSeconds.Observe(dt.Seconds())
 	logger.Infof("round r=%d (%d txn) imported in %s", block.Block.Round, len(block.Block.Payset), dt.String())
 }
+
+// roundPublisher publishes the round of each imported block.
+type roundPublisher struct {
+	rounds *util.RoundBroadcaster
+}
+
+func (rp *roundPublisher) HandleBlock(block *types.EncodedBlockCert) {
+	rp.rounds.Publish(uint64(block.Block.Round))
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/algorand/indexer/exporters"
+)
+
+// exporterConfig is an exporter entry in the configuration file.
+type exporterConfig struct {
+	Type string `mapstructure:"type"`
+	Path string `mapstructure:"path"`
+}
+
+// loadExporters constructs the exporters listed in the configuration file, it
+// returns nil if there are none.
+func loadExporters() (exporters.Exporter, error) {
+	var configs []exporterConfig
+	if err := viper.UnmarshalKey("exporters", &configs); err != nil {
+		return nil, fmt.Errorf("could not parse exporters, %v", err)
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	all := make([]exporters.Exporter, 0, len(configs))
+	for i, config := range configs {
+		exporter, err := exporters.MakeExporter(exporters.Config{
+			Type: config.Type,
+			Path: config.Path,
+		})
+		if err != nil {
+			for _, opened := range all {
+				opened.Close()
+			}
+			return nil, fmt.Errorf("exporter %d: %v", i, err)
+		}
+		all = append(all, exporter)
+	}
+	return exporters.Multi(all...), nil
+}
@@ -38,7 +38,13 @@ var importCmd = &cobra.Command{
 			blockFileLimit,
 			logger)
 
+		helper.Exporter, err = loadExporters()
+		maybeFail(err, "invalid exporter configuration, %v", err)
+
 		helper.Import(db, args)
+		if helper.Exporter != nil {
+			helper.Exporter.Close()
+		}
 	},
 }
 
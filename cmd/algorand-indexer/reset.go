@@ -74,7 +74,7 @@ var resetCmd = &cobra.Command{
 						filter := idb.UpdateFilter{
 							MaxRound: nextRound - 1,
 						}
-						importer.UpdateAccounting(db, cache, filter, logger)
+						importer.UpdateAccounting(db, cache, filter, logger, nil)
 						fmt.Println("Done rebuilding accounting.")
 					} else {
 						fmt.Println("Done. No blocks to rebuild accounting from.")
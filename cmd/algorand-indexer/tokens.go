@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/viper"
+
+	"github.com/algorand/indexer/api"
+	"github.com/algorand/indexer/api/middlewares"
+)
+
+// tokenConfig is a token entry in the token file.
+type tokenConfig struct {
+	Name      string            `mapstructure:"name"`
+	Token     string            `mapstructure:"token"`
+	Routes    []string          `mapstructure:"routes"`
+	DevMode   bool              `mapstructure:"dev-mode"`
+	MaxLimits map[string]uint64 `mapstructure:"max-limits"`
+	RateLimit *struct {
+		Rate  float64 `mapstructure:"rate"`
+		Burst float64 `mapstructure:"burst"`
+	} `mapstructure:"rate-limit"`
+}
+
+// loadTokens reads the API tokens from the token file and the --token flag.
+func loadTokens() ([]middlewares.Token, error) {
+	var tokens []middlewares.Token
+	if tokenString != "" {
+		tokens = append(tokens, middlewares.Token{
+			Name:  "default",
+			Token: tokenString,
+		})
+	}
+	if tokenFile == "" {
+		return tokens, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(tokenFile)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("%s: could not read token file, %v", tokenFile, err)
+	}
+	var configs []tokenConfig
+	if err := v.UnmarshalKey("tokens", &configs); err != nil {
+		return nil, fmt.Errorf("%s: could not parse tokens, %v", tokenFile, err)
+	}
+
+	for _, config := range configs {
+		token := middlewares.Token{
+			Name:      config.Name,
+			Token:     config.Token,
+			Routes:    config.Routes,
+			DevMode:   config.DevMode,
+			MaxLimits: config.MaxLimits,
+		}
+		if config.RateLimit != nil {
+			token.RateLimit = &middlewares.Limit{
+				Rate:  config.RateLimit.Rate,
+				Burst: config.RateLimit.Burst,
+			}
+		}
+		tokens = append(tokens, token)
+	}
+
+	if err := validateTokens(tokens); err != nil {
+		return nil, fmt.Errorf("%s: %v", tokenFile, err)
+	}
+	return tokens, nil
+}
+
+// validateTokens makes sure that tokens can be told apart in logs and that
+// the limit overrides refer to real limits.
+func validateTokens(tokens []middlewares.Token) error {
+	limitNames := make(map[string]bool)
+	for _, name := range api.LimitNames {
+		limitNames[name] = true
+	}
+
+	names := make(map[string]bool)
+	secrets := make(map[string]bool)
+	for i, token := range tokens {
+		if token.Name == "" {
+			return fmt.Errorf("token %d has no name", i)
+		}
+		if token.Token == "" {
+			return fmt.Errorf("token '%s' has no token", token.Name)
+		}
+		if names[token.Name] {
+			return fmt.Errorf("token name '%s' is used more than once", token.Name)
+		}
+		if secrets[token.Token] {
+			return fmt.Errorf("token '%s' uses the same token as another entry", token.Name)
+		}
+		names[token.Name] = true
+		secrets[token.Token] = true
+
+		for _, route := range token.Routes {
+			if err := middlewares.ValidateRoute(route); err != nil {
+				return fmt.Errorf("token '%s' has an invalid route '%s', %v", token.Name, route, err)
+			}
+		}
+
+		for name := range token.MaxLimits {
+			if !limitNames[name] {
+				return fmt.Errorf("token '%s' has an unknown max limit '%s', expected one of %v", token.Name, name, api.LimitNames)
+			}
+		}
+	}
+	return nil
+}
+
+// reloadTokensOnSIGHUP replaces the tokens in the store whenever the process
+// receives SIGHUP. The current tokens are kept if the file can't be loaded.
+func reloadTokensOnSIGHUP(store *middlewares.TokenStore) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	go func() {
+		for range signals {
+			tokens, err := loadTokens()
+			if err != nil {
+				logger.WithError(err).Error("failed to reload API tokens, keeping the current tokens")
+				continue
+			}
+			store.Set(tokens)
+			logger.Infof("reloaded %d API tokens", len(tokens))
+		}
+	}()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/algorand/indexer/api"
+	"github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/idb"
+)
+
+// webhookConfig is a webhook entry in the configuration file.
+type webhookConfig struct {
+	Name          string  `mapstructure:"name"`
+	URL           string  `mapstructure:"url"`
+	Secret        string  `mapstructure:"secret"`
+	Address       *string `mapstructure:"address"`
+	AddressRole   *string `mapstructure:"address-role"`
+	AssetID       *uint64 `mapstructure:"asset-id"`
+	ApplicationID *uint64 `mapstructure:"application-id"`
+	TxType        *string `mapstructure:"tx-type"`
+}
+
+// loadWebhooks reads the webhook subscriptions from the configuration file,
+// it returns nil if there are none.
+func loadWebhooks(db idb.IndexerDb) (*api.Webhooks, error) {
+	var configs []webhookConfig
+	if err := viper.UnmarshalKey("webhooks", &configs); err != nil {
+		return nil, fmt.Errorf("could not parse webhooks, %v", err)
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	subscriptions := make([]api.WebhookSubscription, 0, len(configs))
+	for _, config := range configs {
+		subscriptions = append(subscriptions, api.WebhookSubscription{
+			Name:   config.Name,
+			URL:    config.URL,
+			Secret: config.Secret,
+			Filters: generated.SearchForTransactionsParams{
+				Address:       config.Address,
+				AddressRole:   config.AddressRole,
+				AssetId:       config.AssetID,
+				ApplicationId: config.ApplicationID,
+				TxType:        config.TxType,
+			},
+		})
+	}
+	return api.MakeWebhooks(db, subscriptions, logger)
+}
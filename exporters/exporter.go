@@ -0,0 +1,106 @@
+// Package exporters sends the data of each round to downstream systems once
+// indexer has committed its accounting.
+package exporters
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/types"
+)
+
+// Txn is a transaction of an exported round.
+type Txn struct {
+	// Intra is the offset of the transaction in the block.
+	Intra int
+
+	Txn types.SignedTxnWithAD
+}
+
+// Round is the data of a round whose accounting has been committed.
+type Round struct {
+	Round uint64
+
+	BlockHeader *types.BlockHeader
+
+	// Txns are the transactions of the round in block order.
+	Txns []Txn
+
+	// Updates are the account state changes computed by the accounting and
+	// written to the IndexerDb.
+	Updates *idb.RoundUpdates
+}
+
+// Exporter receives rounds after their accounting is committed. Rounds
+// without transactions have no accounting and are not exported.
+type Exporter interface {
+	// Export is called with each round, in order. The round must not be
+	// modified or retained after Export returns.
+	Export(round *Round) error
+
+	// Close flushes any buffered data and releases the exporter.
+	Close() error
+}
+
+// Config configures one of the built-in exporters.
+type Config struct {
+	// Type is "file" or "stdout".
+	Type string
+
+	// Path is the file which the "file" exporter appends to.
+	Path string
+}
+
+// Types are the types of the built-in exporters.
+var Types = []string{"file", "stdout"}
+
+// MakeExporter constructs a built-in exporter.
+func MakeExporter(config Config) (Exporter, error) {
+	switch config.Type {
+	case "file":
+		if config.Path == "" {
+			return nil, errors.New("the file exporter requires a path")
+		}
+		return MakeFileExporter(config.Path)
+	case "stdout":
+		return MakeStdoutExporter(), nil
+	default:
+		return nil, fmt.Errorf("unknown exporter type '%s', expected one of %s", config.Type, strings.Join(Types, ", "))
+	}
+}
+
+// multiExporter exports each round to several exporters.
+type multiExporter []Exporter
+
+// Multi returns an Exporter which exports each round to all of the exporters.
+func Multi(exporters ...Exporter) Exporter {
+	if len(exporters) == 1 {
+		return exporters[0]
+	}
+	return multiExporter(exporters)
+}
+
+// Export is part of Exporter. Every exporter is called even if one fails, the
+// first error is returned.
+func (m multiExporter) Export(round *Round) error {
+	var first error
+	for _, exporter := range m {
+		if err := exporter.Export(round); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// Close is part of Exporter.
+func (m multiExporter) Close() error {
+	var first error
+	for _, exporter := range m {
+		if err := exporter.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
@@ -0,0 +1,132 @@
+package exporters
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/types"
+)
+
+type recordingExporter struct {
+	rounds []uint64
+	err    error
+	closed bool
+}
+
+func (e *recordingExporter) Export(round *Round) error {
+	e.rounds = append(e.rounds, round.Round)
+	return e.err
+}
+
+func (e *recordingExporter) Close() error {
+	e.closed = true
+	return e.err
+}
+
+func TestMakeExporter(t *testing.T) {
+	_, err := MakeExporter(Config{Type: "kafka"})
+	assert.Error(t, err)
+
+	_, err = MakeExporter(Config{Type: "file"})
+	assert.Error(t, err)
+
+	exporter, err := MakeExporter(Config{Type: "stdout"})
+	require.NoError(t, err)
+	assert.NoError(t, exporter.Close())
+}
+
+func TestMulti(t *testing.T) {
+	failing := &recordingExporter{err: errors.New("failed")}
+	other := &recordingExporter{}
+	multi := Multi(failing, other)
+
+	// Every exporter receives the round even if one fails.
+	assert.Error(t, multi.Export(&Round{Round: 5}))
+	assert.Equal(t, []uint64{5}, failing.rounds)
+	assert.Equal(t, []uint64{5}, other.rounds)
+
+	assert.Error(t, multi.Close())
+	assert.True(t, failing.closed)
+	assert.True(t, other.closed)
+
+	assert.Equal(t, other, Multi(other))
+}
+
+func TestFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rounds.jsonl")
+	exporter, err := MakeFileExporter(path)
+	require.NoError(t, err)
+
+	var sender, receiver types.Address
+	sender[0] = 1
+	receiver[0] = 2
+
+	var updates idb.RoundUpdates
+	updates.Clear()
+	updates.AlgoUpdates[sender] = &idb.AlgoUpdate{Balance: -1000}
+	updates.AlgoUpdates[receiver] = &idb.AlgoUpdate{Balance: 1000}
+	updates.AccountTypes[sender] = "sig"
+	updates.AssetUpdates[0][receiver] = []idb.AssetUpdate{
+		{AssetID: 7, Transfer: &idb.AssetTransfer{Delta: *big.NewInt(-3)}},
+	}
+
+	var stxn types.SignedTxnWithAD
+	stxn.Txn.Sender = sender
+	stxn.Txn.Fee = 1000
+	round := Round{
+		Round:       10,
+		BlockHeader: &types.BlockHeader{Round: 10},
+		Txns:        []Txn{{Intra: 0, Txn: stxn}},
+		Updates:     &updates,
+	}
+	require.NoError(t, exporter.Export(&round))
+	round.Round = 11
+	round.BlockHeader = &types.BlockHeader{Round: 11}
+	require.NoError(t, exporter.Export(&round))
+	require.NoError(t, exporter.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.Len(t, lines, 2)
+	assert.Equal(t, 10.0, lines[0]["round"])
+	assert.Equal(t, 11.0, lines[1]["round"])
+
+	block := lines[0]["block"].(map[string]interface{})
+	assert.Equal(t, 10.0, block["rnd"])
+
+	txns := lines[0]["txns"].([]interface{})
+	require.Len(t, txns, 1)
+	txn := txns[0].(map[string]interface{})["txn"].(map[string]interface{})["txn"].(map[string]interface{})
+	assert.Equal(t, 1000.0, txn["fee"])
+
+	u := lines[0]["updates"].(map[string]interface{})
+	algo := u["algo"].(map[string]interface{})
+	assert.Equal(t, -1000.0, algo[sender.String()].(map[string]interface{})["balance"])
+	assert.Equal(t, 1000.0, algo[receiver.String()].(map[string]interface{})["balance"])
+	assert.Equal(t, "sig", u["account-types"].(map[string]interface{})[sender.String()])
+
+	assets := u["assets"].([]interface{})
+	require.Len(t, assets, 1)
+	asset := assets[0].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, receiver.String(), asset["address"])
+	assert.Equal(t, 7.0, asset["asset-id"])
+	assert.Equal(t, "-3", asset["delta"])
+}
@@ -0,0 +1,270 @@
+package exporters
+
+import (
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	sdk_types "github.com/algorand/go-algorand-sdk/types"
+	"github.com/algorand/go-codec/codec"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/types"
+)
+
+// jsonHandle encodes a round per line. Blocks and transactions use the field
+// names of their msgpack encoding.
+var jsonHandle *codec.JsonHandle
+
+func init() {
+	jsonHandle = new(codec.JsonHandle)
+	jsonHandle.Canonical = true
+	jsonHandle.HTMLCharsAsIs = true
+}
+
+// roundRecord is the JSON encoding of a Round.
+type roundRecord struct {
+	Round   uint64            `codec:"round"`
+	Block   types.BlockHeader `codec:"block"`
+	Txns    []txnRecord       `codec:"txns"`
+	Updates updatesRecord     `codec:"updates"`
+}
+
+type txnRecord struct {
+	Intra int                   `codec:"intra"`
+	Txn   types.SignedTxnWithAD `codec:"txn"`
+}
+
+// updatesRecord is the JSON encoding of idb.RoundUpdates, accounts are keyed
+// by their address.
+type updatesRecord struct {
+	Algo          map[string]algoRecord                   `codec:"algo"`
+	AccountTypes  map[string]string                       `codec:"account-types"`
+	AccountData   map[string]map[string]accountDataRecord `codec:"account-data"`
+	Assets        [][]assetRecord                         `codec:"assets"`
+	AssetDestroys []uint64                                `codec:"asset-destroys"`
+	AppGlobal     []appRecord                             `codec:"app-global"`
+	AppLocal      []appRecord                             `codec:"app-local"`
+}
+
+type algoRecord struct {
+	Balance int64 `codec:"balance"`
+	Rewards int64 `codec:"rewards"`
+	Closed  bool  `codec:"closed"`
+}
+
+type accountDataRecord struct {
+	Delete bool        `codec:"delete"`
+	Value  interface{} `codec:"value"`
+}
+
+// assetRecord is an idb.AssetUpdate of an account. Each sub-round of asset
+// updates is a separate list.
+type assetRecord struct {
+	Address       string             `codec:"address"`
+	AssetID       uint64             `codec:"asset-id"`
+	DefaultFrozen bool               `codec:"default-frozen"`
+	Delta         *string            `codec:"delta,omitempty"`
+	Close         *assetCloseRecord  `codec:"close,omitempty"`
+	Config        *assetConfigRecord `codec:"config,omitempty"`
+	Freeze        *bool              `codec:"frozen,omitempty"`
+}
+
+type assetCloseRecord struct {
+	CloseTo string `codec:"close-to"`
+	Sender  string `codec:"sender"`
+	Round   uint64 `codec:"round"`
+	Offset  uint64 `codec:"offset"`
+}
+
+type assetConfigRecord struct {
+	IsNew   bool              `codec:"is-new"`
+	Creator string            `codec:"creator"`
+	Params  types.AssetParams `codec:"params"`
+}
+
+// appRecord is an idb.AppDelta.
+type appRecord struct {
+	AppIndex          int64                  `codec:"app-id"`
+	Intra             int                    `codec:"intra"`
+	Address           string                 `codec:"address"`
+	AddrIndex         uint64                 `codec:"address-index"`
+	Creator           string                 `codec:"creator"`
+	Delta             types.StateDelta       `codec:"delta"`
+	OnCompletion      sdk_types.OnCompletion `codec:"on-completion"`
+	ApprovalProgram   []byte                 `codec:"approval-program"`
+	ClearStateProgram []byte                 `codec:"clear-state-program"`
+	LocalStateSchema  sdk_types.StateSchema  `codec:"local-state-schema"`
+	GlobalStateSchema sdk_types.StateSchema  `codec:"global-state-schema"`
+}
+
+// addressString encodes an address stored as bytes, or returns an empty
+// string if there isn't one.
+func addressString(b []byte) string {
+	if len(b) != len(types.Address{}) {
+		return ""
+	}
+	var addr types.Address
+	copy(addr[:], b)
+	return addr.String()
+}
+
+func makeAppRecords(deltas []idb.AppDelta) []appRecord {
+	records := make([]appRecord, 0, len(deltas))
+	for _, delta := range deltas {
+		records = append(records, appRecord{
+			AppIndex:          delta.AppIndex,
+			Intra:             delta.Intra,
+			Address:           addressString(delta.Address),
+			AddrIndex:         delta.AddrIndex,
+			Creator:           addressString(delta.Creator),
+			Delta:             delta.Delta,
+			OnCompletion:      delta.OnCompletion,
+			ApprovalProgram:   delta.ApprovalProgram,
+			ClearStateProgram: delta.ClearStateProgram,
+			LocalStateSchema:  delta.LocalStateSchema,
+			GlobalStateSchema: delta.GlobalStateSchema,
+		})
+	}
+	return records
+}
+
+func makeAssetRecords(subround map[[32]byte][]idb.AssetUpdate) []assetRecord {
+	// Accounts are sorted so that the output is deterministic.
+	addrs := make([]types.Address, 0, len(subround))
+	for addr := range subround {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return string(addrs[i][:]) < string(addrs[j][:])
+	})
+
+	var records []assetRecord
+	for _, addr := range addrs {
+		for _, update := range subround[addr] {
+			record := assetRecord{
+				Address:       addr.String(),
+				AssetID:       update.AssetID,
+				DefaultFrozen: update.DefaultFrozen,
+			}
+			if update.Transfer != nil {
+				delta := update.Transfer.Delta.String()
+				record.Delta = &delta
+			}
+			if update.Close != nil {
+				record.Close = &assetCloseRecord{
+					CloseTo: update.Close.CloseTo.String(),
+					Sender:  update.Close.Sender.String(),
+					Round:   update.Close.Round,
+					Offset:  update.Close.Offset,
+				}
+			}
+			if update.Config != nil {
+				record.Config = &assetConfigRecord{
+					IsNew:   update.Config.IsNew,
+					Creator: update.Config.Creator.String(),
+					Params:  update.Config.Params,
+				}
+			}
+			if update.Freeze != nil {
+				frozen := update.Freeze.Frozen
+				record.Freeze = &frozen
+			}
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func makeUpdatesRecord(updates *idb.RoundUpdates) updatesRecord {
+	record := updatesRecord{
+		Algo:          make(map[string]algoRecord, len(updates.AlgoUpdates)),
+		AccountTypes:  make(map[string]string, len(updates.AccountTypes)),
+		AccountData:   make(map[string]map[string]accountDataRecord, len(updates.AccountDataUpdates)),
+		AssetDestroys: updates.AssetDestroys,
+		AppGlobal:     makeAppRecords(updates.AppGlobalDeltas),
+		AppLocal:      makeAppRecords(updates.AppLocalDeltas),
+	}
+	for addr, update := range updates.AlgoUpdates {
+		record.Algo[types.Address(addr).String()] = algoRecord{
+			Balance: update.Balance,
+			Rewards: update.Rewards,
+			Closed:  update.Closed,
+		}
+	}
+	for addr, ktype := range updates.AccountTypes {
+		record.AccountTypes[types.Address(addr).String()] = ktype
+	}
+	for addr, fields := range updates.AccountDataUpdates {
+		data := make(map[string]accountDataRecord, len(fields))
+		for field, update := range fields {
+			data[field] = accountDataRecord{Delete: update.Delete, Value: update.Value}
+		}
+		record.AccountData[types.Address(addr).String()] = data
+	}
+	for _, subround := range updates.AssetUpdates {
+		if len(subround) > 0 {
+			record.Assets = append(record.Assets, makeAssetRecords(subround))
+		}
+	}
+	return record
+}
+
+// jsonLinesExporter writes each round as a line of JSON.
+type jsonLinesExporter struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+// MakeFileExporter constructs an Exporter appending rounds to the file at path,
+// one JSON object per line.
+func MakeFileExporter(path string) (Exporter, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonLinesExporter{w: f, closer: f}, nil
+}
+
+// MakeStdoutExporter constructs an Exporter writing rounds to stdout, one JSON
+// object per line.
+func MakeStdoutExporter() Exporter {
+	return &jsonLinesExporter{w: os.Stdout}
+}
+
+// Export is part of Exporter.
+func (e *jsonLinesExporter) Export(round *Round) error {
+	record := roundRecord{
+		Round:   round.Round,
+		Txns:    make([]txnRecord, 0, len(round.Txns)),
+		Updates: makeUpdatesRecord(round.Updates),
+	}
+	if round.BlockHeader != nil {
+		record.Block = *round.BlockHeader
+	}
+	for _, txn := range round.Txns {
+		record.Txns = append(record.Txns, txnRecord{Intra: txn.Intra, Txn: txn.Txn})
+	}
+
+	var line []byte
+	if err := codec.NewEncoderBytes(&line, jsonHandle).Encode(record); err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	// Each line is written with a single call so that rounds don't interleave.
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, err := e.w.Write(line)
+	return err
+}
+
+// Close is part of Exporter.
+func (e *jsonLinesExporter) Close() error {
+	if e.closer == nil {
+		return nil
+	}
+	return e.closer.Close()
+}
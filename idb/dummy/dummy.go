@@ -102,6 +102,36 @@ func (db *dummyIndexerDb) Applications(ctx context.Context, filter *models.Searc
 	return nil, 0
 }
 
+// AccountChanges is part of idb.IndexerDB
+func (db *dummyIndexerDb) AccountChanges(ctx context.Context, query idb.AccountChangesQuery) (<-chan idb.AccountChangeRow, uint64) {
+	return nil, 0
+}
+
+// AccountRekeys is part of idb.IndexerDB
+func (db *dummyIndexerDb) AccountRekeys(ctx context.Context, query idb.AccountRekeysQuery) (<-chan idb.AccountRekeyRow, uint64) {
+	return nil, 0
+}
+
+// AssetStats is part of idb.IndexerDB
+func (db *dummyIndexerDb) AssetStats(ctx context.Context, query idb.AssetStatsQuery) (idb.AssetStats, uint64, error) {
+	return idb.AssetStats{}, 0, nil
+}
+
+// TransactionStats is part of idb.IndexerDB
+func (db *dummyIndexerDb) TransactionStats(ctx context.Context, query idb.TransactionStatsQuery) (<-chan idb.TransactionStatsRow, uint64) {
+	return nil, 0
+}
+
+// NetworkTotals is part of idb.IndexerDB
+func (db *dummyIndexerDb) NetworkTotals(ctx context.Context) (idb.NetworkTotals, uint64, error) {
+	return idb.NetworkTotals{}, 0, nil
+}
+
+// StakeTotals is part of idb.IndexerDB
+func (db *dummyIndexerDb) StakeTotals(ctx context.Context, query idb.StakeTotalsQuery) (idb.StakeTotals, uint64, error) {
+	return idb.StakeTotals{}, 0, nil
+}
+
 // Health is part of idb.IndexerDB
 func (db *dummyIndexerDb) Health() (state idb.Health, err error) {
 	return idb.Health{}, nil
@@ -58,6 +58,10 @@ func DecodeTxnRowNext(s string) (round uint64, intra uint32, err error) {
 	if err != nil {
 		return
 	}
+	if len(b) != 12 {
+		err = fmt.Errorf("invalid next token length %d", len(b))
+		return
+	}
 	round = binary.LittleEndian.Uint64(b[:8])
 	intra = binary.LittleEndian.Uint32(b[8:])
 	return
@@ -74,6 +78,22 @@ type TxnExtra struct {
 // because initialization has not been completed.
 var ErrorNotInitialized error = errors.New("accounting not initialized")
 
+// ErrorQueryTimeout is used when a query is cancelled because it ran longer
+// than its statement timeout.
+var ErrorQueryTimeout error = errors.New("query timed out")
+
+// ErrorQueryTooExpensive is used when a query is rejected because its
+// estimated cost is above the maximum.
+var ErrorQueryTooExpensive error = errors.New("query is too expensive")
+
+// ErrorAssetNotFound is used when requesting the statistics of an asset which
+// was never created.
+var ErrorAssetNotFound error = errors.New("asset not found")
+
+// ErrorStakeTotalsNotFound is used when requesting the stake totals of a round
+// before they were first recorded.
+var ErrorStakeTotalsNotFound error = errors.New("stake totals not found")
+
 // IndexerDb is the interface used to define alternative Indexer backends.
 // TODO: sqlite3 impl
 // TODO: cockroachdb impl
@@ -105,6 +125,18 @@ type IndexerDb interface {
 	Assets(ctx context.Context, filter AssetsQuery) (<-chan AssetRow, uint64)
 	AssetBalances(ctx context.Context, abq AssetBalanceQuery) (<-chan AssetBalanceRow, uint64)
 	Applications(ctx context.Context, filter *models.SearchForApplicationsParams) (<-chan ApplicationRow, uint64)
+	AccountChanges(ctx context.Context, query AccountChangesQuery) (<-chan AccountChangeRow, uint64)
+	AccountRekeys(ctx context.Context, query AccountRekeysQuery) (<-chan AccountRekeyRow, uint64)
+
+	// AssetStats returns the statistics of an asset and the latest round accounted.
+	AssetStats(ctx context.Context, query AssetStatsQuery) (AssetStats, uint64, error)
+
+	// TransactionStats returns the transaction statistics of each day in day order.
+	TransactionStats(ctx context.Context, query TransactionStatsQuery) (<-chan TransactionStatsRow, uint64)
+	// NetworkTotals returns the totals of the current state and the latest round accounted.
+	NetworkTotals(ctx context.Context) (NetworkTotals, uint64, error)
+	// StakeTotals returns the stake totals as of a round and the latest round accounted.
+	StakeTotals(ctx context.Context, query StakeTotalsQuery) (StakeTotals, uint64, error)
 
 	Health() (status Health, err error)
 	Reset() (err error)
@@ -176,6 +208,9 @@ type TransactionFilter struct {
 	NextToken string
 
 	Limit uint64
+
+	// Export applies the QueryExport guards instead of the search ones.
+	Export bool
 }
 
 // AccountQueryOptions is a parameter object with all of the account filter options.
@@ -206,9 +241,54 @@ type AccountQueryOptions struct {
 	// IncludeDeleted indicated whether to include deleted Assets, Applications, etc within the account.
 	IncludeDeleted bool
 
+	// Status filters on the participation status of the account data:
+	// 0 offline, 1 online and 2 not participating.
+	Status *int
+	// VoteLastValidBefore and VoteLastValidAfter filter on the last round of
+	// the registered participation key, accounts which never registered one
+	// are excluded.
+	VoteLastValidBefore *uint64
+	VoteLastValidAfter  *uint64
+
+	// MinBalanceGreaterThan and MinBalanceLessThan filter on the minimum
+	// balance at the protocol of the latest round accounted.
+	MinBalanceGreaterThan *uint64
+	MinBalanceLessThan    *uint64
+
+	// SigType filters on the type of signature last used by the account,
+	// sig, msig or lsig.
+	SigType string
+	// CreatedAfterRound, CreatedBeforeRound, ClosedAfterRound and
+	// ClosedBeforeRound filter on the round the account was first used and
+	// the round it was last closed.
+	CreatedAfterRound  *uint64
+	CreatedBeforeRound *uint64
+	ClosedAfterRound   *uint64
+	ClosedBeforeRound  *uint64
+	// IsRekeyed filters on whether the account has an authorized address.
+	IsRekeyed *bool
+	// AuthAddrHistory filters on accounts which were ever rekeyed to the address.
+	AuthAddrHistory []byte
+	// MultisigKey filters on msig accounts with the public key among their
+	// subsignature keys.
+	MultisigKey []byte
+
+	// OrderByBalance returns the largest balances first instead of using
+	// address order, pages start after AfterBalance.
+	OrderByBalance bool
+	AfterBalance   *AmountCursor
+
 	Limit uint64
 }
 
+// AmountCursor is used for paging results which are ordered by amount, the
+// largest first. The next page starts after the account with this amount and
+// address.
+type AmountCursor struct {
+	Amount  uint64
+	Address []byte
+}
+
 // AccountRow is metadata relating to one account in a account query.
 type AccountRow struct {
 	Account models.Account
@@ -226,9 +306,25 @@ type AssetsQuery struct {
 	Name string
 	// Unit is a case insensitive substring comparison of the asset unit
 	Unit string
-	// Query checks for fuzzy match against either asset name or unit name
-	// (assetname ILIKE '%?%' OR unitname ILIKE '%?%')
+	// Query searches the asset name and unit name. Results are ordered by
+	// their score, exact matches first, then prefix matches, then similar or
+	// containing names.
 	Query string
+	// PrevScore and AssetIDGreaterThan page through Query results, they are
+	// the score and asset id of the last asset returned.
+	PrevScore *float64
+
+	// Manager, Reserve, Freeze and Clawback filter on the role addresses of the asset params.
+	Manager  []byte
+	Reserve  []byte
+	Freeze   []byte
+	Clawback []byte
+	// URLPrefix is a case sensitive prefix of the asset URL.
+	URLPrefix    string
+	MetadataHash []byte
+	Decimals     *uint64
+	// DefaultFrozen filters on the default frozen state of new holdings.
+	DefaultFrozen *bool
 
 	// IncludeDeleted indicated whether to include deleted Assets in the results.
 	IncludeDeleted bool
@@ -245,6 +341,8 @@ type AssetRow struct {
 	CreatedRound *uint64
 	ClosedRound  *uint64
 	Deleted      *bool
+	// Score is the relevance of the asset to AssetsQuery.Query.
+	Score *float64
 }
 
 // AssetBalanceQuery is a parameter object with all of the asset balance filter options.
@@ -261,6 +359,111 @@ type AssetBalanceQuery struct {
 	// PrevAddress for paging, the last item from the previous
 	// query (items returned in address order)
 	PrevAddress []byte
+
+	// OrderByAmount returns the largest amounts first instead of using
+	// address order, pages start after AfterAmount.
+	OrderByAmount bool
+	AfterAmount   *AmountCursor
+
+	// Export applies the QueryExport guards instead of the search ones.
+	Export bool
+}
+
+// AssetStatsQuery selects an asset and the rounds whose transfers are counted.
+type AssetStatsQuery struct {
+	AssetID uint64
+
+	// MinRound and MaxRound bound the transfer window, 0 is unbounded.
+	MinRound uint64
+	MaxRound uint64
+}
+
+// AssetStats are the holder counts and supply of an asset, and its transfers
+// within the requested rounds.
+type AssetStats struct {
+	AssetID uint64
+
+	// Holders is the number of accounts holding a non-zero amount.
+	Holders uint64
+	// OptedIn is the number of accounts opted in, including those holding zero.
+	OptedIn uint64
+
+	Total             uint64
+	CirculatingSupply uint64
+
+	// Transfers is the number of transfers with a non-zero amount.
+	Transfers uint64
+	// Volume is the amount transferred, it saturates at the maximum uint64.
+	Volume uint64
+
+	Deleted bool
+}
+
+// TransactionStatsQuery selects the days whose transaction statistics are returned.
+type TransactionStatsQuery struct {
+	// MinRound and MaxRound select the days including any of the rounds, 0 is unbounded.
+	MinRound uint64
+	MaxRound uint64
+
+	Limit uint64
+
+	// AfterDay for paging, the day of the last result of the previous query.
+	AfterDay *time.Time
+}
+
+// TransactionStatsRow is the transactions of a UTC day, by block time.
+type TransactionStatsRow struct {
+	Day time.Time
+
+	// MinRound and MaxRound are the first and last rounds of the day.
+	MinRound uint64
+	MaxRound uint64
+
+	// Counts are the number of transactions of each type enum.
+	Counts map[int]uint64
+
+	// Fees is the sum of the fees, it saturates at the maximum uint64.
+	Fees uint64
+
+	// ActiveAddresses is the number of addresses taking part in a transaction.
+	ActiveAddresses uint64
+
+	Error error
+}
+
+// NetworkTotals are the number of accounts, assets, applications and opt ins
+// which are not deleted.
+type NetworkTotals struct {
+	Accounts    uint64
+	Assets      uint64
+	Apps        uint64
+	AssetOptIns uint64
+	AppOptIns   uint64
+}
+
+// StakeTotalsQuery selects the round of the stake totals.
+type StakeTotalsQuery struct {
+	// Round is nil for the latest round accounted.
+	Round *uint64
+}
+
+// ParticipationTotals are the balances of the accounts with one participation status.
+type ParticipationTotals struct {
+	// Money is the sum of the balances, not including pending rewards.
+	Money uint64
+	// RewardUnits is the sum of the reward units of each account, the whole Algos of its balance.
+	RewardUnits uint64
+	Accounts    uint64
+}
+
+// StakeTotals are the balances of the accounts which are not deleted, by
+// participation status, like the account totals of the ledger.
+type StakeTotals struct {
+	// Round is the last round, at or before the requested one, which changed the totals.
+	Round            uint64
+	Online           ParticipationTotals
+	Offline          ParticipationTotals
+	NotParticipating ParticipationTotals
 }
 
 // AssetBalanceRow is metadata relating to one asset balance in an asset balance query.
@@ -275,6 +478,118 @@ type AssetBalanceRow struct {
 	Deleted      *bool
 }
 
+// AccountChangesQuery is a parameter object with the account change log filter options.
+type AccountChangesQuery struct {
+	Address []byte
+
+	MinRound uint64
+	MaxRound uint64 // 0 for no maximum
+
+	Limit uint64 // max rows to return
+
+	// PrevRound for paging, the round of the last item from the previous
+	// query (items returned in round order)
+	PrevRound *uint64
+}
+
+// AccountChange is what a round changed in an account. IndexerDb backends
+// record it when committing the round's accounting.
+type AccountChange struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// BalanceIncrease and BalanceDecrease are the net change to the balance
+	// in microalgos, at most one of them is set.
+	BalanceIncrease uint64 `codec:"balinc"`
+	BalanceDecrease uint64 `codec:"baldec"`
+
+	// Rewards are the rewards paid to the account.
+	Rewards uint64 `codec:"rwd"`
+	Closed  bool   `codec:"closed"`
+
+	// SigType is set if the account signed with a new type of signature.
+	SigType string `codec:"sigtype"`
+
+	// Rekeyed is set if the authorized address changed, AuthAddr is zero if
+	// the account was rekeyed back to itself.
+	Rekeyed  bool          `codec:"rekeyed"`
+	AuthAddr types.Address `codec:"spend"`
+
+	// Status is set if a key registration changed the participation status.
+	Status string `codec:"onl"`
+
+	Assets []AccountAssetChange `codec:"assets"`
+	Apps   []AccountAppChange   `codec:"apps"`
+}
+
+// AccountAssetChange is what a round changed in an asset holding.
+type AccountAssetChange struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	AssetID uint64 `codec:"id"`
+
+	// AmountIncrease and AmountDecrease are the net change to the amount
+	// held, at most one of them is set.
+	AmountIncrease uint64 `codec:"inc"`
+	AmountDecrease uint64 `codec:"dec"`
+
+	OptedIn bool          `codec:"optin"`
+	Closed  bool          `codec:"closed"`
+	CloseTo types.Address `codec:"closeto"`
+
+	// Freeze is set by asset freeze transactions, Frozen is the new state.
+	Freeze bool `codec:"freeze"`
+	Frozen bool `codec:"frozen"`
+}
+
+// AccountAppChange is what a round changed in an application local state.
+type AccountAppChange struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	AppID uint64 `codec:"id"`
+
+	OptedIn      bool `codec:"optin"`
+	ClosedOut    bool `codec:"closeout"`
+	StateChanged bool `codec:"state"`
+}
+
+// AccountChangeRow is one round in an account change log query.
+type AccountChangeRow struct {
+	Round  uint64
+	Change AccountChange
+	Error  error
+}
+
+// AccountRekeysQuery is a parameter object with the rekey history filter options.
+type AccountRekeysQuery struct {
+	Address []byte
+
+	MinRound uint64
+	MaxRound uint64 // 0 for no maximum
+
+	Limit uint64 // max rows to return
+
+	// PrevRound and PrevIntra for paging, the position of the last rekey
+	// from the previous query (rekeys are returned in order)
+	PrevRound *uint64
+	PrevIntra uint64
+}
+
+// AccountRekey is a change of the authorized address of an account. The
+// addresses are nil when the account signs for itself.
+type AccountRekey struct {
+	Round       uint64
+	Intra       uint64
+	Txid        string
+	OldAuthAddr []byte
+	NewAuthAddr []byte
+}
+
+// AccountRekeyRow is a rekey of an account, or an error.
+type AccountRekeyRow struct {
+	Rekey AccountRekey
+	Error error
+}
+
 // ApplicationRow is metadata relating to one application in an application query.
 type ApplicationRow struct {
 	Application models.Application
@@ -288,6 +603,114 @@ type IndexerDbOptions struct {
 	// NoMigrate indicates to not run any migrations.
 	// Should probably only be used by the `reset` subcommand.
 	NoMigrate bool
+
+	// QueryGuards bound the time and cost of API queries.
+	QueryGuards QueryGuards
+
+	// ReadReplicas are connection strings of read only replicas. When set,
+	// API queries are spread over the replicas while imports and migrations
+	// use the primary.
+	ReadReplicas []string
+
+	// ElectWriter opens the database read only, so that several daemons can
+	// share it. See WriterElector.
+	ElectWriter bool
+}
+
+// RoundNotifier is implemented by IndexerDb backends which are notified when
+// a round is committed, including rounds committed by another process sharing
+// the database.
+type RoundNotifier interface {
+	// CurrentRound returns the latest round accounted. It is kept up to date
+	// by the notifications, so it is cheaper than GetMaxRoundAccounted.
+	CurrentRound() (uint64, error)
+
+	// SubscribeRounds returns a channel receiving each committed round until
+	// ctx is done. A slow subscriber may skip rounds, but always receives the
+	// latest one.
+	SubscribeRounds(ctx context.Context) <-chan uint64
+}
+
+// WriterElector is implemented by IndexerDb backends which can elect a single
+// writer among several daemons sharing a database.
+type WriterElector interface {
+	// ElectWriter blocks until this process is elected as the writer or ctx
+	// is done. Once elected the database is initialized and migrations are
+	// started. The returned channel is closed if the writer lock is lost,
+	// after which the process must stop writing.
+	ElectWriter(ctx context.Context) (lost <-chan struct{}, err error)
+}
+
+// WebhookDelivery is a webhook notification waiting to be delivered.
+type WebhookDelivery struct {
+	// ID identifies the delivery, receivers use it to ignore duplicates.
+	ID uint64
+
+	// Subscription is the name of the webhook subscription.
+	Subscription string
+
+	// Round and Intra locate the transaction which triggered the delivery.
+	Round uint64
+	Intra uint32
+
+	// Payload is the request body.
+	Payload []byte
+
+	// Attempts is the number of failed delivery attempts.
+	Attempts uint64
+}
+
+// WebhookQueue is implemented by IndexerDb backends which persist webhook
+// deliveries, so that they are not lost when the daemon restarts.
+type WebhookQueue interface {
+	// WebhookCursor returns the last round enqueued for the subscription, or
+	// false if nothing was enqueued yet.
+	WebhookCursor(subscription string) (round uint64, ok bool, err error)
+
+	// EnqueueWebhooks stores the deliveries and moves the cursor of the
+	// subscription to round atomically. Deliveries for a transaction which
+	// is already queued are ignored.
+	EnqueueWebhooks(subscription string, round uint64, deliveries []WebhookDelivery) error
+
+	// DueWebhooks returns up to limit deliveries of the subscriptions whose
+	// next attempt is due at now, oldest first. The deliveries queued after
+	// one which is waiting for a retry are held back with it, so that each
+	// subscription is delivered in order.
+	DueWebhooks(subscriptions []string, now time.Time, limit uint64) ([]WebhookDelivery, error)
+
+	// WebhookDelivered removes a delivery once the receiver accepted it.
+	WebhookDelivered(id uint64) error
+
+	// WebhookFailed records a failed attempt and schedules the next one.
+	WebhookFailed(id uint64, next time.Time, message string) error
+}
+
+// Kinds of API queries, the query guards are configured for each kind.
+const (
+	QueryTransactions = "transactions"
+	QueryAccounts     = "accounts"
+	QueryAssets       = "assets"
+	QueryBalances     = "balances"
+	QueryApplications = "applications"
+	QueryExport       = "export"
+)
+
+// QueryKinds are all of the kinds of API queries.
+var QueryKinds = []string{QueryTransactions, QueryAccounts, QueryAssets, QueryBalances, QueryApplications, QueryExport}
+
+// QueryGuards bound the time and cost of API queries, zero values disable a guard.
+type QueryGuards struct {
+	// Timeouts are the statement timeouts of each kind of query. Queries
+	// running longer are cancelled with ErrorQueryTimeout.
+	Timeouts map[string]time.Duration
+
+	// MaxCost rejects queries with ErrorQueryTooExpensive when the planner
+	// estimates a larger total cost.
+	MaxCost float64
+
+	// ExportMaxCost replaces MaxCost for QueryExport queries, which return
+	// many more rows than a search.
+	ExportMaxCost float64
 }
 
 // AssetUpdate is used by the accounting and IndexerDb implementations to share modifications in a block.
@@ -312,6 +735,13 @@ type AssetTransfer struct {
 	Delta big.Int
 }
 
+// AssetTransferTally is the number and total amount of an asset's transfers
+// in a round.
+type AssetTransferTally struct {
+	Count  uint64
+	Volume big.Int
+}
+
 // FreezeUpdate is used by the accounting and IndexerDb implementations to share modifications in a block.
 type FreezeUpdate struct {
 	Frozen bool
@@ -325,6 +755,30 @@ type AssetClose struct {
 	Offset  uint64
 }
 
+// AccountSigner is the multisig composition or the logic sig program an
+// account first signed a transaction with, after its signature type changed.
+type AccountSigner struct {
+	MultisigVersion   uint8
+	MultisigThreshold uint8
+	// MultisigKeys are the subsignature public keys, in order.
+	MultisigKeys [][]byte
+	Program      []byte
+	// ProgramHash is the address of a contract account with the program.
+	ProgramHash []byte
+}
+
+// RekeyUpdate is a transaction changing the authorized address of its sender,
+// AuthAddr is zero when the sender is rekeyed back to itself. AfterClose is set
+// when the sender was closed earlier in the round, after any previous rekey,
+// so it signed for itself before this one.
+type RekeyUpdate struct {
+	Address    [32]byte
+	Intra      int
+	Txid       string
+	AuthAddr   [32]byte
+	AfterClose bool
+}
+
 // AlgoUpdate is used by the accounting and IndexerDb implementations to share modifications in a block.
 // When the update does not include closing the account, the values are a delta applied to the account.
 // If the update does include closing the account the rewards must be SET directly instead of applying a delta.
@@ -347,6 +801,9 @@ type AccountDataUpdate struct {
 type RoundUpdates struct {
 	AlgoUpdates  map[[32]byte]*AlgoUpdate
 	AccountTypes map[[32]byte]string
+	// AccountSigners are the latest signers of the msig and lsig
+	// transactions of the round.
+	AccountSigners map[[32]byte]AccountSigner
 
 	// AccountDataUpdates is explicitly a map so that we can
 	// explicitly set values or have not set values. Instead of
@@ -358,6 +815,9 @@ type RoundUpdates struct {
 	// with a 0 value.
 	AccountDataUpdates map[[32]byte]map[string]AccountDataUpdate
 
+	// Rekeys are the round's changes to authorized addresses, in order.
+	Rekeys []RekeyUpdate
+
 	// AssetUpdates is more complicated than AlgoUpdates because there
 	// are no apply data values to work with in the event of a close.
 	// The way we handle this is by breaking the round into sub-rounds,
@@ -373,6 +833,10 @@ type RoundUpdates struct {
 	AssetUpdates  []map[[32]byte][]AssetUpdate
 	AssetDestroys []uint64
 
+	// AssetTransfers tallies the transfers of each asset, they are merged into
+	// account deltas in AssetUpdates.
+	AssetTransfers map[uint64]*AssetTransferTally
+
 	AppGlobalDeltas []AppDelta
 	AppLocalDeltas  []AppDelta
 }
@@ -381,10 +845,13 @@ type RoundUpdates struct {
 func (ru *RoundUpdates) Clear() {
 	ru.AlgoUpdates = make(map[[32]byte]*AlgoUpdate)
 	ru.AccountTypes = make(map[[32]byte]string)
+	ru.AccountSigners = make(map[[32]byte]AccountSigner)
 	ru.AccountDataUpdates = make(map[[32]byte]map[string]AccountDataUpdate)
+	ru.Rekeys = nil
 	ru.AssetUpdates = nil
 	ru.AssetUpdates = append(ru.AssetUpdates, make(map[[32]byte][]AssetUpdate, 0))
 	ru.AssetDestroys = nil
+	ru.AssetTransfers = make(map[uint64]*AssetTransferTally)
 	ru.AppGlobalDeltas = nil
 	ru.AppLocalDeltas = nil
 }
@@ -18,6 +18,52 @@ type IndexerDb struct {
 	mock.Mock
 }
 
+// AccountChanges provides a mock function with given fields: ctx, query
+func (_m *IndexerDb) AccountChanges(ctx context.Context, query idb.AccountChangesQuery) (<-chan idb.AccountChangeRow, uint64) {
+	ret := _m.Called(ctx, query)
+
+	var r0 <-chan idb.AccountChangeRow
+	if rf, ok := ret.Get(0).(func(context.Context, idb.AccountChangesQuery) <-chan idb.AccountChangeRow); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.AccountChangeRow)
+		}
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func(context.Context, idb.AccountChangesQuery) uint64); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
+// AccountRekeys provides a mock function with given fields: ctx, query
+func (_m *IndexerDb) AccountRekeys(ctx context.Context, query idb.AccountRekeysQuery) (<-chan idb.AccountRekeyRow, uint64) {
+	ret := _m.Called(ctx, query)
+
+	var r0 <-chan idb.AccountRekeyRow
+	if rf, ok := ret.Get(0).(func(context.Context, idb.AccountRekeysQuery) <-chan idb.AccountRekeyRow); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.AccountRekeyRow)
+		}
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func(context.Context, idb.AccountRekeysQuery) uint64); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
 // AddTransaction provides a mock function with given fields: round, intra, txtypeenum, assetid, txn, participation
 func (_m *IndexerDb) AddTransaction(round uint64, intra int, txtypeenum int, assetid uint64, txn types.SignedTxnWithAD, participation [][]byte) error {
 	ret := _m.Called(round, intra, txtypeenum, assetid, txn, participation)
@@ -78,6 +124,34 @@ func (_m *IndexerDb) AssetBalances(ctx context.Context, abq idb.AssetBalanceQuer
 	return r0, r1
 }
 
+// AssetStats provides a mock function with given fields: ctx, query
+func (_m *IndexerDb) AssetStats(ctx context.Context, query idb.AssetStatsQuery) (idb.AssetStats, uint64, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 idb.AssetStats
+	if rf, ok := ret.Get(0).(func(context.Context, idb.AssetStatsQuery) idb.AssetStats); ok {
+		r0 = rf(ctx, query)
+	} else {
+		r0 = ret.Get(0).(idb.AssetStats)
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func(context.Context, idb.AssetStatsQuery) uint64); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, idb.AssetStatsQuery) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Assets provides a mock function with given fields: ctx, filter
 func (_m *IndexerDb) Assets(ctx context.Context, filter idb.AssetsQuery) (<-chan idb.AssetRow, uint64) {
 	ret := _m.Called(ctx, filter)
@@ -303,6 +377,34 @@ func (_m *IndexerDb) LoadGenesis(genesis types.Genesis) error {
 	return r0
 }
 
+// NetworkTotals provides a mock function with given fields: ctx
+func (_m *IndexerDb) NetworkTotals(ctx context.Context) (idb.NetworkTotals, uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 idb.NetworkTotals
+	if rf, ok := ret.Get(0).(func(context.Context) idb.NetworkTotals); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(idb.NetworkTotals)
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func(context.Context) uint64); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Reset provides a mock function with given fields:
 func (_m *IndexerDb) Reset() error {
 	ret := _m.Called()
@@ -317,6 +419,34 @@ func (_m *IndexerDb) Reset() error {
 	return r0
 }
 
+// StakeTotals provides a mock function with given fields: ctx, query
+func (_m *IndexerDb) StakeTotals(ctx context.Context, query idb.StakeTotalsQuery) (idb.StakeTotals, uint64, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 idb.StakeTotals
+	if rf, ok := ret.Get(0).(func(context.Context, idb.StakeTotalsQuery) idb.StakeTotals); ok {
+		r0 = rf(ctx, query)
+	} else {
+		r0 = ret.Get(0).(idb.StakeTotals)
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func(context.Context, idb.StakeTotalsQuery) uint64); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, idb.StakeTotalsQuery) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // StartBlock provides a mock function with given fields:
 func (_m *IndexerDb) StartBlock() error {
 	ret := _m.Called()
@@ -331,6 +461,29 @@ func (_m *IndexerDb) StartBlock() error {
 	return r0
 }
 
+// TransactionStats provides a mock function with given fields: ctx, query
+func (_m *IndexerDb) TransactionStats(ctx context.Context, query idb.TransactionStatsQuery) (<-chan idb.TransactionStatsRow, uint64) {
+	ret := _m.Called(ctx, query)
+
+	var r0 <-chan idb.TransactionStatsRow
+	if rf, ok := ret.Get(0).(func(context.Context, idb.TransactionStatsQuery) <-chan idb.TransactionStatsRow); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.TransactionStatsRow)
+		}
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func(context.Context, idb.TransactionStatsQuery) uint64); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	return r0, r1
+}
+
 // Transactions provides a mock function with given fields: ctx, tf
 func (_m *IndexerDb) Transactions(ctx context.Context, tf idb.TransactionFilter) (<-chan idb.TxnRow, uint64) {
 	ret := _m.Called(ctx, tf)
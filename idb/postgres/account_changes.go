@@ -0,0 +1,272 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk_types "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/idb/postgres/internal/encoding"
+	"github.com/algorand/indexer/types"
+)
+
+// accountChanges collects what a round changed in each account, it is written
+// to the append only account_change table with the round's accounting.
+type accountChanges map[[32]byte]*idb.AccountChange
+
+func (c accountChanges) get(addr [32]byte) *idb.AccountChange {
+	change, ok := c[addr]
+	if !ok {
+		change = new(idb.AccountChange)
+		c[addr] = change
+	}
+	return change
+}
+
+// asset returns the change of an asset holding, the pointer is only valid
+// until the next change of the account's holdings.
+func (c accountChanges) asset(addr [32]byte, assetID uint64) *idb.AccountAssetChange {
+	change := c.get(addr)
+	for i := range change.Assets {
+		if change.Assets[i].AssetID == assetID {
+			return &change.Assets[i]
+		}
+	}
+	change.Assets = append(change.Assets, idb.AccountAssetChange{AssetID: assetID})
+	return &change.Assets[len(change.Assets)-1]
+}
+
+// app returns the change of an application local state, the pointer is only
+// valid until the next change of the account's local states.
+func (c accountChanges) app(addr [32]byte, appID uint64) *idb.AccountAppChange {
+	change := c.get(addr)
+	for i := range change.Apps {
+		if change.Apps[i].AppID == appID {
+			return &change.Apps[i]
+		}
+	}
+	change.Apps = append(change.Apps, idb.AccountAppChange{AppID: appID})
+	return &change.Apps[len(change.Apps)-1]
+}
+
+// assetTransfer records a change to the amount held, optedIn is set if the
+// account did not hold the asset before.
+func (c accountChanges) assetTransfer(addr [32]byte, assetID uint64, delta *big.Int, optedIn bool) {
+	change := c.asset(addr, assetID)
+	if optedIn {
+		change.OptedIn = true
+	}
+
+	var net, decrease big.Int
+	net.SetUint64(change.AmountIncrease)
+	decrease.SetUint64(change.AmountDecrease)
+	net.Sub(&net, &decrease)
+	net.Add(&net, delta)
+
+	change.AmountIncrease = 0
+	change.AmountDecrease = 0
+	switch net.Sign() {
+	case 1:
+		change.AmountIncrease = net.Uint64()
+	case -1:
+		change.AmountDecrease = net.Neg(&net).Uint64()
+	}
+}
+
+// assetClose records an account opting out of an asset and sending the amount
+// it held to closeTo.
+func (c accountChanges) assetClose(addr, closeTo [32]byte, assetID uint64, amount uint64) {
+	var delta big.Int
+	delta.SetUint64(amount)
+	c.assetTransfer(closeTo, assetID, &delta, false)
+
+	delta.Neg(&delta)
+	c.assetTransfer(addr, assetID, &delta, false)
+	change := c.asset(addr, assetID)
+	change.Closed = true
+	change.CloseTo = closeTo
+}
+
+func (c accountChanges) assetFreeze(addr [32]byte, assetID uint64, frozen bool) {
+	change := c.asset(addr, assetID)
+	change.Freeze = true
+	change.Frozen = frozen
+}
+
+// makeAccountChanges records the changes which are known from the round
+// updates alone. Asset holdings depend on the database and are added while
+// they are applied.
+func makeAccountChanges(updates *idb.RoundUpdates) accountChanges {
+	changes := make(accountChanges)
+	for addr, update := range updates.AlgoUpdates {
+		change := changes.get(addr)
+		if update.Balance > 0 {
+			change.BalanceIncrease = uint64(update.Balance)
+		} else if update.Balance < 0 {
+			change.BalanceDecrease = uint64(-update.Balance)
+		}
+		// The rewards of a closed account replace the rewards total.
+		if update.Rewards > 0 && !update.Closed {
+			change.Rewards = uint64(update.Rewards)
+		}
+		change.Closed = update.Closed
+	}
+	for addr, keytype := range updates.AccountTypes {
+		changes.get(addr).SigType = keytype
+	}
+	for addr, fields := range updates.AccountDataUpdates {
+		if update, ok := fields["spend"]; ok {
+			change := changes.get(addr)
+			change.Rekeyed = true
+			if authAddr, ok := update.Value.(types.Address); ok && !update.Delete {
+				change.AuthAddr = authAddr
+			}
+		}
+		if update, ok := fields["onl"]; ok && !update.Delete {
+			if status, ok := update.Value.(int); ok && status >= 0 && status < len(statusStrings) {
+				changes.get(addr).Status = statusStrings[status]
+			}
+		}
+	}
+	for _, delta := range updates.AppLocalDeltas {
+		var addr [32]byte
+		copy(addr[:], delta.Address)
+		change := changes.app(addr, uint64(delta.AppIndex))
+		// The on completion only applies to the sender.
+		if delta.AddrIndex == 0 {
+			switch delta.OnCompletion {
+			case sdk_types.OptInOC:
+				change.OptedIn = true
+			case sdk_types.CloseOutOC, sdk_types.ClearStateOC:
+				change.ClosedOut = true
+			}
+		}
+		if len(delta.Delta) > 0 {
+			change.StateChanged = true
+		}
+	}
+	return changes
+}
+
+// getAssetHolding returns the amount of an asset held by an account, and
+// whether the account is opted in.
+func getAssetHolding(stmt *sql.Stmt, addr []byte, assetID uint64) (amount uint64, optedIn bool, err error) {
+	var deleted bool
+	err = stmt.QueryRow(addr, assetID).Scan(&amount, &deleted)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return amount, !deleted, nil
+}
+
+// write inserts the changes of the round into the account_change table.
+func (c accountChanges) write(tx *sql.Tx, round uint64) error {
+	if len(c) == 0 {
+		return nil
+	}
+	insert, err := tx.Prepare(`INSERT INTO account_change (addr, round, changes) VALUES ($1, $2, $3)`)
+	if err != nil {
+		return fmt.Errorf("prepare account change, %v", err)
+	}
+	defer insert.Close()
+	for addr, change := range c {
+		_, err = insert.Exec(addr[:], round, encoding.EncodeJSON(change))
+		if err != nil {
+			return fmt.Errorf("account change, %v", err)
+		}
+	}
+	return nil
+}
+
+// AccountChanges is part of idb.IndexerDB
+func (db *IndexerDb) AccountChanges(ctx context.Context, query idb.AccountChangesQuery) (<-chan idb.AccountChangeRow, uint64) {
+	whereParts := []string{"addr = $1"}
+	whereArgs := []interface{}{query.Address}
+	partNumber := 2
+	if query.MinRound != 0 {
+		whereParts = append(whereParts, fmt.Sprintf("round >= $%d", partNumber))
+		whereArgs = append(whereArgs, query.MinRound)
+		partNumber++
+	}
+	if query.MaxRound != 0 {
+		whereParts = append(whereParts, fmt.Sprintf("round <= $%d", partNumber))
+		whereArgs = append(whereArgs, query.MaxRound)
+		partNumber++
+	}
+	if query.PrevRound != nil {
+		whereParts = append(whereParts, fmt.Sprintf("round > $%d", partNumber))
+		whereArgs = append(whereArgs, *query.PrevRound)
+		partNumber++
+	}
+	sqlQuery := `SELECT round, changes FROM account_change WHERE ` + strings.Join(whereParts, " AND ") + ` ORDER BY round ASC`
+	if query.Limit > 0 {
+		sqlQuery += fmt.Sprintf(" LIMIT %d", query.Limit)
+	}
+
+	out := make(chan idb.AccountChangeRow, 1)
+
+	tx, err := db.beginReadTx(ctx)
+	if err != nil {
+		out <- idb.AccountChangeRow{Error: err}
+		close(out)
+		return out, 0
+	}
+
+	round, err := db.getMaxRoundAccounted(tx)
+	if err != nil {
+		out <- idb.AccountChangeRow{Error: err}
+		close(out)
+		tx.Rollback()
+		return out, round
+	}
+
+	rows, err := db.guardedQuery(ctx, tx, idb.QueryAccounts, sqlQuery, whereArgs...)
+	if err != nil {
+		out <- idb.AccountChangeRow{Error: err}
+		close(out)
+		tx.Rollback()
+		return out, round
+	}
+	go func() {
+		db.yieldAccountChangesThread(ctx, rows, out)
+		close(out)
+		tx.Rollback()
+	}()
+	return out, round
+}
+
+func (db *IndexerDb) yieldAccountChangesThread(ctx context.Context, rows *sql.Rows, out chan<- idb.AccountChangeRow) {
+	defer rows.Close()
+
+	for rows.Next() {
+		var row idb.AccountChangeRow
+		var changes []byte
+		err := rows.Scan(&row.Round, &changes)
+		if err != nil {
+			out <- idb.AccountChangeRow{Error: err}
+			break
+		}
+		err = encoding.DecodeJSON(changes, &row.Change)
+		if err != nil {
+			out <- idb.AccountChangeRow{Error: fmt.Errorf("parsing account change of round %d, %v", row.Round, err)}
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case out <- row:
+		}
+	}
+	if err := rows.Err(); err != nil {
+		out <- idb.AccountChangeRow{Error: db.queryError(ctx, idb.QueryAccounts, err)}
+	}
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"math/big"
+	"testing"
+
+	sdk_types "github.com/algorand/go-algorand-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/idb/postgres/internal/encoding"
+	"github.com/algorand/indexer/types"
+	"github.com/algorand/indexer/util/test"
+)
+
+func TestMakeAccountChanges(t *testing.T) {
+	var updates idb.RoundUpdates
+	updates.Clear()
+	updates.AlgoUpdates[test.AccountA] = &idb.AlgoUpdate{Balance: -1000, Rewards: 10}
+	updates.AlgoUpdates[test.AccountB] = &idb.AlgoUpdate{Balance: 500, Rewards: 0, Closed: true}
+	updates.AccountTypes[test.AccountA] = "msig"
+	updates.AccountDataUpdates[test.AccountA] = map[string]idb.AccountDataUpdate{
+		"spend": {Value: test.AccountC},
+		"onl":   {Value: 1},
+	}
+	updates.AccountDataUpdates[test.AccountB] = map[string]idb.AccountDataUpdate{
+		"spend": {Delete: true, Value: struct{}{}},
+	}
+	updates.AppLocalDeltas = []idb.AppDelta{
+		{AppIndex: 7, Address: test.AccountA[:], OnCompletion: sdk_types.OptInOC},
+		{AppIndex: 7, Address: test.AccountA[:], Delta: types.StateDelta{"k": {Action: 2, Uint: 1}}},
+		// The on completion of other accounts is ignored.
+		{AppIndex: 8, Address: test.AccountB[:], AddrIndex: 1, OnCompletion: sdk_types.CloseOutOC, Delta: types.StateDelta{"k": {Action: 3}}},
+	}
+
+	changes := makeAccountChanges(&updates)
+	assert.Equal(t, &idb.AccountChange{
+		BalanceDecrease: 1000,
+		Rewards:         10,
+		SigType:         "msig",
+		Rekeyed:         true,
+		AuthAddr:        test.AccountC,
+		Status:          "Online",
+		Apps:            []idb.AccountAppChange{{AppID: 7, OptedIn: true, StateChanged: true}},
+	}, changes[test.AccountA])
+	assert.Equal(t, &idb.AccountChange{
+		BalanceIncrease: 500,
+		Closed:          true,
+		Rekeyed:         true,
+		Apps:            []idb.AccountAppChange{{AppID: 8, StateChanged: true}},
+	}, changes[test.AccountB])
+}
+
+func TestAccountChangesAssets(t *testing.T) {
+	changes := make(accountChanges)
+	changes.assetTransfer(test.AccountA, 1, big.NewInt(100), true)
+	changes.assetTransfer(test.AccountA, 2, big.NewInt(-5), false)
+	changes.assetClose(test.AccountA, test.AccountB, 1, 100)
+	changes.assetTransfer(test.AccountA, 1, big.NewInt(30), true)
+	changes.assetFreeze(test.AccountA, 2, true)
+
+	// Deltas larger than an int64 are netted.
+	var large big.Int
+	large.SetUint64(1 << 63)
+	changes.assetTransfer(test.AccountC, 1, &large, false)
+	large.Neg(&large)
+	changes.assetTransfer(test.AccountC, 1, &large, false)
+	changes.assetTransfer(test.AccountC, 1, &large, false)
+
+	assert.Equal(t, []idb.AccountAssetChange{
+		{AssetID: 1, AmountIncrease: 30, OptedIn: true, Closed: true, CloseTo: test.AccountB},
+		{AssetID: 2, AmountDecrease: 5, Freeze: true, Frozen: true},
+	}, changes[test.AccountA].Assets)
+	assert.Equal(t, []idb.AccountAssetChange{{AssetID: 1, AmountIncrease: 100}}, changes[test.AccountB].Assets)
+	assert.Equal(t, []idb.AccountAssetChange{{AssetID: 1, AmountDecrease: 1 << 63}}, changes[test.AccountC].Assets)
+}
+
+func TestAccountChangeEncoding(t *testing.T) {
+	change := idb.AccountChange{
+		BalanceIncrease: 5,
+		Rekeyed:         true,
+		AuthAddr:        test.AccountB,
+		Assets:          []idb.AccountAssetChange{{AssetID: 1, OptedIn: true, Freeze: true}},
+		Apps:            []idb.AccountAppChange{{AppID: 2, ClosedOut: true}},
+	}
+
+	var decoded idb.AccountChange
+	require.NoError(t, encoding.DecodeJSON(encoding.EncodeJSON(change), &decoded))
+	assert.Equal(t, change, decoded)
+
+	// Empty fields are not stored.
+	assert.Equal(t, `{"rekeyed":true}`, string(encoding.EncodeJSON(idb.AccountChange{Rekeyed: true})))
+}
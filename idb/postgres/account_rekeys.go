@@ -0,0 +1,151 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/algorand/indexer/idb"
+)
+
+// backfillAccountRekeys records the rekeys of the transactions which are
+// already accounted. The previous authorized address of each rekey is the new
+// one of the rekey before it, unless the account was closed in between. A
+// transaction closing the account after rekeying it is ordered after the rekey.
+const backfillAccountRekeys = `INSERT INTO account_rekey (addr, round, intra, txid, old_auth, new_auth)
+SELECT addr, round, intra, txid, old_auth, new_auth FROM (
+SELECT addr, round, intra, txid, rekey, new_auth, lag(new_auth) OVER (PARTITION BY addr ORDER BY round, intra, rekey DESC) AS old_auth FROM (
+SELECT decode(txn -> 'txn' ->> 'snd', 'base64') AS addr, round, intra, txid, true AS rekey, nullif(decode(txn -> 'txn' ->> 'rekey', 'base64'), decode(txn -> 'txn' ->> 'snd', 'base64')) AS new_auth
+FROM txn WHERE txn -> 'txn' ->> 'rekey' IS NOT NULL
+UNION ALL
+SELECT decode(txn -> 'txn' ->> 'snd', 'base64'), round, intra, txid, false, NULL
+FROM txn WHERE txn -> 'txn' ->> 'type' = 'pay' AND txn -> 'txn' ->> 'close' IS NOT NULL) e
+WHERE round <= coalesce((SELECT (v->>'account_round')::bigint FROM metastate WHERE k = 'state'), -1)) r
+WHERE rekey
+ON CONFLICT (addr, round, intra) DO NOTHING`
+
+// writeRekeys records the rekeys of a round in account_rekey, it must be
+// called before the round's account data updates are applied.
+func writeRekeys(tx *sql.Tx, round uint64, rekeys []idb.RekeyUpdate) error {
+	if len(rekeys) == 0 {
+		return nil
+	}
+	get, err := tx.Prepare(`SELECT decode(account_data ->> 'spend', 'base64') FROM account WHERE addr = $1`)
+	if err != nil {
+		return fmt.Errorf("prepare get auth addr, %v", err)
+	}
+	defer get.Close()
+	insert, err := tx.Prepare(`INSERT INTO account_rekey (addr, round, intra, txid, old_auth, new_auth) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (addr, round, intra) DO NOTHING`)
+	if err != nil {
+		return fmt.Errorf("prepare account rekey, %v", err)
+	}
+	defer insert.Close()
+
+	// The authorized addresses of the accounts rekeyed earlier in the round.
+	authAddrs := make(map[[32]byte][]byte)
+	for _, rekey := range rekeys {
+		// A closed account signs for itself.
+		oldAuth, ok := authAddrs[rekey.Address]
+		if rekey.AfterClose {
+			oldAuth = nil
+		} else if !ok {
+			err = get.QueryRow(rekey.Address[:]).Scan(&oldAuth)
+			if err != nil && err != sql.ErrNoRows {
+				return fmt.Errorf("get auth addr, %v", err)
+			}
+		}
+		var newAuth []byte
+		if rekey.AuthAddr != ([32]byte{}) {
+			newAuth = append([]byte(nil), rekey.AuthAddr[:]...)
+		}
+		_, err = insert.Exec(rekey.Address[:], round, rekey.Intra, []byte(rekey.Txid), oldAuth, newAuth)
+		if err != nil {
+			return fmt.Errorf("account rekey, %v", err)
+		}
+		authAddrs[rekey.Address] = newAuth
+	}
+	return nil
+}
+
+// AccountRekeys is part of idb.IndexerDB
+func (db *IndexerDb) AccountRekeys(ctx context.Context, query idb.AccountRekeysQuery) (<-chan idb.AccountRekeyRow, uint64) {
+	whereParts := []string{"addr = $1"}
+	whereArgs := []interface{}{query.Address}
+	partNumber := 2
+	if query.MinRound != 0 {
+		whereParts = append(whereParts, fmt.Sprintf("round >= $%d", partNumber))
+		whereArgs = append(whereArgs, query.MinRound)
+		partNumber++
+	}
+	if query.MaxRound != 0 {
+		whereParts = append(whereParts, fmt.Sprintf("round <= $%d", partNumber))
+		whereArgs = append(whereArgs, query.MaxRound)
+		partNumber++
+	}
+	if query.PrevRound != nil {
+		whereParts = append(whereParts, fmt.Sprintf("(round, intra) > ($%d, $%d)", partNumber, partNumber+1))
+		whereArgs = append(whereArgs, *query.PrevRound, query.PrevIntra)
+		partNumber += 2
+	}
+	sqlQuery := `SELECT round, intra, txid, old_auth, new_auth FROM account_rekey WHERE ` + strings.Join(whereParts, " AND ") + ` ORDER BY round ASC, intra ASC`
+	if query.Limit > 0 {
+		sqlQuery += fmt.Sprintf(" LIMIT %d", query.Limit)
+	}
+
+	out := make(chan idb.AccountRekeyRow, 1)
+
+	tx, err := db.beginReadTx(ctx)
+	if err != nil {
+		out <- idb.AccountRekeyRow{Error: err}
+		close(out)
+		return out, 0
+	}
+
+	round, err := db.getMaxRoundAccounted(tx)
+	if err != nil {
+		out <- idb.AccountRekeyRow{Error: err}
+		close(out)
+		tx.Rollback()
+		return out, round
+	}
+
+	rows, err := db.guardedQuery(ctx, tx, idb.QueryAccounts, sqlQuery, whereArgs...)
+	if err != nil {
+		out <- idb.AccountRekeyRow{Error: err}
+		close(out)
+		tx.Rollback()
+		return out, round
+	}
+	go func() {
+		db.yieldAccountRekeysThread(ctx, rows, out)
+		close(out)
+		tx.Rollback()
+	}()
+	return out, round
+}
+
+func (db *IndexerDb) yieldAccountRekeysThread(ctx context.Context, rows *sql.Rows, out chan<- idb.AccountRekeyRow) {
+	defer rows.Close()
+
+	for rows.Next() {
+		var row idb.AccountRekeyRow
+		var txid []byte
+		err := rows.Scan(&row.Rekey.Round, &row.Rekey.Intra, &txid, &row.Rekey.OldAuthAddr, &row.Rekey.NewAuthAddr)
+		if err != nil {
+			out <- idb.AccountRekeyRow{Error: err}
+			break
+		}
+		row.Rekey.Txid = string(txid)
+		select {
+		case <-ctx.Done():
+			return
+		case out <- row:
+		}
+	}
+	if err := rows.Err(); err != nil {
+		out <- idb.AccountRekeyRow{Error: db.queryError(ctx, idb.QueryAccounts, err)}
+	}
+}
@@ -0,0 +1,67 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// createTrigramIndexes creates the pg_trgm extension and the trigram indexes
+// of the asset names and units. The extension is optional, when it can't be
+// created asset searches are not ranked by similarity.
+func createTrigramIndexes(db *IndexerDb) error {
+	_, err := db.db.Exec(`CREATE EXTENSION IF NOT EXISTS pg_trgm`)
+	if err != nil {
+		db.log.WithError(err).Warn("pg_trgm is not available, asset searches will match names containing the query")
+		return nil
+	}
+	indexes := []string{
+		"CREATE INDEX IF NOT EXISTS asset_name_trgm ON asset USING gin ( (params ->> 'an') gin_trgm_ops )",
+		"CREATE INDEX IF NOT EXISTS asset_unit_trgm ON asset USING gin ( (params ->> 'un') gin_trgm_ops )",
+	}
+	for _, cmd := range indexes {
+		_, err = db.db.Exec(cmd)
+		if err != nil {
+			return fmt.Errorf("trigram index, %v", err)
+		}
+	}
+	return nil
+}
+
+// hasTrigram returns true when the pg_trgm extension is installed.
+func hasTrigram(ctx context.Context, tx *sql.Tx) (bool, error) {
+	var installed bool
+	row := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm')`)
+	err := row.Scan(&installed)
+	return installed, err
+}
+
+// assetQueryMatch returns the condition and the score of an asset matching a
+// search query. $query is the query, $prefix and $contains are the LIKE
+// patterns of names starting with and containing it.
+//
+// Exact matches score 2 and prefix matches 1, plus the similarity of the name
+// or unit to the query. Without pg_trgm the similarity is the fraction of the
+// name covered by the query, and only names containing it match.
+func assetQueryMatch(trigram bool, query, prefix, contains int) (match string, score string) {
+	q := fmt.Sprintf("$%d::text", query)
+	p := fmt.Sprintf("$%d", prefix)
+	c := fmt.Sprintf("$%d", contains)
+	// Parenthesized, the % operator binds tighter than ->>.
+	const name, unit = "(a.params ->> 'an')", "(a.params ->> 'un')"
+
+	tier := fmt.Sprintf("CASE WHEN lower(%[1]s) = lower(%[3]s) OR lower(%[2]s) = lower(%[3]s) THEN 2 WHEN %[1]s ILIKE %[4]s OR %[2]s ILIKE %[4]s THEN 1 ELSE 0 END", name, unit, q, p)
+	if trigram {
+		match = fmt.Sprintf("(%[1]s ILIKE %[3]s OR %[2]s ILIKE %[3]s OR %[1]s %% %[4]s OR %[2]s %% %[4]s)", name, unit, c, q)
+		score = fmt.Sprintf("round((%s + greatest(similarity(%s, %s), similarity(%s, %s)))::numeric, 6)", tier, name, q, unit, q)
+		return match, score
+	}
+	match = fmt.Sprintf("(%[1]s ILIKE %[3]s OR %[2]s ILIKE %[3]s)", name, unit, c)
+	coverage := func(field string) string {
+		return fmt.Sprintf("CASE WHEN %s ILIKE %s THEN length(%s)::numeric / length(%s) ELSE 0 END", field, c, q, field)
+	}
+	score = fmt.Sprintf("round((%s + greatest(%s, %s))::numeric, 6)", tier, coverage(name), coverage(unit))
+	return match, score
+}
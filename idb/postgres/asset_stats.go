@@ -0,0 +1,244 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	sdk_types "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/idb/postgres/internal/encoding"
+)
+
+type holdingKey struct {
+	addr    [32]byte
+	assetID uint64
+}
+
+// holdingState is what an asset holding contributes to the asset_stats counts.
+type holdingState struct {
+	optedIn bool
+	holds   bool
+}
+
+// assetStats maintains the holder counts of asset_stats incrementally. The
+// state of each holding is recorded before the round first changes it, and
+// compared with its state once the round's asset updates are applied.
+type assetStats struct {
+	tx     *sql.Tx
+	get    *sql.Stmt
+	before map[holdingKey]holdingState
+}
+
+func makeAssetStats(tx *sql.Tx) *assetStats {
+	return &assetStats{tx: tx, before: make(map[holdingKey]holdingState)}
+}
+
+func (s *assetStats) holding(key holdingKey) (holdingState, error) {
+	if s.get == nil {
+		get, err := s.tx.Prepare(`SELECT amount, deleted FROM account_asset WHERE addr = $1 AND assetid = $2`)
+		if err != nil {
+			return holdingState{}, fmt.Errorf("prepare get asset holding, %v", err)
+		}
+		s.get = get
+	}
+	amount, optedIn, err := getAssetHolding(s.get, key.addr[:], key.assetID)
+	if err != nil {
+		return holdingState{}, fmt.Errorf("get asset holding, %v", err)
+	}
+	return holdingState{optedIn: optedIn, holds: optedIn && amount > 0}, nil
+}
+
+// track must be called before a holding is changed.
+func (s *assetStats) track(addr [32]byte, assetID uint64) error {
+	key := holdingKey{addr: addr, assetID: assetID}
+	if _, ok := s.before[key]; ok {
+		return nil
+	}
+	state, err := s.holding(key)
+	if err != nil {
+		return err
+	}
+	s.before[key] = state
+	return nil
+}
+
+// countDelta returns the change to a count when a holding goes from before to after.
+func countDelta(before, after bool) int64 {
+	switch {
+	case after && !before:
+		return 1
+	case before && !after:
+		return -1
+	}
+	return 0
+}
+
+// write updates asset_stats with the changed holdings and records the round's
+// transfers. It returns the change to the number of opted in holdings.
+func (s *assetStats) write(round uint64, transfers map[uint64]*idb.AssetTransferTally) (int64, error) {
+	type counts struct {
+		holders int64
+		optins  int64
+	}
+	deltas := make(map[uint64]*counts)
+	for key, before := range s.before {
+		after, err := s.holding(key)
+		if err != nil {
+			return 0, err
+		}
+		delta, ok := deltas[key.assetID]
+		if !ok {
+			delta = new(counts)
+			deltas[key.assetID] = delta
+		}
+		delta.holders += countDelta(before.holds, after.holds)
+		delta.optins += countDelta(before.optedIn, after.optedIn)
+	}
+
+	var optIns int64
+	for _, delta := range deltas {
+		optIns += delta.optins
+	}
+
+	if len(deltas) > 0 {
+		upsert, err := s.tx.Prepare(`INSERT INTO asset_stats (assetid, holders, optins) VALUES ($1, $2, $3) ON CONFLICT (assetid) DO UPDATE SET holders = asset_stats.holders + EXCLUDED.holders, optins = asset_stats.optins + EXCLUDED.optins`)
+		if err != nil {
+			return 0, fmt.Errorf("prepare asset stats, %v", err)
+		}
+		defer upsert.Close()
+		for assetID, delta := range deltas {
+			if delta.holders == 0 && delta.optins == 0 {
+				continue
+			}
+			_, err = upsert.Exec(assetID, delta.holders, delta.optins)
+			if err != nil {
+				return 0, fmt.Errorf("asset stats, %v", err)
+			}
+		}
+	}
+
+	if len(transfers) > 0 {
+		insert, err := s.tx.Prepare(`INSERT INTO asset_transfer_stats (assetid, round, transfers, volume) VALUES ($1, $2, $3, $4)`)
+		if err != nil {
+			return 0, fmt.Errorf("prepare asset transfer stats, %v", err)
+		}
+		defer insert.Close()
+		for assetID, tally := range transfers {
+			_, err = insert.Exec(assetID, round, tally.Count, tally.Volume.String())
+			if err != nil {
+				return 0, fmt.Errorf("asset transfer stats, %v", err)
+			}
+		}
+	}
+	return optIns, nil
+}
+
+func (s *assetStats) close() {
+	if s.get != nil {
+		s.get.Close()
+	}
+}
+
+// saturatingUint64 parses a non-negative decimal, values above the maximum
+// uint64 are returned as the maximum.
+func saturatingUint64(decimal string) (uint64, error) {
+	var value big.Int
+	if _, ok := value.SetString(decimal, 10); !ok {
+		return 0, fmt.Errorf("invalid decimal '%s'", decimal)
+	}
+	if !value.IsUint64() {
+		return math.MaxUint64, nil
+	}
+	return value.Uint64(), nil
+}
+
+// AssetStats is part of idb.IndexerDB
+func (db *IndexerDb) AssetStats(ctx context.Context, query idb.AssetStatsQuery) (idb.AssetStats, uint64, error) {
+	tx, err := db.beginReadTx(ctx)
+	if err != nil {
+		return idb.AssetStats{}, 0, err
+	}
+	defer tx.Rollback()
+
+	round, err := db.getMaxRoundAccounted(tx)
+	if err != nil {
+		return idb.AssetStats{}, round, err
+	}
+
+	stats := idb.AssetStats{AssetID: query.AssetID}
+	var paramsJSON []byte
+	row := tx.QueryRowContext(ctx, `SELECT a.params, a.deleted, coalesce(s.holders, 0), coalesce(s.optins, 0) FROM asset a LEFT JOIN asset_stats s ON s.assetid = a.index WHERE a.index = $1`, query.AssetID)
+	err = row.Scan(&paramsJSON, &stats.Deleted, &stats.Holders, &stats.OptedIn)
+	if err == sql.ErrNoRows {
+		return idb.AssetStats{}, round, idb.ErrorAssetNotFound
+	}
+	if err != nil {
+		return idb.AssetStats{}, round, db.queryError(ctx, idb.QueryAssets, err)
+	}
+	var params sdk_types.AssetParams
+	err = encoding.DecodeJSON(paramsJSON, &params)
+	if err != nil {
+		return idb.AssetStats{}, round, fmt.Errorf("parsing asset %d params, %v", query.AssetID, err)
+	}
+
+	// The amount held by the reserve is not in circulation.
+	stats.Total = params.Total
+	stats.CirculatingSupply = params.Total
+	if !params.Reserve.IsZero() {
+		var reserveAmount uint64
+		row = tx.QueryRowContext(ctx, `SELECT amount FROM account_asset WHERE addr = $1 AND assetid = $2 AND NOT deleted`, params.Reserve[:], query.AssetID)
+		err = row.Scan(&reserveAmount)
+		if err != nil && err != sql.ErrNoRows {
+			return idb.AssetStats{}, round, db.queryError(ctx, idb.QueryAssets, err)
+		}
+		if reserveAmount < stats.CirculatingSupply {
+			stats.CirculatingSupply -= reserveAmount
+		} else {
+			stats.CirculatingSupply = 0
+		}
+	}
+
+	whereParts := []string{"assetid = $1"}
+	whereArgs := []interface{}{query.AssetID}
+	partNumber := 2
+	if query.MinRound != 0 {
+		whereParts = append(whereParts, fmt.Sprintf("round >= $%d", partNumber))
+		whereArgs = append(whereArgs, query.MinRound)
+		partNumber++
+	}
+	if query.MaxRound != 0 {
+		whereParts = append(whereParts, fmt.Sprintf("round <= $%d", partNumber))
+		whereArgs = append(whereArgs, query.MaxRound)
+		partNumber++
+	}
+	sqlQuery := `SELECT coalesce(sum(transfers), 0), coalesce(sum(volume), 0) FROM asset_transfer_stats WHERE ` + strings.Join(whereParts, " AND ")
+	rows, err := db.guardedQuery(ctx, tx, idb.QueryAssets, sqlQuery, whereArgs...)
+	if err != nil {
+		return idb.AssetStats{}, round, err
+	}
+	defer rows.Close()
+	transfers, volume := "0", "0"
+	if rows.Next() {
+		err = rows.Scan(&transfers, &volume)
+		if err != nil {
+			return idb.AssetStats{}, round, err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return idb.AssetStats{}, round, db.queryError(ctx, idb.QueryAssets, err)
+	}
+	if stats.Transfers, err = saturatingUint64(transfers); err != nil {
+		return idb.AssetStats{}, round, err
+	}
+	if stats.Volume, err = saturatingUint64(volume); err != nil {
+		return idb.AssetStats{}, round, err
+	}
+	return stats, round, nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaturatingUint64(t *testing.T) {
+	value, err := saturatingUint64("12345")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12345), value)
+
+	value, err = saturatingUint64("36893488147419103230")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), value)
+
+	_, err = saturatingUint64("1.5")
+	assert.Error(t, err)
+}
+
+func TestCountDelta(t *testing.T) {
+	assert.Equal(t, int64(1), countDelta(false, true))
+	assert.Equal(t, int64(-1), countDelta(true, false))
+	assert.Equal(t, int64(0), countDelta(true, true))
+	assert.Equal(t, int64(0), countDelta(false, false))
+}
@@ -0,0 +1,60 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"fmt"
+
+	models "github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/types"
+)
+
+func isDeleted(deleted *bool) bool {
+	return deleted != nil && *deleted
+}
+
+// minBalanceTotals counts what the minimum balance of an account depends on,
+// the account must include its asset holdings.
+func minBalanceTotals(account models.Account) types.MinBalanceTotals {
+	var totals types.MinBalanceTotals
+	if account.Assets != nil {
+		for _, holding := range *account.Assets {
+			if !isDeleted(holding.Deleted) {
+				totals.Assets++
+			}
+		}
+	}
+	if account.CreatedApps != nil {
+		for _, app := range *account.CreatedApps {
+			if !isDeleted(app.Deleted) {
+				totals.AppParams++
+			}
+		}
+	}
+	if account.AppsLocalState != nil {
+		for _, ls := range *account.AppsLocalState {
+			if !isDeleted(ls.Deleted) {
+				totals.AppLocalStates++
+			}
+		}
+	}
+	// The totals only include applications and local states which are not deleted.
+	if account.AppsTotalExtraPages != nil {
+		totals.ExtraAppPages = *account.AppsTotalExtraPages
+	}
+	if account.AppsTotalSchema != nil {
+		totals.SchemaUints = account.AppsTotalSchema.NumUint
+		totals.SchemaByteSlices = account.AppsTotalSchema.NumByteSlice
+	}
+	return totals
+}
+
+// minBalanceExpr is the SQL equivalent of types.MinBalance for the account a.
+func minBalanceExpr(proto types.ConsensusParams) string {
+	uintCost := proto.SchemaMinBalancePerEntry + proto.SchemaUintMinBalance
+	bytesCost := proto.SchemaMinBalancePerEntry + proto.SchemaBytesMinBalance
+	return fmt.Sprintf(`(%[1]d * (1 + (SELECT count(*) FROM account_asset x WHERE x.addr = a.addr AND NOT x.deleted))`+
+		` + (SELECT coalesce(sum(%[2]d * (1 + coalesce((x.params ->> 'epp')::bigint, 0)) + %[4]d * coalesce((x.params -> 'gsch' ->> 'nui')::bigint, 0) + %[5]d * coalesce((x.params -> 'gsch' ->> 'nbs')::bigint, 0)), 0) FROM app x WHERE x.creator = a.addr AND NOT x.deleted)`+
+		` + (SELECT coalesce(sum(%[3]d + %[4]d * coalesce((x.localstate -> 'hsch' ->> 'nui')::bigint, 0) + %[5]d * coalesce((x.localstate -> 'hsch' ->> 'nbs')::bigint, 0)), 0) FROM account_app x WHERE x.addr = a.addr AND NOT x.deleted))`,
+		proto.MinBalance, proto.AppFlatParamsMinBalance, proto.AppFlatOptInMinBalance, uintCost, bytesCost)
+}
@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	models "github.com/algorand/indexer/api/generated/v2"
+	"github.com/algorand/indexer/types"
+)
+
+func TestMinBalanceTotals(t *testing.T) {
+	deleted := true
+	extraPages := uint64(2)
+	account := models.Account{
+		Assets:              &[]models.AssetHolding{{AssetId: 1}, {AssetId: 2, Deleted: &deleted}, {AssetId: 3}},
+		CreatedApps:         &[]models.Application{{Id: 4}, {Id: 5, Deleted: &deleted}},
+		AppsLocalState:      &[]models.ApplicationLocalState{{Id: 4}, {Id: 6}},
+		AppsTotalExtraPages: &extraPages,
+		AppsTotalSchema:     &models.ApplicationStateSchema{NumUint: 3, NumByteSlice: 1},
+	}
+
+	assert.Equal(t, types.MinBalanceTotals{
+		Assets:           2,
+		AppParams:        1,
+		AppLocalStates:   2,
+		ExtraAppPages:    2,
+		SchemaUints:      3,
+		SchemaByteSlices: 1,
+	}, minBalanceTotals(account))
+	assert.Equal(t, types.MinBalanceTotals{}, minBalanceTotals(models.Account{}))
+}
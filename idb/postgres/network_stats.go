@@ -0,0 +1,419 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/idb/postgres/internal/encoding"
+)
+
+// blockTxnStats collects the transaction counts and fees of a block, by type
+// enum, while it is imported.
+type blockTxnStats struct {
+	counts map[int]uint64
+	fees   map[int]uint64
+}
+
+func makeBlockTxnStats() blockTxnStats {
+	return blockTxnStats{counts: make(map[int]uint64), fees: make(map[int]uint64)}
+}
+
+func (s blockTxnStats) add(typeenum int, fee uint64) {
+	s.counts[typeenum]++
+	s.fees[typeenum] += fee
+}
+
+// statsDay returns the UTC day of a block timestamp.
+func statsDay(timestamp int64) string {
+	return time.Unix(timestamp, 0).UTC().Format("2006-01-02")
+}
+
+// writeTxnStats adds a block to the statistics of its day. Every block is
+// added so that the rounds of each day are known. The participation rows are
+// those of txn_participation. The addresses of the days before the block's are
+// no longer needed once its day starts, and are removed.
+func writeTxnStats(tx *sql.Tx, round uint64, timestamp int64, stats blockTxnStats, participation [][]interface{}) error {
+	day := statsDay(timestamp)
+
+	seen := make(map[string]bool, len(participation))
+	addrs := make([][]byte, 0, len(participation))
+	for _, row := range participation {
+		addr := row[0].([]byte)
+		if !seen[string(addr)] {
+			seen[string(addr)] = true
+			addrs = append(addrs, addr)
+		}
+	}
+	var active int64
+	if len(addrs) > 0 {
+		result, err := tx.Exec(`INSERT INTO txn_stats_address (day, addr) SELECT $1::date, unnest($2::bytea[]) ON CONFLICT DO NOTHING`, day, pq.Array(addrs))
+		if err != nil {
+			return fmt.Errorf("txn stats addresses, %v", err)
+		}
+		active, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("txn stats addresses, %v", err)
+		}
+	}
+
+	_, err := tx.Exec(`DELETE FROM txn_stats_address WHERE day < $1::date`, day)
+	if err != nil {
+		return fmt.Errorf("prune txn stats addresses, %v", err)
+	}
+
+	_, err = tx.Exec(`INSERT INTO txn_stats_day (day, min_round, max_round, active_addresses) VALUES ($1::date, $2, $2, $3) ON CONFLICT (day) DO UPDATE SET min_round = least(txn_stats_day.min_round, EXCLUDED.min_round), max_round = greatest(txn_stats_day.max_round, EXCLUDED.max_round), active_addresses = txn_stats_day.active_addresses + EXCLUDED.active_addresses`, day, round, active)
+	if err != nil {
+		return fmt.Errorf("txn stats day, %v", err)
+	}
+
+	if len(stats.counts) == 0 {
+		return nil
+	}
+	upsert, err := tx.Prepare(`INSERT INTO txn_stats (day, typeenum, txns, fees) VALUES ($1::date, $2, $3, $4) ON CONFLICT (day, typeenum) DO UPDATE SET txns = txn_stats.txns + EXCLUDED.txns, fees = txn_stats.fees + EXCLUDED.fees`)
+	if err != nil {
+		return fmt.Errorf("prepare txn stats, %v", err)
+	}
+	defer upsert.Close()
+	for typeenum, count := range stats.counts {
+		_, err = upsert.Exec(day, typeenum, count, strconv.FormatUint(stats.fees[typeenum], 10))
+		if err != nil {
+			return fmt.Errorf("txn stats, %v", err)
+		}
+	}
+	return nil
+}
+
+// backfillTxnStats adds the blocks imported before the statistics were
+// recorded, the rounds before the first one in txn_stats_day, to the
+// statistics of their days. An address active on the day of the first
+// recorded round is counted twice if the day ended before the backfill.
+var backfillTxnStats = []string{
+	`CREATE TEMPORARY TABLE txn_stats_backfill ON COMMIT DROP AS
+		SELECT round, realtime::date AS day FROM block_header
+		WHERE round < coalesce((SELECT min(min_round) FROM txn_stats_day), 9223372036854775807)`,
+	`INSERT INTO txn_stats (day, typeenum, txns, fees)
+		SELECT b.day, t.typeenum, count(*), coalesce(sum((t.txn -> 'txn' ->> 'fee')::numeric), 0)
+		FROM txn t JOIN txn_stats_backfill b ON b.round = t.round GROUP BY b.day, t.typeenum
+		ON CONFLICT (day, typeenum) DO UPDATE SET txns = txn_stats.txns + EXCLUDED.txns, fees = txn_stats.fees + EXCLUDED.fees`,
+	`WITH added AS (
+			INSERT INTO txn_stats_address (day, addr)
+			SELECT DISTINCT b.day, p.addr FROM txn_participation p JOIN txn_stats_backfill b ON b.round = p.round
+			ON CONFLICT DO NOTHING RETURNING day
+		), active AS (SELECT day, count(*) AS addresses FROM added GROUP BY day)
+		INSERT INTO txn_stats_day (day, min_round, max_round, active_addresses)
+		SELECT b.day, min(b.round), max(b.round), coalesce(max(a.addresses), 0)
+		FROM txn_stats_backfill b LEFT JOIN active a ON a.day = b.day GROUP BY b.day
+		ON CONFLICT (day) DO UPDATE SET min_round = least(txn_stats_day.min_round, EXCLUDED.min_round), max_round = greatest(txn_stats_day.max_round, EXCLUDED.max_round), active_addresses = txn_stats_day.active_addresses + EXCLUDED.active_addresses`,
+	`DELETE FROM txn_stats_address WHERE day < (SELECT max(day) FROM txn_stats_day)`,
+}
+
+// Names of the network_totals rows.
+const (
+	totalAccounts    = "accounts"
+	totalAssets      = "assets"
+	totalApps        = "apps"
+	totalAssetOptIns = "asset-opt-ins"
+	totalAppOptIns   = "app-opt-ins"
+)
+
+// computeNetworkTotals counts the rows of network_totals from the current
+// state. It scans the account tables and is only used when they are created.
+const computeNetworkTotals = `INSERT INTO network_totals (name, count)
+SELECT 'accounts', count(*) FROM account WHERE NOT deleted
+UNION ALL SELECT 'assets', count(*) FROM asset WHERE NOT deleted
+UNION ALL SELECT 'apps', count(*) FROM app WHERE NOT deleted
+UNION ALL SELECT 'asset-opt-ins', count(*) FROM account_asset WHERE NOT deleted
+UNION ALL SELECT 'app-opt-ins', count(*) FROM account_app WHERE NOT deleted
+ON CONFLICT (name) DO UPDATE SET count = EXCLUDED.count`
+
+type appLocalKey struct {
+	addr  string
+	appID int64
+}
+
+// totalsKeys are the rows of the network totals which a round may change.
+// The totals are maintained by counting the live rows among them before and
+// after the round is applied. Asset opt ins are counted by assetStats.
+type totalsKeys struct {
+	accounts  map[[32]byte]bool
+	assets    map[uint64]bool
+	apps      map[int64]bool
+	appLocals map[appLocalKey]bool
+}
+
+func makeTotalsKeys(updates *idb.RoundUpdates) totalsKeys {
+	keys := totalsKeys{
+		accounts:  make(map[[32]byte]bool, len(updates.AlgoUpdates)),
+		assets:    make(map[uint64]bool),
+		apps:      make(map[int64]bool),
+		appLocals: make(map[appLocalKey]bool),
+	}
+	for addr := range updates.AlgoUpdates {
+		keys.accounts[addr] = true
+	}
+	for _, subround := range updates.AssetUpdates {
+		for _, aulist := range subround {
+			for _, au := range aulist {
+				if au.Config != nil {
+					keys.assets[au.AssetID] = true
+				}
+			}
+		}
+	}
+	for _, assetID := range updates.AssetDestroys {
+		keys.assets[assetID] = true
+	}
+	for _, delta := range updates.AppGlobalDeltas {
+		keys.apps[delta.AppIndex] = true
+	}
+	for _, delta := range updates.AppLocalDeltas {
+		keys.appLocals[appLocalKey{addr: string(delta.Address), appID: delta.AppIndex}] = true
+	}
+	return keys
+}
+
+// liveCounts are the number of keys of each total which are not deleted.
+type liveCounts map[string]int64
+
+func countQuery(tx *sql.Tx, query string, args ...interface{}) (int64, error) {
+	var count int64
+	err := tx.QueryRow(query, args...).Scan(&count)
+	return count, err
+}
+
+func (k totalsKeys) count(tx *sql.Tx) (liveCounts, error) {
+	counts := make(liveCounts)
+	if len(k.accounts) > 0 {
+		addrs := make([][]byte, 0, len(k.accounts))
+		for addr := range k.accounts {
+			addr := addr
+			addrs = append(addrs, addr[:])
+		}
+		count, err := countQuery(tx, `SELECT count(*) FROM account WHERE addr = ANY($1) AND NOT deleted`, pq.Array(addrs))
+		if err != nil {
+			return nil, fmt.Errorf("count accounts, %v", err)
+		}
+		counts[totalAccounts] = count
+	}
+	if len(k.assets) > 0 {
+		ids := make([]int64, 0, len(k.assets))
+		for id := range k.assets {
+			ids = append(ids, int64(id))
+		}
+		count, err := countQuery(tx, `SELECT count(*) FROM asset WHERE index = ANY($1) AND NOT deleted`, pq.Array(ids))
+		if err != nil {
+			return nil, fmt.Errorf("count assets, %v", err)
+		}
+		counts[totalAssets] = count
+	}
+	if len(k.apps) > 0 {
+		ids := make([]int64, 0, len(k.apps))
+		for id := range k.apps {
+			ids = append(ids, id)
+		}
+		count, err := countQuery(tx, `SELECT count(*) FROM app WHERE index = ANY($1) AND NOT deleted`, pq.Array(ids))
+		if err != nil {
+			return nil, fmt.Errorf("count apps, %v", err)
+		}
+		counts[totalApps] = count
+	}
+	if len(k.appLocals) > 0 {
+		addrs := make([][]byte, 0, len(k.appLocals))
+		ids := make([]int64, 0, len(k.appLocals))
+		for key := range k.appLocals {
+			addrs = append(addrs, []byte(key.addr))
+			ids = append(ids, key.appID)
+		}
+		count, err := countQuery(tx, `SELECT count(*) FROM account_app aa JOIN unnest($1::bytea[], $2::bigint[]) AS k (addr, app) ON aa.addr = k.addr AND aa.app = k.app WHERE NOT aa.deleted`, pq.Array(addrs), pq.Array(ids))
+		if err != nil {
+			return nil, fmt.Errorf("count app opt ins, %v", err)
+		}
+		counts[totalAppOptIns] = count
+	}
+	return counts, nil
+}
+
+// writeNetworkTotals adds the difference between the live counts before and
+// after a round to network_totals.
+func writeNetworkTotals(tx *sql.Tx, before, after liveCounts) error {
+	deltas := make(map[string]int64, len(after))
+	for name, count := range after {
+		if delta := count - before[name]; delta != 0 {
+			deltas[name] = delta
+		}
+	}
+	if len(deltas) == 0 {
+		return nil
+	}
+	upsert, err := tx.Prepare(`INSERT INTO network_totals (name, count) VALUES ($1, $2) ON CONFLICT (name) DO UPDATE SET count = network_totals.count + EXCLUDED.count`)
+	if err != nil {
+		return fmt.Errorf("prepare network totals, %v", err)
+	}
+	defer upsert.Close()
+	for name, delta := range deltas {
+		_, err = upsert.Exec(name, delta)
+		if err != nil {
+			return fmt.Errorf("network totals, %v", err)
+		}
+	}
+	return nil
+}
+
+// NetworkTotals is part of idb.IndexerDB
+func (db *IndexerDb) NetworkTotals(ctx context.Context) (idb.NetworkTotals, uint64, error) {
+	tx, err := db.beginReadTx(ctx)
+	if err != nil {
+		return idb.NetworkTotals{}, 0, err
+	}
+	defer tx.Rollback()
+
+	round, err := db.getMaxRoundAccounted(tx)
+	if err != nil {
+		return idb.NetworkTotals{}, round, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT name, count FROM network_totals`)
+	if err != nil {
+		return idb.NetworkTotals{}, round, db.queryError(ctx, idb.QueryAccounts, err)
+	}
+	defer rows.Close()
+
+	var totals idb.NetworkTotals
+	fields := map[string]*uint64{
+		totalAccounts:    &totals.Accounts,
+		totalAssets:      &totals.Assets,
+		totalApps:        &totals.Apps,
+		totalAssetOptIns: &totals.AssetOptIns,
+		totalAppOptIns:   &totals.AppOptIns,
+	}
+	for rows.Next() {
+		var name string
+		var count int64
+		err = rows.Scan(&name, &count)
+		if err != nil {
+			return idb.NetworkTotals{}, round, err
+		}
+		if field, ok := fields[name]; ok && count > 0 {
+			*field = uint64(count)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return idb.NetworkTotals{}, round, db.queryError(ctx, idb.QueryAccounts, err)
+	}
+	return totals, round, nil
+}
+
+// TransactionStats is part of idb.IndexerDB
+func (db *IndexerDb) TransactionStats(ctx context.Context, query idb.TransactionStatsQuery) (<-chan idb.TransactionStatsRow, uint64) {
+	var whereParts []string
+	var whereArgs []interface{}
+	partNumber := 1
+	if query.MinRound != 0 {
+		whereParts = append(whereParts, fmt.Sprintf("max_round >= $%d", partNumber))
+		whereArgs = append(whereArgs, query.MinRound)
+		partNumber++
+	}
+	if query.MaxRound != 0 {
+		whereParts = append(whereParts, fmt.Sprintf("min_round <= $%d", partNumber))
+		whereArgs = append(whereArgs, query.MaxRound)
+		partNumber++
+	}
+	if query.AfterDay != nil {
+		whereParts = append(whereParts, fmt.Sprintf("day > $%d::date", partNumber))
+		whereArgs = append(whereArgs, query.AfterDay.UTC().Format("2006-01-02"))
+		partNumber++
+	}
+	days := `SELECT day, min_round, max_round, active_addresses FROM txn_stats_day`
+	if len(whereParts) > 0 {
+		days += " WHERE " + strings.Join(whereParts, " AND ")
+	}
+	days += " ORDER BY day ASC"
+	if query.Limit > 0 {
+		days += fmt.Sprintf(" LIMIT %d", query.Limit)
+	}
+	sqlQuery := `SELECT d.day, d.min_round, d.max_round, d.active_addresses, coalesce(sum(s.fees), 0), coalesce(json_object_agg(s.typeenum, s.txns) FILTER (WHERE s.typeenum IS NOT NULL), '{}')
+FROM (` + days + `) d LEFT JOIN txn_stats s ON s.day = d.day
+GROUP BY d.day, d.min_round, d.max_round, d.active_addresses ORDER BY d.day ASC`
+
+	out := make(chan idb.TransactionStatsRow, 1)
+
+	tx, err := db.beginReadTx(ctx)
+	if err != nil {
+		out <- idb.TransactionStatsRow{Error: err}
+		close(out)
+		return out, 0
+	}
+
+	round, err := db.getMaxRoundAccounted(tx)
+	if err != nil {
+		out <- idb.TransactionStatsRow{Error: err}
+		close(out)
+		tx.Rollback()
+		return out, round
+	}
+
+	rows, err := db.guardedQuery(ctx, tx, idb.QueryTransactions, sqlQuery, whereArgs...)
+	if err != nil {
+		out <- idb.TransactionStatsRow{Error: err}
+		close(out)
+		tx.Rollback()
+		return out, round
+	}
+	go func() {
+		db.yieldTransactionStatsThread(ctx, rows, out)
+		close(out)
+		tx.Rollback()
+	}()
+	return out, round
+}
+
+func (db *IndexerDb) yieldTransactionStatsThread(ctx context.Context, rows *sql.Rows, out chan<- idb.TransactionStatsRow) {
+	defer rows.Close()
+
+	for rows.Next() {
+		var row idb.TransactionStatsRow
+		var fees string
+		var countsJSON []byte
+		err := rows.Scan(&row.Day, &row.MinRound, &row.MaxRound, &row.ActiveAddresses, &fees, &countsJSON)
+		if err != nil {
+			out <- idb.TransactionStatsRow{Error: err}
+			break
+		}
+		row.Fees, err = saturatingUint64(fees)
+		if err != nil {
+			out <- idb.TransactionStatsRow{Error: err}
+			break
+		}
+		var counts map[string]uint64
+		err = encoding.DecodeJSON(countsJSON, &counts)
+		if err != nil {
+			out <- idb.TransactionStatsRow{Error: fmt.Errorf("parsing transaction counts, %v", err)}
+			break
+		}
+		row.Counts = make(map[int]uint64, len(counts))
+		for typeenum, count := range counts {
+			enum, err := strconv.Atoi(typeenum)
+			if err != nil {
+				continue
+			}
+			row.Counts[enum] = count
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case out <- row:
+		}
+	}
+	if err := rows.Err(); err != nil {
+		out <- idb.TransactionStatsRow{Error: db.queryError(ctx, idb.QueryTransactions, err)}
+	}
+}
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"testing"
+
+	sdk_types "github.com/algorand/go-algorand-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/util/test"
+)
+
+func TestStatsDay(t *testing.T) {
+	assert.Equal(t, "2021-06-01", statsDay(1622505600))
+	assert.Equal(t, "2021-06-01", statsDay(1622591999))
+	assert.Equal(t, "2021-06-02", statsDay(1622592000))
+}
+
+func TestMakeTotalsKeys(t *testing.T) {
+	var updates idb.RoundUpdates
+	updates.Clear()
+	updates.AlgoUpdates[test.AccountA] = &idb.AlgoUpdate{Balance: 1}
+	updates.AssetUpdates[0][test.AccountA] = []idb.AssetUpdate{
+		{AssetID: 1, Config: &idb.AcfgUpdate{IsNew: true}},
+		// Holdings are counted by the asset stats.
+		{AssetID: 2, Transfer: &idb.AssetTransfer{}},
+	}
+	updates.AssetDestroys = []uint64{3}
+	updates.AppGlobalDeltas = []idb.AppDelta{{AppIndex: 4}, {AppIndex: 4}}
+	updates.AppLocalDeltas = []idb.AppDelta{
+		{AppIndex: 4, Address: test.AccountA[:], OnCompletion: sdk_types.OptInOC},
+		{AppIndex: 4, Address: test.AccountA[:]},
+	}
+
+	keys := makeTotalsKeys(&updates)
+	assert.Equal(t, map[[32]byte]bool{test.AccountA: true}, keys.accounts)
+	assert.Equal(t, map[uint64]bool{1: true, 3: true}, keys.assets)
+	assert.Equal(t, map[int64]bool{4: true}, keys.apps)
+	assert.Equal(t, map[appLocalKey]bool{{addr: string(test.AccountA[:]), appID: 4}: true}, keys.appLocals)
+}
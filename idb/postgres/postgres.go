@@ -15,6 +15,7 @@ import (
 	"math"
 	"math/big"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +27,7 @@ import (
 
 	// Load the postgres sql.DB implementation
 	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 
 	models "github.com/algorand/indexer/api/generated/v2"
@@ -59,15 +61,37 @@ func OpenPostgres(connection string, opts idb.IndexerDbOptions, log *log.Logger)
 		opts.ReadOnly = true
 	}
 
-	return openPostgres(db, opts, log)
+	replicas := make([]*sql.DB, 0, len(opts.ReadReplicas))
+	for i, replicaConnection := range opts.ReadReplicas {
+		replica, err := sql.Open("postgres", replicaConnection)
+		if err != nil {
+			return nil, fmt.Errorf("connecting to postgres read replica %d: %v", i, err)
+		}
+		replicas = append(replicas, replica)
+	}
+
+	pdb, err = openPostgres(db, opts, log)
+	if err != nil {
+		return nil, err
+	}
+	pdb.replicas = replicas
+	pdb.connection = connection
+	return pdb, nil
 }
 
 // Allow tests to inject a DB
 func openPostgres(db *sql.DB, opts idb.IndexerDbOptions, logger *log.Logger) (pdb *IndexerDb, err error) {
+	// register metric with global prometheus metrics handler
+	prometheus.Register(cancelledQueries)
+
 	pdb = &IndexerDb{
-		readonly: opts.ReadOnly,
+		// A writer candidate doesn't write until it is elected.
+		readonly: opts.ReadOnly || opts.ElectWriter,
 		log:      logger,
 		db:       db,
+		guards:   opts.QueryGuards,
+		opts:     opts,
+		rounds:   makeRoundNotifier(),
 	}
 
 	if pdb.log == nil {
@@ -78,7 +102,7 @@ func openPostgres(db *sql.DB, opts idb.IndexerDbOptions, logger *log.Logger) (pd
 	}
 
 	// e.g. a user named "readonly" is in the connection string
-	if !opts.ReadOnly {
+	if !pdb.readonly {
 		err = pdb.init(opts)
 		if err != nil {
 			return nil, fmt.Errorf("initializing postgres: %v", err)
@@ -92,14 +116,35 @@ type IndexerDb struct {
 	readonly bool
 	log      *log.Logger
 
+	// opts are the options the database was opened with, a writer candidate
+	// initializes the database with them once elected.
+	opts idb.IndexerDbOptions
+
 	db *sql.DB
 
+	// replicas are read only connections used by API queries, see beginReadTx.
+	replicas    []*sql.DB
+	nextReplica uint32
+
+	// guards bound the time and cost of API queries.
+	guards idb.QueryGuards
+
+	// connection is the primary's connection string, used to listen for
+	// round notifications. It is empty when a test injects the database.
+	connection string
+	rounds     *roundNotifier
+
 	// state for StartBlock/AddTransaction/CommitBlock
 	txrows  [][]interface{}
 	txprows [][]interface{}
+	txstats blockTxnStats
 
 	migration *migration.Migration
 
+	// writerMu guards readonly and migration, which change when a writer
+	// candidate is elected.
+	writerMu sync.RWMutex
+
 	accountingLock sync.Mutex
 }
 
@@ -152,6 +197,11 @@ func (db *IndexerDb) init(opts idb.IndexerDbOptions) (err error) {
 		return fmt.Errorf("unable to setup postgres: %v", err)
 	}
 
+	err = createTrigramIndexes(db)
+	if err != nil {
+		return fmt.Errorf("unable to setup postgres: %v", err)
+	}
+
 	err = db.markMigrationsAsDone()
 	if err != nil {
 		return fmt.Errorf("unable to confirm migration: %v", err)
@@ -175,6 +225,7 @@ func (db *IndexerDb) Reset() (err error) {
 func (db *IndexerDb) StartBlock() (err error) {
 	db.txrows = make([][]interface{}, 0, 6000)
 	db.txprows = make([][]interface{}, 0, 10000)
+	db.txstats = makeBlockTxnStats()
 	return nil
 }
 
@@ -189,6 +240,10 @@ func (db *IndexerDb) AddTransaction(round uint64, intra int, txtypeenum int, ass
 		txp := []interface{}{paddr, round, intra}
 		db.txprows = append(db.txprows, txp)
 	}
+	if db.txstats.counts == nil {
+		db.txstats = makeBlockTxnStats()
+	}
+	db.txstats.add(txtypeenum, uint64(txn.Txn.Fee))
 	return nil
 }
 
@@ -268,6 +323,11 @@ func (db *IndexerDb) commitBlock(tx *sql.Tx, round uint64, timestamp int64, rewa
 		return fmt.Errorf("put block_header %v    %#v", err, err)
 	}
 
+	err = writeTxnStats(tx, round, timestamp, db.txstats, db.txprows)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
@@ -280,6 +340,7 @@ func (db *IndexerDb) CommitBlock(round uint64, timestamp int64, rewardslevel uin
 
 	db.txrows = nil
 	db.txprows = nil
+	db.txstats = blockTxnStats{}
 
 	if err != nil {
 		return fmt.Errorf("CommitBlock(): %v", err)
@@ -345,6 +406,15 @@ func (db *IndexerDb) LoadGenesis(genesis types.Genesis) (err error) {
 		return
 	}
 
+	_, err = tx.Exec(computeNetworkTotals)
+	if err != nil {
+		return fmt.Errorf("unable to count network totals, %v", err)
+	}
+	_, err = tx.Exec(computeStakeTotals)
+	if err != nil {
+		return fmt.Errorf("unable to sum stake totals, %v", err)
+	}
+
 	err = tx.Commit()
 	db.log.Printf("genesis %d accounts %d microalgos, err=%v", len(genesis.Allocation), total, err)
 	return err
@@ -806,12 +876,42 @@ func setDirtyAppLocalState(dirty []inmemAppLocalState, x inmemAppLocalState) []i
 	return append(dirty, x)
 }
 
+// accountSignerArgs returns the column values of an account_signer row.
+func accountSignerArgs(addr []byte, round uint64, signer idb.AccountSigner) []interface{} {
+	var version, threshold *uint8
+	var keys interface{}
+	if signer.MultisigKeys != nil {
+		version, threshold = &signer.MultisigVersion, &signer.MultisigThreshold
+		keys = pq.ByteaArray(signer.MultisigKeys)
+	}
+	return []interface{}{addr, round, version, threshold, keys, signer.Program, signer.ProgramHash}
+}
+
 func (db *IndexerDb) commitRoundAccounting(tx *sql.Tx, updates idb.RoundUpdates, round uint64, blockHeader *types.BlockHeader) (err error) {
 	defer tx.Rollback() // ignored if .Commit() first
 
 	db.accountingLock.Lock()
 	defer db.accountingLock.Unlock()
 
+	changes := makeAccountChanges(&updates)
+	stats := makeAssetStats(tx)
+	defer stats.close()
+	totalsKeys := makeTotalsKeys(&updates)
+	totalsBefore, err := totalsKeys.count(tx)
+	if err != nil {
+		return err
+	}
+	stakeAddrs := makeStakeAddrs(&updates)
+	stakeBefore, err := sumStake(tx, stakeAddrs)
+	if err != nil {
+		return err
+	}
+	// The rekeys read the authorized addresses from before the round.
+	err = writeRekeys(tx, round, updates.Rekeys)
+	if err != nil {
+		return err
+	}
+
 	any := false
 	if len(updates.AlgoUpdates) > 0 {
 		any = true
@@ -858,6 +958,24 @@ func (db *IndexerDb) commitRoundAccounting(tx *sql.Tx, updates idb.RoundUpdates,
 			}
 		}
 	}
+	if len(updates.AccountSigners) > 0 {
+		any = true
+		// The latest signer is kept, with the round it changed.
+		setsigner, err := tx.Prepare(`INSERT INTO account_signer (addr, round, msig_version, msig_threshold, msig_keys, program, program_hash) VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (addr) DO UPDATE SET round = EXCLUDED.round, msig_version = EXCLUDED.msig_version, msig_threshold = EXCLUDED.msig_threshold, msig_keys = EXCLUDED.msig_keys, program = EXCLUDED.program, program_hash = EXCLUDED.program_hash
+WHERE (account_signer.msig_version, account_signer.msig_threshold, account_signer.msig_keys, account_signer.program) IS DISTINCT FROM (EXCLUDED.msig_version, EXCLUDED.msig_threshold, EXCLUDED.msig_keys, EXCLUDED.program)`)
+		if err != nil {
+			return fmt.Errorf("prepare account signer, %v", err)
+		}
+		defer setsigner.Close()
+		for addr, signer := range updates.AccountSigners {
+			addr := addr
+			_, err = setsigner.Exec(accountSignerArgs(addr[:], round, signer)...)
+			if err != nil {
+				return fmt.Errorf("account signer, %v", err)
+			}
+		}
+	}
 	if len(updates.AccountDataUpdates) > 0 {
 		any = true
 
@@ -948,6 +1066,13 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 		}
 		defer getacfg.Close()
 
+		// The holding before each update is recorded in the account changes.
+		getaa, err := tx.Prepare(`SELECT amount, deleted FROM account_asset WHERE addr = $1 AND assetid = $2`)
+		if err != nil {
+			return fmt.Errorf("prepare get account_asset, %v", err)
+		}
+		defer getaa.Close()
+
 		//////////////////
 		// Asset Freeze //
 		//////////////////
@@ -966,6 +1091,16 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 
 					// Apply deltas
 					if au.Transfer != nil {
+						err = stats.track(addr, au.AssetID)
+						if err != nil {
+							return err
+						}
+						_, optedIn, err := getAssetHolding(getaa, addr[:], au.AssetID)
+						if err != nil {
+							return fmt.Errorf("get account asset, %v", err)
+						}
+						changes.assetTransfer(addr, au.AssetID, &au.Transfer.Delta, !optedIn)
+
 						if au.Transfer.Delta.IsInt64() {
 							// easy case
 							delta := au.Transfer.Delta.Int64()
@@ -1006,6 +1141,18 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 
 					// Close holding before continuing to next subround.
 					if au.Close != nil {
+						for _, holder := range [][32]byte{au.Close.Sender, au.Close.CloseTo} {
+							err = stats.track(holder, au.AssetID)
+							if err != nil {
+								return err
+							}
+						}
+						amount, _, err := getAssetHolding(getaa, au.Close.Sender[:], au.AssetID)
+						if err != nil {
+							return fmt.Errorf("get account asset, %v", err)
+						}
+						changes.assetClose(au.Close.Sender, au.Close.CloseTo, au.AssetID, amount)
+
 						_, err = acc.Exec(au.Close.Round, au.Close.Offset, au.Close.Sender[:], au.AssetID)
 						if err != nil {
 							return fmt.Errorf("asset close record amount, %v", err)
@@ -1051,10 +1198,15 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 						if au.AssetID == debugAsset {
 							db.log.Errorf("%d %s %s", round, encoding.Base64(addr[:]), obs(au.Freeze))
 						}
+						err = stats.track(addr, au.AssetID)
+						if err != nil {
+							return err
+						}
 						_, err = fr.Exec(addr[:], au.AssetID, au.Freeze.Frozen, round)
 						if err != nil {
 							return fmt.Errorf("update asset freeze, %v", err)
 						}
+						changes.assetFreeze(addr, au.AssetID, au.Freeze.Frozen)
 					}
 				}
 			}
@@ -1075,10 +1227,28 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 			return fmt.Errorf("prepare asset clear, %v", err)
 		}
 		defer aclear.Close()
+		getcreator, err := tx.Prepare(`SELECT creator_addr FROM asset WHERE index = $1`)
+		if err != nil {
+			return fmt.Errorf("prepare asset creator, %v", err)
+		}
+		defer getcreator.Close()
 		for _, assetID := range updates.AssetDestroys {
 			if assetID == debugAsset {
 				db.log.Errorf("%d destroy asset %d", round, assetID)
 			}
+			var creator []byte
+			err = getcreator.QueryRow(assetID).Scan(&creator)
+			if err != nil && err != sql.ErrNoRows {
+				return fmt.Errorf("asset destroy creator, %v", err)
+			}
+			if len(creator) == 32 {
+				var addr [32]byte
+				copy(addr[:], creator)
+				err = stats.track(addr, assetID)
+				if err != nil {
+					return err
+				}
+			}
 			_, err = ads.Exec(round, assetID)
 			if err != nil {
 				return fmt.Errorf("asset destroy, %v", err)
@@ -1291,6 +1461,33 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 		db.log.Debugf("empty round %d", round)
 	}
 
+	err = changes.write(tx, round)
+	if err != nil {
+		return err
+	}
+	assetOptIns, err := stats.write(round, updates.AssetTransfers)
+	if err != nil {
+		return err
+	}
+	totalsAfter, err := totalsKeys.count(tx)
+	if err != nil {
+		return err
+	}
+	// Asset opt ins are counted by the asset stats as a difference.
+	totalsAfter[totalAssetOptIns] = assetOptIns
+	err = writeNetworkTotals(tx, totalsBefore, totalsAfter)
+	if err != nil {
+		return err
+	}
+	stakeAfter, err := sumStake(tx, stakeAddrs)
+	if err != nil {
+		return err
+	}
+	err = writeStakeTotals(tx, round, stakeBefore, stakeAfter)
+	if err != nil {
+		return err
+	}
+
 	importstate, err := db.getImportState(tx)
 	if err != nil {
 		return err
@@ -1312,6 +1509,12 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 		return
 	}
 
+	// Delivered to listeners when the transaction commits.
+	_, err = tx.Exec(`SELECT pg_notify($1, $2)`, roundChannel, strconv.FormatUint(round, 10))
+	if err != nil {
+		return fmt.Errorf("notify round, %v", err)
+	}
+
 	return tx.Commit()
 }
 
@@ -1323,12 +1526,13 @@ func (db *IndexerDb) CommitRoundAccounting(updates idb.RoundUpdates, round uint6
 	if err := db.txWithRetry(context.Background(), serializable, f); err != nil {
 		return fmt.Errorf("CommitRoundAccounting(): %v", err)
 	}
+	db.rounds.Publish(round)
 	return nil
 }
 
 // GetBlock is part of idb.IndexerDB
 func (db *IndexerDb) GetBlock(ctx context.Context, round uint64, options idb.GetBlockOptions) (blockHeader types.BlockHeader, transactions []idb.TxnRow, err error) {
-	tx, err := db.db.BeginTx(ctx, &readonlyRepeatableRead)
+	tx, err := db.beginReadTx(ctx)
 	if err != nil {
 		return
 	}
@@ -1360,7 +1564,7 @@ func (db *IndexerDb) GetBlock(ctx context.Context, round uint64, options idb.Get
 		}
 
 		go func() {
-			db.yieldTxnsThreadSimple(ctx, rows, out, nil, nil)
+			db.yieldTxnsThreadSimple(ctx, rows, idb.QueryTransactions, out, nil, nil)
 			close(out)
 		}()
 
@@ -1577,21 +1781,21 @@ func (db *IndexerDb) yieldTxns(ctx context.Context, tx *sql.Tx, tf idb.Transacti
 		return
 	}
 
-	rows, err := tx.Query(query, whereArgs...)
+	rows, err := db.guardedQuery(ctx, tx, transactionQueryKind(tf), query, whereArgs...)
 	if err != nil {
-		err = fmt.Errorf("txn query %#v err %v", query, err)
+		err = fmt.Errorf("txn query %#v err %w", query, err)
 		out <- idb.TxnRow{Error: err}
 		return
 	}
 
-	db.yieldTxnsThreadSimple(ctx, rows, out, nil, nil)
+	db.yieldTxnsThreadSimple(ctx, rows, transactionQueryKind(tf), out, nil, nil)
 }
 
 // Transactions is part of idb.IndexerDB
 func (db *IndexerDb) Transactions(ctx context.Context, tf idb.TransactionFilter) (<-chan idb.TxnRow, uint64) {
 	out := make(chan idb.TxnRow, 1)
 
-	tx, err := db.db.BeginTx(ctx, &readonlyRepeatableRead)
+	tx, err := db.beginReadTx(ctx)
 	if err != nil {
 		out <- idb.TxnRow{Error: err}
 		close(out)
@@ -1638,7 +1842,7 @@ func (db *IndexerDb) txTransactions(tx *sql.Tx, tf idb.TransactionFilter) <-chan
 		return out
 	}
 	go func() {
-		db.yieldTxnsThreadSimple(context.Background(), rows, out, nil, nil)
+		db.yieldTxnsThreadSimple(context.Background(), rows, idb.QueryTransactions, out, nil, nil)
 		close(out)
 	}()
 	return out
@@ -1673,14 +1877,14 @@ func (db *IndexerDb) txnsWithNext(ctx context.Context, tx *sql.Tx, tf idb.Transa
 		out <- idb.TxnRow{Error: err}
 		return
 	}
-	rows, err := tx.Query(query, whereArgs...)
+	rows, err := db.guardedQuery(ctx, tx, transactionQueryKind(tf), query, whereArgs...)
 	if err != nil {
-		err = fmt.Errorf("txn query %#v err %v", query, err)
+		err = fmt.Errorf("txn query %#v err %w", query, err)
 		out <- idb.TxnRow{Error: err}
 		return
 	}
 	count := int(0)
-	db.yieldTxnsThreadSimple(ctx, rows, out, &count, &err)
+	db.yieldTxnsThreadSimple(ctx, rows, transactionQueryKind(tf), out, &count, &err)
 	if err != nil {
 		return
 	}
@@ -1713,16 +1917,16 @@ func (db *IndexerDb) txnsWithNext(ctx context.Context, tx *sql.Tx, tf idb.Transa
 		out <- idb.TxnRow{Error: err}
 		return
 	}
-	rows, err = tx.Query(query, whereArgs...)
+	rows, err = db.guardedQuery(ctx, tx, transactionQueryKind(tf), query, whereArgs...)
 	if err != nil {
-		err = fmt.Errorf("txn query %#v err %v", query, err)
+		err = fmt.Errorf("txn query %#v err %w", query, err)
 		out <- idb.TxnRow{Error: err}
 		return
 	}
-	db.yieldTxnsThreadSimple(ctx, rows, out, nil, nil)
+	db.yieldTxnsThreadSimple(ctx, rows, transactionQueryKind(tf), out, nil, nil)
 }
 
-func (db *IndexerDb) yieldTxnsThreadSimple(ctx context.Context, rows *sql.Rows, results chan<- idb.TxnRow, countp *int, errp *error) {
+func (db *IndexerDb) yieldTxnsThreadSimple(ctx context.Context, rows *sql.Rows, kind string, results chan<- idb.TxnRow, countp *int, errp *error) {
 	defer rows.Close()
 
 	count := 0
@@ -1764,6 +1968,7 @@ func (db *IndexerDb) yieldTxnsThreadSimple(ctx context.Context, rows *sql.Rows,
 		}
 	}
 	if err := rows.Err(); err != nil {
+		err = db.queryError(ctx, kind, err)
 		results <- idb.TxnRow{Error: err}
 		if errp != nil {
 			*errp = err
@@ -1832,11 +2037,17 @@ func (db *IndexerDb) yieldAccountsThread(req *getAccountsRequest) {
 		var localStateClosedBytes []byte
 		var localStateDeletedBytes []byte
 
+		// account_signer of msig and lsig accounts
+		var msigVersion, msigThreshold *uint64
+		var msigKeys pq.ByteaArray
+		var program, programHash []byte
+
 		var err error
 
 		if req.opts.IncludeAssetHoldings && req.opts.IncludeAssetParams {
 			err = req.rows.Scan(
 				&addr, &microalgos, &rewardstotal, &createdat, &closedat, &deleted, &rewardsbase, &keytype, &accountDataJSONStr,
+				&msigVersion, &msigThreshold, &msigKeys, &program, &programHash,
 				&holdingAssetids, &holdingAmount, &holdingFrozen, &holdingCreatedBytes, &holdingClosedBytes, &holdingDeletedBytes,
 				&assetParamsIds, &assetParamsStr, &assetParamsCreatedBytes, &assetParamsClosedBytes, &assetParamsDeletedBytes,
 				&appParamIndexes, &appParams, &appCreatedBytes, &appClosedBytes, &appDeletedBytes, &localStateAppIds, &localStates,
@@ -1845,6 +2056,7 @@ func (db *IndexerDb) yieldAccountsThread(req *getAccountsRequest) {
 		} else if req.opts.IncludeAssetHoldings {
 			err = req.rows.Scan(
 				&addr, &microalgos, &rewardstotal, &createdat, &closedat, &deleted, &rewardsbase, &keytype, &accountDataJSONStr,
+				&msigVersion, &msigThreshold, &msigKeys, &program, &programHash,
 				&holdingAssetids, &holdingAmount, &holdingFrozen, &holdingCreatedBytes, &holdingClosedBytes, &holdingDeletedBytes,
 				&appParamIndexes, &appParams, &appCreatedBytes, &appClosedBytes, &appDeletedBytes, &localStateAppIds, &localStates,
 				&localStateCreatedBytes, &localStateClosedBytes, &localStateDeletedBytes,
@@ -1852,6 +2064,7 @@ func (db *IndexerDb) yieldAccountsThread(req *getAccountsRequest) {
 		} else if req.opts.IncludeAssetParams {
 			err = req.rows.Scan(
 				&addr, &microalgos, &rewardstotal, &createdat, &closedat, &deleted, &rewardsbase, &keytype, &accountDataJSONStr,
+				&msigVersion, &msigThreshold, &msigKeys, &program, &programHash,
 				&assetParamsIds, &assetParamsStr, &assetParamsCreatedBytes, &assetParamsClosedBytes, &assetParamsDeletedBytes,
 				&appParamIndexes, &appParams, &appCreatedBytes, &appClosedBytes, &appDeletedBytes, &localStateAppIds, &localStates,
 				&localStateCreatedBytes, &localStateClosedBytes, &localStateDeletedBytes,
@@ -1859,6 +2072,7 @@ func (db *IndexerDb) yieldAccountsThread(req *getAccountsRequest) {
 		} else {
 			err = req.rows.Scan(
 				&addr, &microalgos, &rewardstotal, &createdat, &closedat, &deleted, &rewardsbase, &keytype, &accountDataJSONStr,
+				&msigVersion, &msigThreshold, &msigKeys, &program, &programHash,
 				&appParamIndexes, &appParams, &appCreatedBytes, &appClosedBytes, &appDeletedBytes, &localStateAppIds, &localStates,
 				&localStateCreatedBytes, &localStateClosedBytes, &localStateDeletedBytes,
 			)
@@ -1886,6 +2100,19 @@ func (db *IndexerDb) yieldAccountsThread(req *getAccountsRequest) {
 		if keytype != nil && *keytype != "" {
 			account.SigType = keytype
 		}
+		if msigVersion != nil {
+			account.Multisig = &models.AccountMultisig{
+				Version:    *msigVersion,
+				Threshold:  *msigThreshold,
+				PublicKeys: [][]byte(msigKeys),
+			}
+		}
+		if program != nil {
+			account.Logicsig = &models.AccountLogicsig{
+				Logic:       program,
+				ProgramHash: programHash,
+			}
+		}
 
 		if accountDataJSONStr != nil {
 			var ad types.AccountData
@@ -2258,6 +2485,23 @@ func (db *IndexerDb) yieldAccountsThread(req *getAccountsRequest) {
 			account.AppsTotalSchema = &totalSchema
 		}
 
+		// The minimum balance depends on the asset holdings, so they must be included.
+		if req.opts.IncludeAssetHoldings && !isDeleted(account.Deleted) {
+			proto, err := types.Protocol(string(req.blockheader.CurrentProtocol))
+			if err != nil {
+				err = fmt.Errorf("get protocol err (%s) %v", req.blockheader.CurrentProtocol, err)
+				req.out <- idb.AccountRow{Error: err}
+				break
+			}
+			minBalance := types.MinBalance(proto, minBalanceTotals(account))
+			var available uint64
+			if account.Amount > minBalance {
+				available = account.Amount - minBalance
+			}
+			account.MinBalance = &minBalance
+			account.AvailableBalance = &available
+		}
+
 		select {
 		case req.out <- idb.AccountRow{Account: account}:
 			count++
@@ -2269,7 +2513,7 @@ func (db *IndexerDb) yieldAccountsThread(req *getAccountsRequest) {
 		}
 	}
 	if err := req.rows.Err(); err != nil {
-		err = fmt.Errorf("error reading rows: %v", err)
+		err = fmt.Errorf("error reading rows: %w", db.queryError(req.ctx, idb.QueryAccounts, err))
 		req.out <- idb.AccountRow{Error: err}
 	}
 }
@@ -2377,7 +2621,7 @@ func (db *IndexerDb) GetAccounts(ctx context.Context, opts idb.AccountQueryOptio
 	}
 
 	// Begin transaction so we get everything at one consistent point in time and round of accounting.
-	tx, err := db.db.BeginTx(ctx, &readonlyRepeatableRead)
+	tx, err := db.beginReadTx(ctx)
 	if err != nil {
 		err = fmt.Errorf("account tx err %v", err)
 		out <- idb.AccountRow{Error: err}
@@ -2416,8 +2660,21 @@ func (db *IndexerDb) GetAccounts(ctx context.Context, opts idb.AccountQueryOptio
 		return out, round
 	}
 
+	// The min-balance filters depend on the protocol.
+	var proto types.ConsensusParams
+	if opts.MinBalanceGreaterThan != nil || opts.MinBalanceLessThan != nil {
+		proto, err = types.Protocol(string(blockheader.CurrentProtocol))
+		if err != nil {
+			err = fmt.Errorf("get protocol err (%s) %v", blockheader.CurrentProtocol, err)
+			out <- idb.AccountRow{Error: err}
+			close(out)
+			tx.Rollback()
+			return out, round
+		}
+	}
+
 	// Construct query for fetching accounts...
-	query, whereArgs := db.buildAccountQuery(opts)
+	query, whereArgs := db.buildAccountQuery(opts, proto)
 	req := &getAccountsRequest{
 		ctx:         ctx,
 		opts:        opts,
@@ -2426,9 +2683,9 @@ func (db *IndexerDb) GetAccounts(ctx context.Context, opts idb.AccountQueryOptio
 		out:         out,
 		start:       time.Now(),
 	}
-	req.rows, err = tx.Query(query, whereArgs...)
+	req.rows, err = db.guardedQuery(ctx, tx, idb.QueryAccounts, query, whereArgs...)
 	if err != nil {
-		err = fmt.Errorf("account query %#v err %v", query, err)
+		err = fmt.Errorf("account query %#v err %w", query, err)
 		out <- idb.AccountRow{Error: err}
 		close(out)
 		tx.Rollback()
@@ -2442,9 +2699,9 @@ func (db *IndexerDb) GetAccounts(ctx context.Context, opts idb.AccountQueryOptio
 	return out, round
 }
 
-func (db *IndexerDb) buildAccountQuery(opts idb.AccountQueryOptions) (query string, whereArgs []interface{}) {
+func (db *IndexerDb) buildAccountQuery(opts idb.AccountQueryOptions, proto types.ConsensusParams) (query string, whereArgs []interface{}) {
 	// Construct query for fetching accounts...
-	const maxWhereParts = 14
+	const maxWhereParts = 27
 	whereParts := make([]string, 0, maxWhereParts)
 	whereArgs = make([]interface{}, 0, maxWhereParts)
 	partNumber := 1
@@ -2501,6 +2758,79 @@ func (db *IndexerDb) buildAccountQuery(opts idb.AccountQueryOptions) (query stri
 		whereArgs = append(whereArgs, opts.EqualToAuthAddr)
 		partNumber++
 	}
+	// The expressions match account_by_participation.
+	if opts.Status != nil {
+		whereParts = append(whereParts, fmt.Sprintf("coalesce((a.account_data ->> 'onl')::int, 0) = $%d", partNumber))
+		whereArgs = append(whereArgs, *opts.Status)
+		partNumber++
+	}
+	if opts.VoteLastValidBefore != nil {
+		whereParts = append(whereParts, fmt.Sprintf("(a.account_data ->> 'voteLst')::bigint < $%d", partNumber))
+		whereArgs = append(whereArgs, *opts.VoteLastValidBefore)
+		partNumber++
+	}
+	if opts.VoteLastValidAfter != nil {
+		whereParts = append(whereParts, fmt.Sprintf("(a.account_data ->> 'voteLst')::bigint > $%d", partNumber))
+		whereArgs = append(whereArgs, *opts.VoteLastValidAfter)
+		partNumber++
+	}
+	if opts.MinBalanceGreaterThan != nil {
+		whereParts = append(whereParts, fmt.Sprintf("%s > $%d", minBalanceExpr(proto), partNumber))
+		whereArgs = append(whereArgs, *opts.MinBalanceGreaterThan)
+		partNumber++
+	}
+	if opts.MinBalanceLessThan != nil {
+		whereParts = append(whereParts, fmt.Sprintf("%s < $%d", minBalanceExpr(proto), partNumber))
+		whereArgs = append(whereArgs, *opts.MinBalanceLessThan)
+		partNumber++
+	}
+	if opts.SigType != "" {
+		whereParts = append(whereParts, fmt.Sprintf("a.keytype = $%d", partNumber))
+		whereArgs = append(whereArgs, opts.SigType)
+		partNumber++
+	}
+	if opts.CreatedAfterRound != nil {
+		whereParts = append(whereParts, fmt.Sprintf("a.created_at > $%d", partNumber))
+		whereArgs = append(whereArgs, *opts.CreatedAfterRound)
+		partNumber++
+	}
+	if opts.CreatedBeforeRound != nil {
+		whereParts = append(whereParts, fmt.Sprintf("a.created_at < $%d", partNumber))
+		whereArgs = append(whereArgs, *opts.CreatedBeforeRound)
+		partNumber++
+	}
+	if opts.ClosedAfterRound != nil {
+		whereParts = append(whereParts, fmt.Sprintf("a.closed_at > $%d", partNumber))
+		whereArgs = append(whereArgs, *opts.ClosedAfterRound)
+		partNumber++
+	}
+	if opts.ClosedBeforeRound != nil {
+		whereParts = append(whereParts, fmt.Sprintf("a.closed_at < $%d", partNumber))
+		whereArgs = append(whereArgs, *opts.ClosedBeforeRound)
+		partNumber++
+	}
+	if opts.IsRekeyed != nil {
+		if *opts.IsRekeyed {
+			whereParts = append(whereParts, "a.account_data ->> 'spend' IS NOT NULL")
+		} else {
+			whereParts = append(whereParts, "a.account_data ->> 'spend' IS NULL")
+		}
+	}
+	if len(opts.AuthAddrHistory) > 0 {
+		whereParts = append(whereParts, fmt.Sprintf("a.addr IN (SELECT addr FROM account_rekey WHERE new_auth = $%d)", partNumber))
+		whereArgs = append(whereArgs, opts.AuthAddrHistory)
+		partNumber++
+	}
+	if len(opts.MultisigKey) > 0 {
+		whereParts = append(whereParts, fmt.Sprintf("a.addr IN (SELECT addr FROM account_signer WHERE msig_keys @> ARRAY[$%d::bytea])", partNumber))
+		whereArgs = append(whereArgs, opts.MultisigKey)
+		partNumber++
+	}
+	if opts.AfterBalance != nil {
+		whereParts = append(whereParts, fmt.Sprintf("(a.microalgos, a.addr) < ($%d, $%d)", partNumber, partNumber+1))
+		whereArgs = append(whereArgs, opts.AfterBalance.Amount, opts.AfterBalance.Address)
+		partNumber += 2
+	}
 	query = `SELECT a.addr, a.microalgos, a.rewards_total, a.created_at, a.closed_at, a.deleted, a.rewardsbase, a.keytype, a.account_data FROM account a`
 	if opts.HasAssetID != 0 {
 		// inner join requires match, filtering on presence of asset
@@ -2514,7 +2844,12 @@ func (db *IndexerDb) buildAccountQuery(opts idb.AccountQueryOptions) (query stri
 		whereStr := strings.Join(whereParts, " AND ")
 		query += " WHERE " + whereStr
 	}
-	query += " ORDER BY a.addr ASC"
+	orderBy := "ORDER BY %[1]s.addr ASC"
+	if opts.OrderByBalance {
+		// Both columns are descending so that account_by_balance is used.
+		orderBy = "ORDER BY %[1]s.microalgos DESC, %[1]s.addr DESC"
+	}
+	query += " " + fmt.Sprintf(orderBy, "a")
 	if opts.Limit != 0 {
 		query += fmt.Sprintf(" LIMIT %d", opts.Limit)
 	}
@@ -2546,7 +2881,7 @@ func (db *IndexerDb) buildAccountQuery(opts idb.AccountQueryOptions) (query stri
 	}
 
 	// query results
-	query += ` SELECT za.addr, za.microalgos, za.rewards_total, za.created_at, za.closed_at, za.deleted, za.rewardsbase, za.keytype, za.account_data`
+	query += ` SELECT za.addr, za.microalgos, za.rewards_total, za.created_at, za.closed_at, za.deleted, za.rewardsbase, za.keytype, za.account_data, sg.msig_version, sg.msig_threshold, sg.msig_keys, sg.program, sg.program_hash`
 	if opts.IncludeAssetHoldings {
 		query += `, qaa.haid, qaa.hamt, qaa.hf, qaa.holding_created_at, qaa.holding_closed_at, qaa.holding_deleted`
 	}
@@ -2562,23 +2897,28 @@ func (db *IndexerDb) buildAccountQuery(opts idb.AccountQueryOptions) (query stri
 	if opts.IncludeAssetParams {
 		query += ` LEFT JOIN qap ON za.addr = qap.addr`
 	}
-	query += " LEFT JOIN qapp ON za.addr = qapp.addr LEFT JOIN qls ON qls.addr = za.addr ORDER BY za.addr ASC;"
+	query += " LEFT JOIN account_signer sg ON za.addr = sg.addr LEFT JOIN qapp ON za.addr = qapp.addr LEFT JOIN qls ON qls.addr = za.addr " + fmt.Sprintf(orderBy, "za") + ";"
 	return query, whereArgs
 }
 
-// Assets is part of idb.IndexerDB
-func (db *IndexerDb) Assets(ctx context.Context, filter idb.AssetsQuery) (<-chan idb.AssetRow, uint64) {
-	query := `SELECT index, creator_addr, params, created_at, closed_at, deleted FROM asset a`
-	const maxWhereParts = 14
+// escapeLike escapes the wildcards of a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// buildAssetQuery returns the query of the assets matching filter. Assets are
+// ordered by id, or by their score when searching with filter.Query.
+func buildAssetQuery(filter idb.AssetsQuery, trigram bool) (query string, whereArgs []interface{}) {
+	const maxWhereParts = 25
 	whereParts := make([]string, 0, maxWhereParts)
-	whereArgs := make([]interface{}, 0, maxWhereParts)
+	whereArgs = make([]interface{}, 0, maxWhereParts)
 	partNumber := 1
 	if filter.AssetID != 0 {
 		whereParts = append(whereParts, fmt.Sprintf("a.index = $%d", partNumber))
 		whereArgs = append(whereArgs, filter.AssetID)
 		partNumber++
 	}
-	if filter.AssetIDGreaterThan != 0 {
+	if filter.AssetIDGreaterThan != 0 && filter.Query == "" {
 		whereParts = append(whereParts, fmt.Sprintf("a.index > $%d", partNumber))
 		whereArgs = append(whereArgs, filter.AssetIDGreaterThan)
 		partNumber++
@@ -2598,27 +2938,80 @@ func (db *IndexerDb) Assets(ctx context.Context, filter idb.AssetsQuery) (<-chan
 		whereArgs = append(whereArgs, "%"+filter.Unit+"%")
 		partNumber++
 	}
+	score := "NULL::numeric"
 	if filter.Query != "" {
-		qs := "%" + filter.Query + "%"
-		whereParts = append(whereParts, fmt.Sprintf("(a.params ->> 'un' ILIKE $%d OR a.params ->> 'an' ILIKE $%d)", partNumber, partNumber))
-		whereArgs = append(whereArgs, qs)
+		var match string
+		match, score = assetQueryMatch(trigram, partNumber, partNumber+1, partNumber+2)
+		whereParts = append(whereParts, match)
+		escaped := escapeLike(filter.Query)
+		whereArgs = append(whereArgs, filter.Query, escaped+"%", "%"+escaped+"%")
+		partNumber += 3
+	}
+	// The role addresses and metadata hash are base64 in the params json, the
+	// containment comparisons use asset_params_gin.
+	paramBytes := []struct {
+		key   string
+		value []byte
+	}{
+		{"m", filter.Manager},
+		{"r", filter.Reserve},
+		{"f", filter.Freeze},
+		{"c", filter.Clawback},
+		{"am", filter.MetadataHash},
+	}
+	for _, param := range paramBytes {
+		if param.value == nil {
+			continue
+		}
+		whereParts = append(whereParts, fmt.Sprintf("a.params @> jsonb_build_object('%s', encode($%d::bytea, 'base64'))", param.key, partNumber))
+		whereArgs = append(whereArgs, param.value)
+		partNumber++
+	}
+	if filter.URLPrefix != "" {
+		whereParts = append(whereParts, fmt.Sprintf("a.params ->> 'au' LIKE $%d", partNumber))
+		whereArgs = append(whereArgs, escapeLike(filter.URLPrefix)+"%")
+		partNumber++
+	}
+	// Zero decimals and default frozen false are omitted from the params json.
+	if filter.Decimals != nil {
+		whereParts = append(whereParts, fmt.Sprintf("coalesce((a.params ->> 'dc')::bigint, 0) = $%d", partNumber))
+		whereArgs = append(whereArgs, *filter.Decimals)
+		partNumber++
+	}
+	if filter.DefaultFrozen != nil {
+		whereParts = append(whereParts, fmt.Sprintf("coalesce((a.params ->> 'df')::bool, false) = $%d", partNumber))
+		whereArgs = append(whereArgs, *filter.DefaultFrozen)
 		partNumber++
 	}
 	if !filter.IncludeDeleted {
 		whereParts = append(whereParts, "coalesce(a.deleted, false) = false")
 	}
+	query = `SELECT index, creator_addr, params, created_at, closed_at, deleted, ` + score + ` AS score FROM asset a`
 	if len(whereParts) > 0 {
 		whereStr := strings.Join(whereParts, " AND ")
 		query += " WHERE " + whereStr
 	}
-	query += " ORDER BY index ASC"
+	if filter.Query != "" {
+		query = `SELECT index, creator_addr, params, created_at, closed_at, deleted, score FROM (` + query + `) a`
+		if filter.PrevScore != nil {
+			query += fmt.Sprintf(" WHERE (a.score < $%d OR (a.score = $%d AND a.index > $%d))", partNumber, partNumber, partNumber+1)
+			whereArgs = append(whereArgs, *filter.PrevScore, filter.AssetIDGreaterThan)
+		}
+		query += " ORDER BY score DESC, index ASC"
+	} else {
+		query += " ORDER BY index ASC"
+	}
 	if filter.Limit != 0 {
 		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
 	}
+	return query, whereArgs
+}
 
+// Assets is part of idb.IndexerDB
+func (db *IndexerDb) Assets(ctx context.Context, filter idb.AssetsQuery) (<-chan idb.AssetRow, uint64) {
 	out := make(chan idb.AssetRow, 1)
 
-	tx, err := db.db.BeginTx(ctx, &readonlyRepeatableRead)
+	tx, err := db.beginReadTx(ctx)
 	if err != nil {
 		out <- idb.AssetRow{Error: err}
 		close(out)
@@ -2633,9 +3026,21 @@ func (db *IndexerDb) Assets(ctx context.Context, filter idb.AssetsQuery) (<-chan
 		return out, round
 	}
 
-	rows, err := tx.Query(query, whereArgs...)
+	trigram := false
+	if filter.Query != "" {
+		trigram, err = hasTrigram(ctx, tx)
+		if err != nil {
+			out <- idb.AssetRow{Error: db.queryError(ctx, idb.QueryAssets, err)}
+			close(out)
+			tx.Rollback()
+			return out, round
+		}
+	}
+
+	query, whereArgs := buildAssetQuery(filter, trigram)
+	rows, err := db.guardedQuery(ctx, tx, idb.QueryAssets, query, whereArgs...)
 	if err != nil {
-		err = fmt.Errorf("asset query %#v err %v", query, err)
+		err = fmt.Errorf("asset query %#v err %w", query, err)
 		out <- idb.AssetRow{Error: err}
 		close(out)
 		tx.Rollback()
@@ -2659,9 +3064,10 @@ func (db *IndexerDb) yieldAssetsThread(ctx context.Context, filter idb.AssetsQue
 		var created *uint64
 		var closed *uint64
 		var deleted *bool
+		var score *float64
 		var err error
 
-		err = rows.Scan(&index, &creatorAddr, &paramsJSONStr, &created, &closed, &deleted)
+		err = rows.Scan(&index, &creatorAddr, &paramsJSONStr, &created, &closed, &deleted, &score)
 		if err != nil {
 			out <- idb.AssetRow{Error: err}
 			break
@@ -2679,6 +3085,7 @@ func (db *IndexerDb) yieldAssetsThread(ctx context.Context, filter idb.AssetsQue
 			CreatedRound: created,
 			ClosedRound:  closed,
 			Deleted:      deleted,
+			Score:        score,
 		}
 		select {
 		case <-ctx.Done():
@@ -2687,7 +3094,7 @@ func (db *IndexerDb) yieldAssetsThread(ctx context.Context, filter idb.AssetsQue
 		}
 	}
 	if err := rows.Err(); err != nil {
-		out <- idb.AssetRow{Error: err}
+		out <- idb.AssetRow{Error: db.queryError(ctx, idb.QueryAssets, err)}
 	}
 }
 
@@ -2717,6 +3124,11 @@ func (db *IndexerDb) AssetBalances(ctx context.Context, abq idb.AssetBalanceQuer
 		whereArgs = append(whereArgs, abq.PrevAddress)
 		partNumber++
 	}
+	if abq.AfterAmount != nil {
+		whereParts = append(whereParts, fmt.Sprintf("(aa.amount, aa.addr) < ($%d, $%d)", partNumber, partNumber+1))
+		whereArgs = append(whereArgs, abq.AfterAmount.Amount, abq.AfterAmount.Address)
+		partNumber += 2
+	}
 	if !abq.IncludeDeleted {
 		whereParts = append(whereParts, "coalesce(aa.deleted, false) = false")
 	}
@@ -2724,14 +3136,19 @@ func (db *IndexerDb) AssetBalances(ctx context.Context, abq idb.AssetBalanceQuer
 	if len(whereParts) > 0 {
 		query += " WHERE " + strings.Join(whereParts, " AND ")
 	}
-	query += " ORDER BY addr ASC"
+	if abq.OrderByAmount {
+		// Both columns are descending so that account_asset_by_amount is used.
+		query += " ORDER BY amount DESC, addr DESC"
+	} else {
+		query += " ORDER BY addr ASC"
+	}
 	if abq.Limit > 0 {
 		query += fmt.Sprintf(" LIMIT %d", abq.Limit)
 	}
 
 	out := make(chan idb.AssetBalanceRow, 1)
 
-	tx, err := db.db.BeginTx(ctx, &readonlyRepeatableRead)
+	tx, err := db.beginReadTx(ctx)
 	if err != nil {
 		out <- idb.AssetBalanceRow{Error: err}
 		close(out)
@@ -2746,7 +3163,11 @@ func (db *IndexerDb) AssetBalances(ctx context.Context, abq idb.AssetBalanceQuer
 		return out, round
 	}
 
-	rows, err := tx.Query(query, whereArgs...)
+	kind := idb.QueryBalances
+	if abq.Export {
+		kind = idb.QueryExport
+	}
+	rows, err := db.guardedQuery(ctx, tx, kind, query, whereArgs...)
 	if err != nil {
 		out <- idb.AssetBalanceRow{Error: err}
 		close(out)
@@ -2754,14 +3175,14 @@ func (db *IndexerDb) AssetBalances(ctx context.Context, abq idb.AssetBalanceQuer
 		return out, round
 	}
 	go func() {
-		db.yieldAssetBalanceThread(ctx, rows, out)
+		db.yieldAssetBalanceThread(ctx, rows, kind, out)
 		close(out)
 		tx.Rollback()
 	}()
 	return out, round
 }
 
-func (db *IndexerDb) yieldAssetBalanceThread(ctx context.Context, rows *sql.Rows, out chan<- idb.AssetBalanceRow) {
+func (db *IndexerDb) yieldAssetBalanceThread(ctx context.Context, rows *sql.Rows, kind string, out chan<- idb.AssetBalanceRow) {
 	defer rows.Close()
 
 	for rows.Next() {
@@ -2793,7 +3214,7 @@ func (db *IndexerDb) yieldAssetBalanceThread(ctx context.Context, rows *sql.Rows
 		}
 	}
 	if err := rows.Err(); err != nil {
-		out <- idb.AssetBalanceRow{Error: err}
+		out <- idb.AssetBalanceRow{Error: db.queryError(ctx, kind, err)}
 	}
 }
 
@@ -2834,7 +3255,7 @@ func (db *IndexerDb) Applications(ctx context.Context, filter *models.SearchForA
 		query += fmt.Sprintf(" LIMIT %d", *filter.Limit)
 	}
 
-	tx, err := db.db.BeginTx(ctx, &readonlyRepeatableRead)
+	tx, err := db.beginReadTx(ctx)
 	if err != nil {
 		out <- idb.ApplicationRow{Error: err}
 		close(out)
@@ -2849,7 +3270,7 @@ func (db *IndexerDb) Applications(ctx context.Context, filter *models.SearchForA
 		return out, round
 	}
 
-	rows, err := tx.Query(query, whereArgs...)
+	rows, err := db.guardedQuery(ctx, tx, idb.QueryApplications, query, whereArgs...)
 	if err != nil {
 		out <- idb.ApplicationRow{Error: err}
 		close(out)
@@ -2918,7 +3339,7 @@ func (db *IndexerDb) yieldApplicationsThread(ctx context.Context, rows *sql.Rows
 		out <- rec
 	}
 	if err := rows.Err(); err != nil {
-		out <- idb.ApplicationRow{Error: err}
+		out <- idb.ApplicationRow{Error: db.queryError(ctx, idb.QueryApplications, err)}
 	}
 }
 
@@ -2930,12 +3351,20 @@ func (db *IndexerDb) Health() (idb.Health, error) {
 	errString := ""
 	var data = make(map[string]interface{})
 
-	if db.readonly {
+	db.writerMu.RLock()
+	readonly := db.readonly
+	dbMigration := db.migration
+	db.writerMu.RUnlock()
+
+	if readonly {
 		data["read-only-mode"] = true
 	}
+	if db.opts.ElectWriter {
+		data["writer"] = !readonly
+	}
 
-	if db.migration != nil {
-		state := db.migration.GetStatus()
+	if dbMigration != nil {
+		state := dbMigration.GetStatus()
 
 		if state.Err != nil {
 			errString = state.Err.Error()
@@ -1,11 +1,14 @@
 package postgres
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"math"
 	"sync"
 	"testing"
+	"time"
 
 	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
@@ -1078,3 +1081,879 @@ func TestInitializationNewDatabase(t *testing.T) {
 
 	assert.Equal(t, len(migrations), state.NextMigration)
 }
+
+// TestQueryGuardMaxCost checks that queries which are estimated to cost more
+// than the maximum are rejected before running.
+func TestQueryGuardMaxCost(t *testing.T) {
+	_, connStr, shutdownFunc := setupPostgres(t)
+	defer shutdownFunc()
+
+	opts := idb.IndexerDbOptions{
+		QueryGuards: idb.QueryGuards{MaxCost: 0.001},
+	}
+	db, err := OpenPostgres(connStr, opts, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.LoadGenesis(test.MakeGenesis()))
+
+	rowsCh, _ := db.Transactions(context.Background(), idb.TransactionFilter{Limit: 10})
+	row, ok := <-rowsCh
+	require.True(t, ok)
+	require.Error(t, row.Error)
+	assert.True(t, errors.Is(row.Error, idb.ErrorQueryTooExpensive), row.Error.Error())
+
+	// Exports are only limited by their own maximum.
+	rowsCh, _ = db.Transactions(context.Background(), idb.TransactionFilter{Limit: 10, Export: true})
+	for row := range rowsCh {
+		assert.NoError(t, row.Error)
+	}
+	db.guards.ExportMaxCost = 0.001
+	rowsCh, _ = db.Transactions(context.Background(), idb.TransactionFilter{Limit: 10, Export: true})
+	row, ok = <-rowsCh
+	require.True(t, ok)
+	assert.True(t, errors.Is(row.Error, idb.ErrorQueryTooExpensive))
+
+	// Queries below the maximum run as usual.
+	db.guards.MaxCost = math.MaxFloat64
+	rowsCh, _ = db.Transactions(context.Background(), idb.TransactionFilter{Limit: 10})
+	for row := range rowsCh {
+		assert.NoError(t, row.Error)
+	}
+}
+
+// TestReadReplicas checks that API queries use the read replicas, and fall
+// back to the primary when a replica is unavailable.
+func TestReadReplicas(t *testing.T) {
+	_, connStr, shutdownFunc := setupPostgres(t)
+	defer shutdownFunc()
+
+	opts := idb.IndexerDbOptions{
+		// The same database stands in for a working replica.
+		ReadReplicas: []string{connStr, "host=127.0.0.1 port=1 user=nobody dbname=none sslmode=disable connect_timeout=1"},
+	}
+	db, err := OpenPostgres(connStr, opts, nil)
+	require.NoError(t, err)
+	require.Len(t, db.replicas, 2)
+	require.NoError(t, db.LoadGenesis(test.MakeGenesis()))
+
+	// Use each replica once.
+	for i := 0; i < 2; i++ {
+		rowsCh, _ := db.Transactions(context.Background(), idb.TransactionFilter{Limit: 10})
+		for row := range rowsCh {
+			assert.NoError(t, row.Error)
+		}
+	}
+}
+
+// TestElectWriter checks that only one of several candidates sharing a
+// database is elected, and that another takes over when the writer stops.
+func TestElectWriter(t *testing.T) {
+	_, connStr, shutdownFunc := setupPostgres(t)
+	defer shutdownFunc()
+
+	opts := idb.IndexerDbOptions{ElectWriter: true}
+	first, err := OpenPostgres(connStr, opts, nil)
+	require.NoError(t, err)
+	second, err := OpenPostgres(connStr, opts, nil)
+	require.NoError(t, err)
+
+	lost, err := first.ElectWriter(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, first.LoadGenesis(test.MakeGenesis()))
+
+	health, err := first.Health()
+	require.NoError(t, err)
+	assert.Equal(t, true, (*health.Data)["writer"])
+
+	// The lock is held, so the second candidate waits.
+	ctx, cancel := context.WithTimeout(context.Background(), 2*writerCheckInterval)
+	defer cancel()
+	_, err = second.ElectWriter(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	health, err = second.Health()
+	require.NoError(t, err)
+	assert.Equal(t, false, (*health.Data)["writer"])
+
+	// The lock is released when the writer's connection dies.
+	_, err = second.db.Exec(`SELECT pg_terminate_backend(pid) FROM pg_locks WHERE locktype = 'advisory' AND pid <> pg_backend_pid()`)
+	require.NoError(t, err)
+	<-lost
+	_, err = second.ElectWriter(context.Background())
+	require.NoError(t, err)
+}
+
+// TestRoundNotifications checks that a read only instance is notified of the
+// rounds committed by the writer.
+func TestRoundNotifications(t *testing.T) {
+	_, connStr, shutdownFunc := setupPostgres(t)
+	defer shutdownFunc()
+
+	writer, err := OpenPostgres(connStr, idb.IndexerDbOptions{}, nil)
+	require.NoError(t, err)
+	require.NoError(t, writer.LoadGenesis(test.MakeGenesis()))
+	reader, err := OpenPostgres(connStr, idb.IndexerDbOptions{ReadOnly: true}, nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rounds := reader.SubscribeRounds(ctx)
+
+	accountTxns(t, writer, test.Round)
+	assert.Equal(t, test.Round, <-rounds)
+
+	round, err := reader.CurrentRound()
+	require.NoError(t, err)
+	assert.Equal(t, test.Round, round)
+}
+
+func TestWebhookQueue(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	_, ok, err := db.WebhookCursor("a")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	deliveries := []idb.WebhookDelivery{
+		{Round: 5, Intra: 0, Payload: []byte("first")},
+		{Round: 5, Intra: 1, Payload: []byte("second")},
+	}
+	require.NoError(t, db.EnqueueWebhooks("a", 5, deliveries))
+	// Enqueuing a round again doesn't duplicate deliveries or move the cursor back.
+	require.NoError(t, db.EnqueueWebhooks("a", 4, deliveries[:1]))
+	require.NoError(t, db.EnqueueWebhooks("b", 6, []idb.WebhookDelivery{{Round: 6, Payload: []byte("other")}}))
+
+	cursor, ok, err := db.WebhookCursor("a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(5), cursor)
+
+	now := time.Now()
+	due, err := db.DueWebhooks([]string{"a"}, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	assert.Equal(t, "a", due[0].Subscription)
+	assert.Equal(t, []byte("first"), due[0].Payload)
+	assert.Equal(t, uint32(1), due[1].Intra)
+
+	require.NoError(t, db.WebhookDelivered(due[0].ID))
+	require.NoError(t, db.WebhookFailed(due[1].ID, now.Add(time.Minute), "receiver returned 500"))
+
+	// Deliveries queued after the failed one wait for its retry.
+	require.NoError(t, db.EnqueueWebhooks("a", 6, []idb.WebhookDelivery{{Round: 6, Payload: []byte("third")}}))
+	due, err = db.DueWebhooks([]string{"a"}, now, 10)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+	due, err = db.DueWebhooks([]string{"b"}, now, 10)
+	require.NoError(t, err)
+	assert.Len(t, due, 1)
+
+	due, err = db.DueWebhooks([]string{"a"}, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	assert.Equal(t, uint64(1), due[0].Attempts)
+	assert.Equal(t, []byte("third"), due[1].Payload)
+}
+
+func TestAccountChanges(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	assetid := uint64(2222)
+	amt := uint64(10000)
+	total := uint64(1000000)
+
+	///////////
+	// Given // AccountA opts in, closes and opts back into an asset, then is rekeyed in the next round.
+	///////////
+	_, createAsset := test.MakeAssetConfigOrPanic(test.Round, 0, assetid, total, uint64(6), false, "icicles", "frozen coin", "http://antarctica.com", test.AccountD)
+	_, fundMain := test.MakeAssetTxnOrPanic(test.Round, assetid, amt, test.AccountD, test.AccountA, sdk_types.ZeroAddress)
+	_, closeMain := test.MakeAssetTxnOrPanic(test.Round, assetid, 1000, test.AccountA, test.AccountB, test.AccountC)
+	_, optinMain := test.MakeAssetTxnOrPanic(test.Round, assetid, 0, test.AccountA, test.AccountA, sdk_types.ZeroAddress)
+	_, payMain := test.MakeAssetTxnOrPanic(test.Round, assetid, amt, test.AccountD, test.AccountA, sdk_types.ZeroAddress)
+
+	cache, err := db.GetDefaultFrozen()
+	require.NoError(t, err)
+	state := getAccounting(test.Round, cache)
+	for _, txn := range []*idb.TxnRow{createAsset, fundMain, closeMain, optinMain, payMain} {
+		require.NoError(t, state.AddTransaction(txn))
+	}
+	require.NoError(t, db.CommitRoundAccounting(state.RoundUpdates, test.Round, &types.BlockHeader{}))
+
+	_, rekey := test.MakePayTxnRowOrPanic(test.Round+1, 1000, 0, 0, 0, 0, 0, test.AccountA,
+		test.AccountA, sdk_types.ZeroAddress, test.AccountB)
+	state = getAccounting(test.Round+1, cache)
+	require.NoError(t, state.AddTransaction(rekey))
+	require.NoError(t, db.CommitRoundAccounting(state.RoundUpdates, test.Round+1, &types.BlockHeader{}))
+
+	//////////
+	// When // We look up the changes of AccountA and AccountC.
+	//////////
+	lookup := func(query idb.AccountChangesQuery) []idb.AccountChangeRow {
+		rows, _ := db.AccountChanges(context.Background(), query)
+		var results []idb.AccountChangeRow
+		for row := range rows {
+			require.NoError(t, row.Error)
+			results = append(results, row)
+		}
+		return results
+	}
+	changes := lookup(idb.AccountChangesQuery{Address: test.AccountA[:]})
+	closeTo := lookup(idb.AccountChangesQuery{Address: test.AccountC[:]})
+
+	//////////
+	// Then // Each round is recorded with the net asset changes, the opt in, the close and the rekey.
+	//////////
+	require.Len(t, changes, 2)
+	assert.Equal(t, test.Round, changes[0].Round)
+	require.Len(t, changes[0].Change.Assets, 1)
+	assert.Equal(t, idb.AccountAssetChange{
+		AssetID:        assetid,
+		AmountIncrease: amt,
+		OptedIn:        true,
+		Closed:         true,
+		CloseTo:        test.AccountC,
+	}, changes[0].Change.Assets[0])
+
+	assert.Equal(t, test.Round+1, changes[1].Round)
+	assert.True(t, changes[1].Change.Rekeyed)
+	assert.Equal(t, test.AccountB, changes[1].Change.AuthAddr)
+
+	require.Len(t, closeTo, 1)
+	assert.Equal(t, []idb.AccountAssetChange{{AssetID: assetid, AmountIncrease: 9000}}, closeTo[0].Change.Assets)
+
+	// Results are paged by round.
+	prev := test.Round
+	changes = lookup(idb.AccountChangesQuery{Address: test.AccountA[:], PrevRound: &prev, Limit: 1})
+	require.Len(t, changes, 1)
+	assert.Equal(t, test.Round+1, changes[0].Round)
+}
+
+func TestAssetBalancesOrderByAmount(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	assetid := uint64(2222)
+	total := uint64(1000000)
+
+	///////////
+	// Given // Three holders, two of them with the same amount.
+	///////////
+	_, createAsset := test.MakeAssetConfigOrPanic(test.Round, 0, assetid, total, uint64(6), false, "icicles", "frozen coin", "http://antarctica.com", test.AccountD)
+	_, payA := test.MakeAssetTxnOrPanic(test.Round, assetid, 100, test.AccountD, test.AccountA, sdk_types.ZeroAddress)
+	_, payB := test.MakeAssetTxnOrPanic(test.Round, assetid, 500, test.AccountD, test.AccountB, sdk_types.ZeroAddress)
+	_, payC := test.MakeAssetTxnOrPanic(test.Round, assetid, 100, test.AccountD, test.AccountC, sdk_types.ZeroAddress)
+
+	cache, err := db.GetDefaultFrozen()
+	require.NoError(t, err)
+	state := getAccounting(test.Round, cache)
+	for _, txn := range []*idb.TxnRow{createAsset, payA, payB, payC} {
+		require.NoError(t, state.AddTransaction(txn))
+	}
+	require.NoError(t, db.CommitRoundAccounting(state.RoundUpdates, test.Round, &types.BlockHeader{}))
+
+	//////////
+	// When // The balances are paged through in amount order.
+	//////////
+	var pages [][]idb.AssetBalanceRow
+	query := idb.AssetBalanceQuery{AssetID: assetid, OrderByAmount: true, Limit: 2}
+	for i := 0; i < 3; i++ {
+		rows, _ := db.AssetBalances(context.Background(), query)
+		var page []idb.AssetBalanceRow
+		for row := range rows {
+			require.NoError(t, row.Error)
+			page = append(page, row)
+		}
+		pages = append(pages, page)
+		if len(page) == 0 {
+			break
+		}
+		last := page[len(page)-1]
+		query.AfterAmount = &idb.AmountCursor{Amount: last.Amount, Address: last.Address}
+	}
+
+	//////////
+	// Then // The largest holding comes first and equal amounts are ordered by address.
+	//////////
+	require.Len(t, pages, 3)
+	require.Len(t, pages[0], 2)
+	assert.Equal(t, total-700, pages[0][0].Amount)
+	assert.Equal(t, test.AccountD[:], pages[0][0].Address)
+	assert.Equal(t, uint64(500), pages[0][1].Amount)
+
+	higher, lower := test.AccountA, test.AccountC
+	if bytes.Compare(higher[:], lower[:]) < 0 {
+		higher, lower = lower, higher
+	}
+	require.Len(t, pages[1], 2)
+	assert.Equal(t, higher[:], pages[1][0].Address)
+	assert.Equal(t, lower[:], pages[1][1].Address)
+	assert.Empty(t, pages[2])
+}
+
+func TestAssetStats(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	assetid := uint64(2222)
+	total := uint64(1000000)
+
+	///////////
+	// Given // Three holders and an opt in, then one holder closes out in the next round.
+	///////////
+	_, createAsset := test.MakeAssetConfigOrPanic(test.Round, 0, assetid, total, uint64(6), false, "icicles", "frozen coin", "http://antarctica.com", test.AccountD)
+	_, payA := test.MakeAssetTxnOrPanic(test.Round, assetid, 100, test.AccountD, test.AccountA, sdk_types.ZeroAddress)
+	_, payB := test.MakeAssetTxnOrPanic(test.Round, assetid, 500, test.AccountD, test.AccountB, sdk_types.ZeroAddress)
+	_, optinC := test.MakeAssetTxnOrPanic(test.Round, assetid, 0, test.AccountC, test.AccountC, sdk_types.ZeroAddress)
+
+	cache, err := db.GetDefaultFrozen()
+	require.NoError(t, err)
+	state := getAccounting(test.Round, cache)
+	for _, txn := range []*idb.TxnRow{createAsset, payA, payB, optinC} {
+		require.NoError(t, state.AddTransaction(txn))
+	}
+	require.NoError(t, db.CommitRoundAccounting(state.RoundUpdates, test.Round, &types.BlockHeader{}))
+
+	_, closeA := test.MakeAssetTxnOrPanic(test.Round+1, assetid, 0, test.AccountA, test.AccountB, test.AccountB)
+	state = getAccounting(test.Round+1, cache)
+	require.NoError(t, state.AddTransaction(closeA))
+	require.NoError(t, db.CommitRoundAccounting(state.RoundUpdates, test.Round+1, &types.BlockHeader{}))
+
+	//////////
+	// When // We look up the stats with and without a round window.
+	//////////
+	stats, _, err := db.AssetStats(context.Background(), idb.AssetStatsQuery{AssetID: assetid})
+	require.NoError(t, err)
+	window, _, err := db.AssetStats(context.Background(), idb.AssetStatsQuery{AssetID: assetid, MinRound: test.Round + 1})
+	require.NoError(t, err)
+	_, _, err = db.AssetStats(context.Background(), idb.AssetStatsQuery{AssetID: assetid + 1})
+
+	//////////
+	// Then // The counts follow the holdings and the supply excludes the reserve, which is the creator.
+	//////////
+	assert.Equal(t, idb.AssetStats{
+		AssetID:           assetid,
+		Holders:           2,
+		OptedIn:           3,
+		Total:             total,
+		CirculatingSupply: 600,
+		Transfers:         2,
+		Volume:            600,
+	}, stats)
+	assert.Equal(t, uint64(0), window.Transfers)
+	assert.Equal(t, uint64(0), window.Volume)
+	assert.Equal(t, idb.ErrorAssetNotFound, err)
+}
+
+func TestTransactionStatsAndNetworkTotals(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	assetid := uint64(2222)
+
+	///////////
+	// Given // Two imported blocks, the second repeats a sender, and an asset created with one opt in.
+	///////////
+	pay1, _ := test.MakePayTxnRowOrPanic(test.Round, 1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	xfer, _ := test.MakeAssetTxnOrPanic(test.Round, assetid, 0, test.AccountC, test.AccountC, sdk_types.ZeroAddress)
+	importTxns(t, db, test.Round, pay1, xfer)
+	pay2, _ := test.MakePayTxnRowOrPanic(test.Round+1, 2000, 10, 0, 0, 0, 0, test.AccountA, test.AccountD, sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	importTxns(t, db, test.Round+1, pay2)
+
+	genesisTotals, _, err := db.NetworkTotals(context.Background())
+	require.NoError(t, err)
+
+	_, createAsset := test.MakeAssetConfigOrPanic(test.Round, 0, assetid, 1000, uint64(6), false, "icicles", "frozen coin", "http://antarctica.com", test.AccountD)
+	_, optinC := test.MakeAssetTxnOrPanic(test.Round, assetid, 0, test.AccountC, test.AccountC, sdk_types.ZeroAddress)
+	accountTxns(t, db, test.Round, createAsset, optinC)
+
+	//////////
+	// When // We look up the daily stats and the totals.
+	//////////
+	var rows []idb.TransactionStatsRow
+	statsCh, _ := db.TransactionStats(context.Background(), idb.TransactionStatsQuery{})
+	for row := range statsCh {
+		require.NoError(t, row.Error)
+		rows = append(rows, row)
+	}
+	totals, _, err := db.NetworkTotals(context.Background())
+	require.NoError(t, err)
+
+	//////////
+	// Then // Both blocks fall on the same day and the totals include the asset and its holdings.
+	//////////
+	require.Len(t, rows, 1)
+	assert.True(t, rows[0].Day.Equal(time.Unix(0, 0)))
+	assert.Equal(t, test.Round, rows[0].MinRound)
+	assert.Equal(t, test.Round+1, rows[0].MaxRound)
+	assert.Equal(t, map[int]uint64{idb.TypeEnumPay: 2, idb.TypeEnumAssetTransfer: 1}, rows[0].Counts)
+	assert.Equal(t, uint64(4000), rows[0].Fees)
+	assert.Equal(t, uint64(4), rows[0].ActiveAddresses)
+
+	assert.Equal(t, idb.NetworkTotals{Accounts: 4}, genesisTotals)
+	assert.Equal(t, uint64(1), totals.Assets)
+	assert.Equal(t, uint64(2), totals.AssetOptIns)
+	assert.Equal(t, uint64(0), totals.Apps)
+}
+
+func TestBackfillTxnStats(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	///////////
+	// Given // A block imported before the statistics were recorded, and one after, repeating a sender.
+	///////////
+	pay1, _ := test.MakePayTxnRowOrPanic(test.Round, 1000, 10, 0, 0, 0, 0, test.AccountA, test.AccountB, sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	xfer, _ := test.MakeAssetTxnOrPanic(test.Round, 2222, 0, test.AccountC, test.AccountC, sdk_types.ZeroAddress)
+	importTxns(t, db, test.Round, pay1, xfer)
+	_, err := db.db.Exec(`DELETE FROM txn_stats; DELETE FROM txn_stats_day; DELETE FROM txn_stats_address`)
+	require.NoError(t, err)
+	pay2, _ := test.MakePayTxnRowOrPanic(test.Round+1, 2000, 10, 0, 0, 0, 0, test.AccountA, test.AccountD, sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	importTxns(t, db, test.Round+1, pay2)
+
+	//////////
+	// When // The earlier block is backfilled.
+	//////////
+	tx, err := db.db.Begin()
+	require.NoError(t, err)
+	for _, cmd := range backfillTxnStats {
+		_, err = tx.Exec(cmd)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tx.Commit())
+
+	var rows []idb.TransactionStatsRow
+	statsCh, _ := db.TransactionStats(context.Background(), idb.TransactionStatsQuery{})
+	for row := range statsCh {
+		require.NoError(t, row.Error)
+		rows = append(rows, row)
+	}
+
+	//////////
+	// Then // The day includes both blocks and the sender is counted once.
+	//////////
+	require.Len(t, rows, 1)
+	assert.Equal(t, test.Round, rows[0].MinRound)
+	assert.Equal(t, test.Round+1, rows[0].MaxRound)
+	assert.Equal(t, map[int]uint64{idb.TypeEnumPay: 2, idb.TypeEnumAssetTransfer: 1}, rows[0].Counts)
+	assert.Equal(t, uint64(4000), rows[0].Fees)
+	assert.Equal(t, uint64(4), rows[0].ActiveAddresses)
+}
+
+func TestStakeTotals(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	///////////
+	// Given // The genesis accounts, then one of them registers online.
+	///////////
+	genesisRound := uint64(0)
+	genesis, _, err := db.StakeTotals(context.Background(), idb.StakeTotalsQuery{})
+	require.NoError(t, err)
+
+	_, keyreg := test.MakeSimpleKeyregOnlineTxn(test.Round, test.AccountA)
+	accountTxns(t, db, test.Round, keyreg)
+
+	//////////
+	// When // We look up the latest totals and those of the genesis.
+	//////////
+	latest, round, err := db.StakeTotals(context.Background(), idb.StakeTotalsQuery{})
+	require.NoError(t, err)
+	before, _, err := db.StakeTotals(context.Background(), idb.StakeTotalsQuery{Round: &genesisRound})
+	require.NoError(t, err)
+
+	//////////
+	// Then // The registered balance moved from offline to online.
+	//////////
+	balance := uint64(1000 * 1000 * 1000 * 1000)
+	assert.Equal(t, idb.StakeTotals{
+		Offline: idb.ParticipationTotals{Money: 4 * balance, RewardUnits: 4 * balance / 1000000, Accounts: 4},
+	}, genesis)
+	assert.Equal(t, genesis, before)
+
+	assert.Equal(t, test.Round, round)
+	assert.Equal(t, test.Round, latest.Round)
+	assert.Equal(t, idb.ParticipationTotals{Money: balance, RewardUnits: balance / 1000000, Accounts: 1}, latest.Online)
+	assert.Equal(t, 3*balance, latest.Offline.Money)
+	assert.Equal(t, idb.ParticipationTotals{}, latest.NotParticipating)
+}
+
+func TestSearchAccountsByParticipation(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	///////////
+	// Given // Two accounts register participation keys which are last valid in different rounds.
+	///////////
+	keyregA, keyregRowA := test.MakeSimpleKeyregOnlineTxn(test.Round, test.AccountA)
+	importTxns(t, db, test.Round, keyregA)
+	accountTxns(t, db, test.Round, keyregRowA)
+	keyregB, keyregRowB := test.MakeSimpleKeyregOnlineTxn(test.Round+1, test.AccountB)
+	importTxns(t, db, test.Round+1, keyregB)
+	accountTxns(t, db, test.Round+1, keyregRowB)
+
+	search := func(opts idb.AccountQueryOptions) []string {
+		rows, _ := db.GetAccounts(context.Background(), opts)
+		var addrs []string
+		for row := range rows {
+			require.NoError(t, row.Error)
+			addrs = append(addrs, row.Account.Address)
+		}
+		return addrs
+	}
+
+	//////////
+	// When // We search by status and key expiry.
+	//////////
+	online := 1
+	onlineAccounts := search(idb.AccountQueryOptions{Status: &online})
+	expiring := search(idb.AccountQueryOptions{Status: &online, VoteLastValidBefore: uint64Ptr(test.Round + 1)})
+	later := search(idb.AccountQueryOptions{VoteLastValidAfter: uint64Ptr(test.Round)})
+
+	//////////
+	// Then // Only the registered accounts match, by the last valid round of their keys.
+	//////////
+	assert.ElementsMatch(t, []string{test.AccountA.String(), test.AccountB.String()}, onlineAccounts)
+	assert.Equal(t, []string{test.AccountA.String()}, expiring)
+	assert.Equal(t, []string{test.AccountB.String()}, later)
+}
+
+func TestAccountMinBalance(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	proto, err := types.Protocol(test.Proto)
+	require.NoError(t, err)
+	assetid := uint64(2222)
+
+	///////////
+	// Given // An asset created by D and held by C.
+	///////////
+	createAsset, createAssetRow := test.MakeAssetConfigOrPanic(test.Round, 0, assetid, 1000, uint64(6), false, "icicles", "frozen coin", "http://antarctica.com", test.AccountD)
+	optinC, optinCRow := test.MakeAssetTxnOrPanic(test.Round, assetid, 0, test.AccountC, test.AccountC, sdk_types.ZeroAddress)
+	importTxns(t, db, test.Round, createAsset, optinC)
+	accountTxns(t, db, test.Round, createAssetRow, optinCRow)
+
+	//////////
+	// When // We look up C and search by min-balance.
+	//////////
+	rows, _ := db.GetAccounts(context.Background(), idb.AccountQueryOptions{EqualToAddress: test.AccountC[:], IncludeAssetHoldings: true})
+	row, ok := <-rows
+	require.True(t, ok)
+	require.NoError(t, row.Error)
+
+	var holders []string
+	rows, _ = db.GetAccounts(context.Background(), idb.AccountQueryOptions{MinBalanceGreaterThan: uint64Ptr(proto.MinBalance), IncludeAssetHoldings: true})
+	for row := range rows {
+		require.NoError(t, row.Error)
+		holders = append(holders, row.Account.Address)
+	}
+
+	//////////
+	// Then // The holding adds to the minimum balance, in the account and in the filter.
+	//////////
+	require.NotNil(t, row.Account.MinBalance)
+	assert.Equal(t, 2*proto.MinBalance, *row.Account.MinBalance)
+	assert.Equal(t, row.Account.Amount-2*proto.MinBalance, *row.Account.AvailableBalance)
+	assert.ElementsMatch(t, []string{test.AccountC.String(), test.AccountD.String()}, holders)
+}
+
+func TestSearchAssetsByParams(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	///////////
+	// Given // Two assets with different roles, URLs and params.
+	///////////
+	createA, createARow := test.MakeAssetConfigOrPanic(test.Round, 0, 1, 1000, uint64(0), false, "a", "asset a", "https://a.example/%x", test.AccountA)
+	createB, createBRow := test.MakeAssetConfigOrPanic(test.Round, 0, 2, 1000, uint64(6), true, "b", "asset b", "https://a.example/xx", test.AccountB)
+	importTxns(t, db, test.Round, createA, createB)
+	accountTxns(t, db, test.Round, createARow, createBRow)
+
+	search := func(filter idb.AssetsQuery) []uint64 {
+		rows, _ := db.Assets(context.Background(), filter)
+		var ids []uint64
+		for row := range rows {
+			require.NoError(t, row.Error)
+			ids = append(ids, row.AssetID)
+		}
+		return ids
+	}
+
+	//////////
+	// When // We search by the asset params.
+	//////////
+	decimals := uint64(0)
+	frozen := true
+	byManager := search(idb.AssetsQuery{Manager: test.AccountA[:]})
+	byClawback := search(idb.AssetsQuery{Clawback: test.AccountB[:]})
+	byPrefix := search(idb.AssetsQuery{URLPrefix: "https://a.example/"})
+	byEscapedPrefix := search(idb.AssetsQuery{URLPrefix: "https://a.example/%"})
+	byDecimals := search(idb.AssetsQuery{Decimals: &decimals})
+	byDefaultFrozen := search(idb.AssetsQuery{DefaultFrozen: &frozen})
+
+	//////////
+	// Then // Only the matching assets are returned, the URL prefix is matched literally.
+	//////////
+	assert.Equal(t, []uint64{1}, byManager)
+	assert.Equal(t, []uint64{2}, byClawback)
+	assert.Equal(t, []uint64{1, 2}, byPrefix)
+	assert.Equal(t, []uint64{1}, byEscapedPrefix)
+	assert.Equal(t, []uint64{1}, byDecimals)
+	assert.Equal(t, []uint64{2}, byDefaultFrozen)
+}
+
+func TestSearchAssetsByQuery(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	///////////
+	// Given // Assets named exactly like the query, starting with it, containing it, and not matching.
+	///////////
+	exact, exactRow := test.MakeAssetConfigOrPanic(test.Round, 0, 1, 1000, uint64(6), false, "X", "usdc", "", test.AccountA)
+	prefix, prefixRow := test.MakeAssetConfigOrPanic(test.Round, 0, 2, 1000, uint64(6), false, "UC", "USDC Coin", "", test.AccountA)
+	contains, containsRow := test.MakeAssetConfigOrPanic(test.Round, 0, 3, 1000, uint64(6), false, "WUSDC", "Wrapped USDC", "", test.AccountB)
+	other, otherRow := test.MakeAssetConfigOrPanic(test.Round, 0, 4, 1000, uint64(6), false, "ALG", "Algo", "", test.AccountB)
+	importTxns(t, db, test.Round, exact, prefix, contains, other)
+	accountTxns(t, db, test.Round, exactRow, prefixRow, containsRow, otherRow)
+
+	search := func(filter idb.AssetsQuery) []idb.AssetRow {
+		rows, _ := db.Assets(context.Background(), filter)
+		var assets []idb.AssetRow
+		for row := range rows {
+			require.NoError(t, row.Error)
+			assets = append(assets, row)
+		}
+		return assets
+	}
+
+	//////////
+	// When // We search by query, and page through the results.
+	//////////
+	all := search(idb.AssetsQuery{Query: "USDC"})
+	first := search(idb.AssetsQuery{Query: "USDC", Limit: 1})
+	require.Len(t, first, 1)
+	second := search(idb.AssetsQuery{Query: "USDC", Limit: 1, PrevScore: first[0].Score, AssetIDGreaterThan: first[0].AssetID})
+
+	//////////
+	// Then // Exact matches come first, then prefix matches, then the others.
+	//////////
+	require.Len(t, all, 3)
+	var ids []uint64
+	for _, asset := range all {
+		ids = append(ids, asset.AssetID)
+		require.NotNil(t, asset.Score)
+	}
+	assert.Equal(t, []uint64{1, 2, 3}, ids)
+	assert.GreaterOrEqual(t, *all[0].Score, 2.0)
+	assert.GreaterOrEqual(t, *all[1].Score, 1.0)
+	assert.Less(t, *all[1].Score, 2.0)
+	assert.Less(t, *all[2].Score, 1.0)
+	require.Len(t, second, 1)
+	assert.Equal(t, uint64(2), second[0].AssetID)
+}
+
+func TestSearchAccountsByKeyTypeAndRounds(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	///////////
+	// Given // A multisig account rekeyed to B, and an account created then closed.
+	///////////
+	newAddr := sdk_types.Address{9}
+	rekey, rekeyRow := test.MakePayTxnRowOrPanic(
+		test.Round, 0, 0, 0, 0, 0, 0, test.AccountA, test.AccountA,
+		sdk_types.ZeroAddress, test.AccountB)
+	rekey.Msig.Subsigs = append(rekey.Msig.Subsigs, sdk_types.MultisigSubsig{})
+	rekeyRow.TxnBytes = msgpack.Encode(rekey)
+	fund, fundRow := test.MakePayTxnRowOrPanic(
+		test.Round, 0, 1000000, 0, 0, 0, 0, test.AccountB, newAddr,
+		sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	importTxns(t, db, test.Round, rekey, fund)
+	accountTxns(t, db, test.Round, rekeyRow, fundRow)
+
+	closeTxn, closeRow := test.MakePayTxnRowOrPanic(
+		test.Round+1, 0, 0, 1000000, 0, 0, 0, newAddr, test.AccountB,
+		test.AccountB, sdk_types.ZeroAddress)
+	importTxns(t, db, test.Round+1, closeTxn)
+	accountTxns(t, db, test.Round+1, closeRow)
+
+	search := func(opts idb.AccountQueryOptions) []string {
+		rows, _ := db.GetAccounts(context.Background(), opts)
+		var addrs []string
+		for row := range rows {
+			require.NoError(t, row.Error)
+			addrs = append(addrs, row.Account.Address)
+		}
+		return addrs
+	}
+
+	//////////
+	// When // We search by signature type, rekey status, and creation and close rounds.
+	//////////
+	rekeyed := true
+	msig := search(idb.AccountQueryOptions{SigType: "msig"})
+	rekeyedAccounts := search(idb.AccountQueryOptions{IsRekeyed: &rekeyed})
+	created := search(idb.AccountQueryOptions{CreatedAfterRound: uint64Ptr(test.Round - 1), IncludeDeleted: true})
+	closed := search(idb.AccountQueryOptions{ClosedAfterRound: uint64Ptr(test.Round), IncludeDeleted: true})
+	closedOpen := search(idb.AccountQueryOptions{ClosedAfterRound: uint64Ptr(test.Round)})
+
+	//////////
+	// Then // Only the matching accounts are returned.
+	//////////
+	assert.Equal(t, []string{test.AccountA.String()}, msig)
+	assert.Equal(t, []string{test.AccountA.String()}, rekeyedAccounts)
+	assert.Equal(t, []string{newAddr.String()}, created)
+	assert.Equal(t, []string{newAddr.String()}, closed)
+	assert.Empty(t, closedOpen)
+}
+
+func TestAccountSigners(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	///////////
+	// Given // A multisig payment from A and a logic sig payment from B.
+	///////////
+	key := func(b byte) []byte {
+		k := make([]byte, 32)
+		k[0] = b
+		return k
+	}
+	msigTxn, msigRow := test.MakePayTxnRowOrPanic(
+		test.Round, 0, 0, 0, 0, 0, 0, test.AccountA, test.AccountA,
+		sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	msigTxn.Msig = sdk_types.MultisigSig{
+		Version:   1,
+		Threshold: 2,
+		Subsigs:   []sdk_types.MultisigSubsig{{Key: key(1)}, {Key: key(2)}},
+	}
+	msigRow.TxnBytes = msgpack.Encode(msigTxn)
+	lsigTxn, lsigRow := test.MakePayTxnRowOrPanic(
+		test.Round, 0, 0, 0, 0, 0, 0, test.AccountB, test.AccountB,
+		sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	lsigTxn.Lsig.Logic = []byte{1, 32, 1, 1, 34}
+	lsigRow.TxnBytes = msgpack.Encode(lsigTxn)
+	importTxns(t, db, test.Round, msigTxn, lsigTxn)
+	accountTxns(t, db, test.Round, msigRow, lsigRow)
+
+	search := func(opts idb.AccountQueryOptions) []idb.AccountRow {
+		rows, _ := db.GetAccounts(context.Background(), opts)
+		var accounts []idb.AccountRow
+		for row := range rows {
+			require.NoError(t, row.Error)
+			accounts = append(accounts, row)
+		}
+		return accounts
+	}
+
+	//////////
+	// When // We search by a multisig key and look up B.
+	//////////
+	byKey := search(idb.AccountQueryOptions{MultisigKey: key(2)})
+	byOtherKey := search(idb.AccountQueryOptions{MultisigKey: key(3)})
+	lsig := search(idb.AccountQueryOptions{EqualToAddress: test.AccountB[:]})
+
+	//////////
+	// Then // The multisig composition and the program are returned with the accounts.
+	//////////
+	require.Len(t, byKey, 1)
+	assert.Equal(t, test.AccountA.String(), byKey[0].Account.Address)
+	require.NotNil(t, byKey[0].Account.Multisig)
+	assert.Equal(t, uint64(1), byKey[0].Account.Multisig.Version)
+	assert.Equal(t, uint64(2), byKey[0].Account.Multisig.Threshold)
+	assert.Equal(t, [][]byte{key(1), key(2)}, byKey[0].Account.Multisig.PublicKeys)
+	assert.Nil(t, byKey[0].Account.Logicsig)
+	assert.Empty(t, byOtherKey)
+
+	require.Len(t, lsig, 1)
+	require.NotNil(t, lsig[0].Account.Logicsig)
+	assert.Equal(t, lsigTxn.Lsig.Logic, lsig[0].Account.Logicsig.Logic)
+	hash := crypto.AddressFromProgram(lsigTxn.Lsig.Logic)
+	assert.Equal(t, hash[:], lsig[0].Account.Logicsig.ProgramHash)
+	assert.Nil(t, lsig[0].Account.Multisig)
+}
+
+func TestAccountRekeys(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	///////////
+	// Given // A rekeyed to B, then to C, then back to itself, then to D, closed, and rekeyed to B, one per round.
+	///////////
+	steps := []struct{ closeTo, authAddr sdk_types.Address }{
+		{authAddr: test.AccountB},
+		{authAddr: test.AccountC},
+		{authAddr: test.AccountA},
+		{authAddr: test.AccountD},
+		{closeTo: test.AccountE},
+		{authAddr: test.AccountB},
+	}
+	txids := make([]string, 0, len(steps))
+	for i, step := range steps {
+		round := test.Round + uint64(i)
+		txn, row := test.MakePayTxnRowOrPanic(
+			round, 0, 0, 0, 0, 0, 0, test.AccountA, test.AccountA,
+			step.closeTo, step.authAddr)
+		importTxns(t, db, round, txn)
+		accountTxns(t, db, round, row)
+		txids = append(txids, crypto.TransactionIDString(txn.Txn))
+	}
+
+	lookup := func(query idb.AccountRekeysQuery) []idb.AccountRekey {
+		rows, _ := db.AccountRekeys(context.Background(), query)
+		var rekeys []idb.AccountRekey
+		for row := range rows {
+			require.NoError(t, row.Error)
+			rekeys = append(rekeys, row.Rekey)
+		}
+		return rekeys
+	}
+	search := func(authAddr sdk_types.Address) []idb.AccountRow {
+		rows, _ := db.GetAccounts(context.Background(), idb.AccountQueryOptions{AuthAddrHistory: authAddr[:], IncludeDeleted: true})
+		var accounts []idb.AccountRow
+		for row := range rows {
+			require.NoError(t, row.Error)
+			accounts = append(accounts, row)
+		}
+		return accounts
+	}
+	expected := []idb.AccountRekey{
+		{Round: test.Round, Intra: 0, Txid: txids[0], NewAuthAddr: test.AccountB[:]},
+		{Round: test.Round + 1, Intra: 0, Txid: txids[1], OldAuthAddr: test.AccountB[:], NewAuthAddr: test.AccountC[:]},
+		{Round: test.Round + 2, Intra: 0, Txid: txids[2], OldAuthAddr: test.AccountC[:]},
+		{Round: test.Round + 3, Intra: 0, Txid: txids[3], NewAuthAddr: test.AccountD[:]},
+		{Round: test.Round + 5, Intra: 0, Txid: txids[5], NewAuthAddr: test.AccountB[:]},
+	}
+
+	//////////
+	// When // We look up the rekeys of A, page them, and search by the authorized addresses.
+	//////////
+	rekeys := lookup(idb.AccountRekeysQuery{Address: test.AccountA[:]})
+	prev := test.Round
+	page := lookup(idb.AccountRekeysQuery{Address: test.AccountA[:], PrevRound: &prev, Limit: 1})
+	byC := search(test.AccountC)
+	byE := search(test.AccountE)
+
+	//////////
+	// Then // Every change of the authorized address is listed in order, the account signed for itself after the close.
+	//////////
+	assert.Equal(t, expected, rekeys)
+	assert.Equal(t, expected[1:2], page)
+	require.Len(t, byC, 1)
+	assert.Equal(t, test.AccountA.String(), byC[0].Account.Address)
+	assert.Empty(t, byE)
+
+	//////////
+	// When // The rekeys are backfilled from the transactions.
+	//////////
+	_, err := db.db.Exec(`DELETE FROM account_rekey`)
+	require.NoError(t, err)
+	_, err = db.db.Exec(backfillAccountRekeys)
+	require.NoError(t, err)
+
+	//////////
+	// Then // The same history is recorded.
+	//////////
+	assert.Equal(t, expected, lookup(idb.AccountRekeysQuery{Address: test.AccountA[:]}))
+}
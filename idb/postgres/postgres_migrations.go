@@ -8,11 +8,14 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
 	sdk_types "github.com/algorand/go-algorand-sdk/types"
 
+	"github.com/algorand/indexer/accounting"
 	"github.com/algorand/indexer/idb"
 	"github.com/algorand/indexer/idb/migration"
 	"github.com/algorand/indexer/idb/postgres/internal/encoding"
+	"github.com/algorand/indexer/types"
 )
 
 func init() {
@@ -42,6 +45,21 @@ func init() {
 		{FixFreezeLookupMigration, false, "Fix search by asset freeze address."},
 		{ClearAccountDataMigration, false, "clear account data for accounts that have been closed"},
 		{MakeDeletedNotNullMigration, false, "make all \"deleted\" columns NOT NULL"},
+		{AddWebhookTablesMigration, false, "add tables for persisting webhook deliveries"},
+		{AddAccountChangeTableMigration, true, "add the account change log table"},
+		{AddAmountOrderIndexesMigration, false, "add indexes for ordering accounts and asset holdings by amount"},
+		{AddAssetStatsTablesMigration, true, "add the asset holder counts and transfer statistics tables"},
+		{AddNetworkStatsTablesMigration, true, "add the transaction statistics and network totals tables"},
+		{AddStakeTotalsTableMigration, true, "add the stake totals table"},
+		{AddParticipationIndexMigration, false, "add an index for searching accounts by participation status and key expiry"},
+		{AddAssetParamsIndexesMigration, false, "add indexes for searching assets by params"},
+		{AddAssetTrigramIndexesMigration, false, "add trigram indexes for searching assets by name when pg_trgm is available"},
+		{AddAccountSearchIndexesMigration, false, "add indexes for searching accounts by signature type, creation round and close round"},
+		{AddAccountSignerTableMigration, true, "add the multisig composition and logic sig program table"},
+		{AddAccountRekeyTableMigration, true, "add the account rekey history table"},
+		{BackfillAccountRekeysMigration, false, "record the rekey history of the transactions imported before upgrading"},
+		{BackfillTxnStatsMigration, false, "add the blocks imported before upgrading to the daily transaction statistics"},
+		{BackfillAccountSignersMigration, false, "record the multisig compositions and logic sig programs of the accounts imported before upgrading"},
 	}
 }
 
@@ -538,3 +556,282 @@ func MakeDeletedNotNullMigration(db *IndexerDb, state *MigrationState) error {
 	}
 	return sqlMigration(db, state, queries)
 }
+
+// AddWebhookTablesMigration adds the webhook_subscription and webhook_delivery
+// tables.
+func AddWebhookTablesMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS webhook_subscription (
+			name text PRIMARY KEY,
+			round bigint NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS webhook_delivery (
+			id bigserial PRIMARY KEY,
+			subscription text NOT NULL,
+			round bigint NOT NULL,
+			intra integer NOT NULL,
+			payload bytea NOT NULL,
+			attempts integer NOT NULL DEFAULT 0,
+			next_attempt timestamp with time zone NOT NULL DEFAULT now(),
+			last_error text,
+			UNIQUE (subscription, round, intra)
+		)`,
+		"CREATE INDEX IF NOT EXISTS webhook_delivery_next_attempt ON webhook_delivery ( next_attempt )",
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// AddAccountChangeTableMigration adds the account_change table. Changes are
+// recorded from the next round imported.
+func AddAccountChangeTableMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS account_change (
+			addr bytea NOT NULL,
+			round bigint NOT NULL,
+			changes jsonb NOT NULL,
+			PRIMARY KEY (addr, round)
+		)`,
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// AddAmountOrderIndexesMigration adds the indexes used when listing the
+// largest accounts and asset holders.
+func AddAmountOrderIndexesMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		"CREATE INDEX IF NOT EXISTS account_by_balance ON account ( microalgos, addr )",
+		"CREATE INDEX IF NOT EXISTS account_asset_by_amount ON account_asset ( assetid, amount, addr )",
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// AddAssetStatsTablesMigration adds the asset_stats and asset_transfer_stats
+// tables. Holder counts are computed from the current holdings, transfers are
+// recorded from the next round imported.
+func AddAssetStatsTablesMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS asset_stats (
+			assetid bigint PRIMARY KEY,
+			holders bigint NOT NULL,
+			optins bigint NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS asset_transfer_stats (
+			assetid bigint NOT NULL,
+			round bigint NOT NULL,
+			transfers bigint NOT NULL,
+			volume numeric NOT NULL,
+			PRIMARY KEY (assetid, round)
+		)`,
+		`INSERT INTO asset_stats (assetid, holders, optins)
+			SELECT assetid, count(*) FILTER (WHERE amount > 0), count(*) FROM account_asset WHERE NOT deleted GROUP BY assetid
+			ON CONFLICT (assetid) DO UPDATE SET holders = EXCLUDED.holders, optins = EXCLUDED.optins`,
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// AddNetworkStatsTablesMigration adds the tables of the daily transaction
+// statistics and the network totals. The totals are counted from the current
+// state, transactions are recorded from the next block imported.
+func AddNetworkStatsTablesMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS txn_stats_day (
+			day date PRIMARY KEY,
+			min_round bigint NOT NULL,
+			max_round bigint NOT NULL,
+			active_addresses bigint NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS txn_stats (
+			day date NOT NULL,
+			typeenum smallint NOT NULL,
+			txns bigint NOT NULL,
+			fees numeric NOT NULL,
+			PRIMARY KEY (day, typeenum)
+		)`,
+		`CREATE TABLE IF NOT EXISTS txn_stats_address (
+			day date NOT NULL,
+			addr bytea NOT NULL,
+			PRIMARY KEY (day, addr)
+		)`,
+		`CREATE TABLE IF NOT EXISTS network_totals (
+			name text PRIMARY KEY,
+			count bigint NOT NULL
+		)`,
+		computeNetworkTotals,
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// AddStakeTotalsTableMigration adds the stake_totals table and records the
+// totals of the latest round accounted.
+func AddStakeTotalsTableMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS stake_totals (
+			round bigint PRIMARY KEY,
+			online bigint NOT NULL,
+			online_reward_units bigint NOT NULL,
+			online_accounts bigint NOT NULL,
+			offline bigint NOT NULL,
+			offline_reward_units bigint NOT NULL,
+			offline_accounts bigint NOT NULL,
+			not_participating bigint NOT NULL,
+			not_participating_reward_units bigint NOT NULL,
+			not_participating_accounts bigint NOT NULL
+		)`,
+		computeStakeTotals,
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// AddParticipationIndexMigration adds the index used when searching for
+// accounts by participation status and participation key expiry.
+func AddParticipationIndexMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		"CREATE INDEX IF NOT EXISTS account_by_participation ON account ( (coalesce((account_data ->> 'onl')::int, 0)), ((account_data ->> 'voteLst')::bigint) )",
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// AddAssetParamsIndexesMigration adds the indexes used when searching for
+// assets by role addresses, metadata hash and URL prefix.
+func AddAssetParamsIndexesMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		"CREATE INDEX IF NOT EXISTS asset_params_gin ON asset USING gin ( params jsonb_path_ops )",
+		"CREATE INDEX IF NOT EXISTS asset_by_url ON asset ( (params ->> 'au') text_pattern_ops )",
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// AddAssetTrigramIndexesMigration adds the trigram indexes used to rank asset
+// searches, the migration succeeds without them when pg_trgm is not available.
+func AddAssetTrigramIndexesMigration(db *IndexerDb, state *MigrationState) error {
+	err := createTrigramIndexes(db)
+	if err != nil {
+		return fmt.Errorf("migration %d, %v", state.NextMigration, err)
+	}
+	return upsertMigrationState(db, state, true)
+}
+
+// AddAccountSearchIndexesMigration adds the indexes used when searching for
+// accounts by signature type, creation round and close round.
+func AddAccountSearchIndexesMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		"CREATE INDEX IF NOT EXISTS account_by_keytype ON account ( keytype )",
+		"CREATE INDEX IF NOT EXISTS account_by_created_at ON account ( created_at )",
+		"CREATE INDEX IF NOT EXISTS account_by_closed_at ON account ( closed_at ) WHERE closed_at IS NOT NULL",
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// AddAccountSignerTableMigration adds the table of the multisig compositions
+// and logic sig programs which accounts sign with.
+func AddAccountSignerTableMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS account_signer (
+			addr bytea PRIMARY KEY,
+			round bigint NOT NULL,
+			msig_version smallint,
+			msig_threshold smallint,
+			msig_keys bytea[],
+			program bytea,
+			program_hash bytea
+		)`,
+		"CREATE INDEX IF NOT EXISTS account_signer_by_msig_key ON account_signer USING gin ( msig_keys )",
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// AddAccountRekeyTableMigration adds the table of the changes to the
+// authorized addresses of accounts.
+func AddAccountRekeyTableMigration(db *IndexerDb, state *MigrationState) error {
+	queries := []string{
+		`CREATE TABLE IF NOT EXISTS account_rekey (
+			addr bytea NOT NULL,
+			round bigint NOT NULL,
+			intra smallint NOT NULL,
+			txid bytea NOT NULL,
+			old_auth bytea,
+			new_auth bytea,
+			PRIMARY KEY (addr, round, intra)
+		)`,
+		"CREATE INDEX IF NOT EXISTS account_rekey_by_new_auth ON account_rekey ( new_auth ) WHERE new_auth IS NOT NULL",
+	}
+	return sqlMigration(db, state, queries)
+}
+
+// BackfillAccountRekeysMigration records the rekeys of the transactions which
+// were accounted before upgrading.
+func BackfillAccountRekeysMigration(db *IndexerDb, state *MigrationState) error {
+	return sqlMigration(db, state, []string{backfillAccountRekeys})
+}
+
+// BackfillTxnStatsMigration adds the transactions imported before
+// AddNetworkStatsTablesMigration to the daily transaction statistics.
+func BackfillTxnStatsMigration(db *IndexerDb, state *MigrationState) error {
+	return sqlMigration(db, state, backfillTxnStats)
+}
+
+// BackfillAccountSignersMigration records the multisig compositions and logic
+// sig programs of the msig and lsig accounts without one, from the latest
+// transaction they signed that way. Signers recorded by the accounting in the
+// meantime are newer and are kept.
+func BackfillAccountSignersMigration(db *IndexerDb, state *MigrationState) error {
+	updateQuery := "INSERT INTO account_signer (addr, round, msig_version, msig_threshold, msig_keys, program, program_hash) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (addr) DO NOTHING"
+	query := `SELECT DISTINCT ON (a.addr) a.addr, a.keytype, t.round, t.txnbytes FROM account a
+JOIN txn_participation p ON p.addr = a.addr
+JOIN txn t ON t.round = p.round AND t.intra = p.intra
+WHERE a.keytype IN ('msig', 'lsig') AND NOT a.deleted AND NOT EXISTS (SELECT 1 FROM account_signer s WHERE s.addr = a.addr)
+AND t.txn -> 'txn' ->> 'snd' = encode(a.addr, 'base64')
+AND CASE WHEN a.keytype = 'msig' THEN t.txn -> 'msig' IS NOT NULL OR t.txn -> 'lsig' -> 'msig' IS NOT NULL
+ELSE t.txn -> 'lsig' IS NOT NULL AND t.txn -> 'lsig' -> 'msig' IS NULL AND t.txn -> 'lsig' -> 'sig' IS NULL END
+ORDER BY a.addr, t.round DESC, t.intra DESC`
+	rows, err := db.db.Query(query)
+	if err != nil {
+		return fmt.Errorf("unable to query account signers: %v", err)
+	}
+	defer rows.Close()
+
+	signers := make([][]interface{}, 0)
+
+	db.log.Print("loop through the msig and lsig accounts")
+	for rows.Next() {
+		var addr, txnbytes []byte
+		var keytype string
+		var round uint64
+		err = rows.Scan(&addr, &keytype, &round, &txnbytes)
+		if err != nil {
+			return fmt.Errorf("error scanning row: %v", err)
+		}
+
+		var stxn types.SignedTxnWithAD
+		err = msgpack.Decode(txnbytes, &stxn)
+		if err != nil {
+			return fmt.Errorf("decoding txn of %s: %v", encoding.Base64(addr), err)
+		}
+		if signer, ok := accounting.MakeAccountSigner(stxn, keytype); ok {
+			signers = append(signers, accountSignerArgs(addr, round, signer))
+		}
+
+		if len(signers) > 5000 {
+			err = updateBatch(db, updateQuery, signers)
+			if err != nil {
+				return fmt.Errorf("updating batch: %v", err)
+			}
+			signers = signers[:0]
+		}
+	}
+
+	if rows.Err() != nil {
+		return fmt.Errorf("error while processing account signers: %v", rows.Err())
+	}
+
+	// Commit any leftovers
+	if len(signers) > 0 {
+		err = updateBatch(db, updateQuery, signers)
+		if err != nil {
+			return fmt.Errorf("updating batch: %v", err)
+		}
+	}
+
+	// Update migration state
+	return upsertMigrationState(db, state, true)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
 	sdk_types "github.com/algorand/go-algorand-sdk/types"
 
 	"github.com/algorand/indexer/idb"
@@ -302,3 +303,53 @@ func TestMakeDeletedNotNullMigration(t *testing.T) {
 		assert.Equal(t, false, *deleted)
 	}
 }
+
+func TestBackfillAccountSignersMigration(t *testing.T) {
+	db, shutdownFunc := setupIdb(t, test.MakeGenesis())
+	defer shutdownFunc()
+
+	///////////
+	// Given // A multisig payment from A, accounted before the signers were recorded.
+	///////////
+	key := func(b byte) []byte {
+		k := make([]byte, 32)
+		k[0] = b
+		return k
+	}
+	msigTxn, msigRow := test.MakePayTxnRowOrPanic(
+		test.Round, 0, 0, 0, 0, 0, 0, test.AccountA, test.AccountA,
+		sdk_types.ZeroAddress, sdk_types.ZeroAddress)
+	msigTxn.Msig = sdk_types.MultisigSig{
+		Version:   1,
+		Threshold: 2,
+		Subsigs:   []sdk_types.MultisigSubsig{{Key: key(1)}, {Key: key(2)}},
+	}
+	msigRow.TxnBytes = msgpack.Encode(msigTxn)
+	importTxns(t, db, test.Round, msigTxn)
+	accountTxns(t, db, test.Round, msigRow)
+	_, err := db.db.Exec("TRUNCATE account_signer")
+	require.NoError(t, err)
+
+	//////////
+	// When // We run the migration.
+	//////////
+	state := MigrationState{NextMigration: 40}
+	err = BackfillAccountSignersMigration(db, &state)
+	require.NoError(t, err)
+
+	//////////
+	// Then // The multisig composition is recorded from the payment.
+	//////////
+	assert.Equal(t, 41, state.NextMigration)
+	rows, _ := db.GetAccounts(context.Background(), idb.AccountQueryOptions{EqualToAddress: test.AccountA[:]})
+	var accounts []idb.AccountRow
+	for row := range rows {
+		require.NoError(t, row.Error)
+		accounts = append(accounts, row)
+	}
+	require.Len(t, accounts, 1)
+	require.NotNil(t, accounts[0].Account.Multisig)
+	assert.Equal(t, uint64(2), accounts[0].Account.Multisig.Threshold)
+	assert.Equal(t, [][]byte{key(1), key(2)}, accounts[0].Account.Multisig.PublicKeys)
+	assert.Equal(t, int(test.Round), queryInt(db.db, "SELECT round FROM account_signer WHERE addr = $1", test.AccountA[:]))
+}
@@ -1,11 +1,14 @@
 package postgres
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/algorand/indexer/idb"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -113,3 +116,50 @@ func TestTealKeyValue(t *testing.T) {
 	_, ok = tkv.get(k2)
 	a.False(ok)
 }
+
+func TestQueryError(t *testing.T) {
+	db := &IndexerDb{}
+	timeoutErr := &pq.Error{Code: "57014", Message: "canceling statement due to statement timeout"}
+	otherErr := errors.New("other")
+
+	assert.NoError(t, db.queryError(context.Background(), idb.QueryTransactions, nil))
+	assert.True(t, errors.Is(db.queryError(context.Background(), idb.QueryTransactions, timeoutErr), idb.ErrorQueryTimeout))
+	assert.Equal(t, otherErr, db.queryError(context.Background(), idb.QueryTransactions, otherErr))
+
+	// Queries cancelled by the client are not timeouts.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := db.queryError(ctx, idb.QueryTransactions, timeoutErr)
+	assert.False(t, errors.Is(err, idb.ErrorQueryTimeout))
+}
+
+func TestElectWriterNotCandidate(t *testing.T) {
+	db := &IndexerDb{}
+	_, err := db.ElectWriter(context.Background())
+	assert.Error(t, err)
+}
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, "https://", escapeLike("https://"))
+	assert.Equal(t, `100\%\_a\\b`, escapeLike(`100%_a\b`))
+}
+
+func TestBuildAssetQuerySearch(t *testing.T) {
+	score := 1.5
+	filter := idb.AssetsQuery{Query: "usd_", PrevScore: &score, AssetIDGreaterThan: 10, Limit: 5}
+
+	query, args := buildAssetQuery(filter, true)
+	assert.Contains(t, query, "similarity(")
+	assert.Contains(t, query, "ORDER BY score DESC, index ASC LIMIT 5")
+	assert.NotContains(t, query, "a.index > $1")
+	assert.Equal(t, []interface{}{"usd_", `usd\_%`, `%usd\_%`, score, uint64(10)}, args)
+
+	query, _ = buildAssetQuery(filter, false)
+	assert.NotContains(t, query, "similarity(")
+	assert.NotContains(t, query, " % ")
+
+	query, args = buildAssetQuery(idb.AssetsQuery{AssetIDGreaterThan: 10}, false)
+	assert.Contains(t, query, "NULL::numeric AS score")
+	assert.Contains(t, query, "ORDER BY index ASC")
+	assert.Equal(t, []interface{}{uint64(10)}, args)
+}
@@ -0,0 +1,106 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/algorand/indexer/idb"
+)
+
+// cancelledQueries counts the API queries which did not run to completion.
+var cancelledQueries = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: "indexer_db",
+		Name:      "cancelled_queries_total",
+		Help:      "API queries which were cancelled by query kind and reason (timeout, cost, client).",
+	},
+	[]string{"query", "reason"})
+
+// guardedQuery runs an API query in tx. The statement timeout of the kind of
+// query is applied first, and the query is rejected without running it if the
+// planner estimates that it costs more than the maximum. Exports have their own
+// maximum since they are expected to scan much more than a search.
+func (db *IndexerDb) guardedQuery(ctx context.Context, tx *sql.Tx, kind string, query string, args ...interface{}) (*sql.Rows, error) {
+	if timeout := db.guards.Timeouts[kind]; timeout > 0 {
+		_, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", timeout.Milliseconds()))
+		if err != nil {
+			return nil, fmt.Errorf("setting statement timeout, %v", err)
+		}
+	}
+
+	maxCost := db.guards.MaxCost
+	if kind == idb.QueryExport {
+		maxCost = db.guards.ExportMaxCost
+	}
+	if maxCost > 0 {
+		cost, err := explainCost(ctx, tx, query, args...)
+		if err != nil {
+			return nil, db.queryError(ctx, kind, err)
+		}
+		if cost > maxCost {
+			cancelledQueries.WithLabelValues(kind, "cost").Inc()
+			return nil, fmt.Errorf("%w: the estimated cost %.0f is above the maximum %.0f, try adding filters such as a round range",
+				idb.ErrorQueryTooExpensive, cost, maxCost)
+		}
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	return rows, db.queryError(ctx, kind, err)
+}
+
+// explainCost returns the total cost of the query plan estimated by postgres.
+func explainCost(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (float64, error) {
+	var planJSON []byte
+	err := tx.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+query, args...).Scan(&planJSON)
+	if err != nil {
+		return 0, fmt.Errorf("explain query, %w", err)
+	}
+
+	var plans []struct {
+		Plan struct {
+			TotalCost float64 `json:"Total Cost"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(planJSON, &plans); err != nil {
+		return 0, fmt.Errorf("parsing query plan, %v", err)
+	}
+	if len(plans) == 0 {
+		return 0, fmt.Errorf("empty query plan")
+	}
+	return plans[0].Plan.TotalCost, nil
+}
+
+// queryError counts cancelled queries, and wraps statement timeouts with
+// idb.ErrorQueryTimeout. Postgres reports cancellation by the client and by
+// the statement timeout with the same code, so the context tells them apart.
+func (db *IndexerDb) queryError(ctx context.Context, kind string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		cancelledQueries.WithLabelValues(kind, "client").Inc()
+		return err
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "query_canceled" {
+		cancelledQueries.WithLabelValues(kind, "timeout").Inc()
+		return fmt.Errorf("%w: %v", idb.ErrorQueryTimeout, err)
+	}
+	return err
+}
+
+// transactionQueryKind returns the kind of query of a transaction filter.
+func transactionQueryKind(tf idb.TransactionFilter) string {
+	if tf.Export {
+		return idb.QueryExport
+	}
+	return idb.QueryTransactions
+}
@@ -0,0 +1,34 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync/atomic"
+)
+
+// beginReadTx begins a read only transaction for an API query. Queries are
+// spread over the read replicas in turn, falling back to the primary when
+// there are no replicas or the replica is unavailable.
+//
+// Everything the query needs, including the max round accounted which is
+// reported as the current round, must be read in this transaction so that the
+// result is consistent with the replica's replication lag.
+func (db *IndexerDb) beginReadTx(ctx context.Context) (*sql.Tx, error) {
+	if len(db.replicas) == 0 {
+		return db.db.BeginTx(ctx, &readonlyRepeatableRead)
+	}
+
+	i := atomic.AddUint32(&db.nextReplica, 1) % uint32(len(db.replicas))
+	tx, err := db.replicas[i].BeginTx(ctx, &readonlyRepeatableRead)
+	if err == nil {
+		return tx, nil
+	}
+	if ctx.Err() != nil {
+		return nil, err
+	}
+
+	db.log.WithError(err).Warnf("read replica %d unavailable, using the primary", i)
+	return db.db.BeginTx(ctx, &readonlyRepeatableRead)
+}
@@ -1,7 +1,14 @@
 DROP TABLE IF EXISTS account;
 DROP TABLE IF EXISTS account_app;
 DROP TABLE IF EXISTS account_asset;
+DROP TABLE IF EXISTS account_change;
+DROP TABLE IF EXISTS account_rekey;
+DROP TABLE IF EXISTS account_signer;
 DROP TABLE IF EXISTS app;
 DROP TABLE IF EXISTS asset;
+DROP TABLE IF EXISTS asset_stats;
+DROP TABLE IF EXISTS asset_transfer_stats;
 DROP TABLE IF EXISTS metastate;
+DROP TABLE IF EXISTS network_totals;
+DROP TABLE IF EXISTS stake_totals;
 UPDATE txn SET extra = NULL WHERE extra IS NOT NULL;
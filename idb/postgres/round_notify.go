@@ -0,0 +1,119 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/algorand/indexer/util"
+)
+
+// roundChannel is notified with the round number when a round is committed.
+const roundChannel = "indexer_round_committed"
+
+// roundNotifier broadcasts committed rounds, and whether they are received from
+// every process sharing the database.
+type roundNotifier struct {
+	*util.RoundBroadcaster
+
+	// listening is set once notifications are received from every process,
+	// until then the latest round may be stale.
+	mu         sync.Mutex
+	listening  bool
+	listenOnce sync.Once
+}
+
+func makeRoundNotifier() *roundNotifier {
+	return &roundNotifier{
+		RoundBroadcaster: util.MakeRoundBroadcaster(),
+	}
+}
+
+// latest returns the latest committed round, if it is known to be current.
+func (n *roundNotifier) latest() (uint64, bool) {
+	n.mu.Lock()
+	listening := n.listening
+	n.mu.Unlock()
+
+	round, ok := n.Latest()
+	return round, ok && listening
+}
+
+func (n *roundNotifier) setListening() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.listening = true
+}
+
+// CurrentRound is part of idb.RoundNotifier.
+func (db *IndexerDb) CurrentRound() (uint64, error) {
+	db.listenRounds()
+	if round, ok := db.rounds.latest(); ok {
+		return round, nil
+	}
+
+	round, err := db.GetMaxRoundAccounted()
+	if err != nil {
+		return 0, err
+	}
+	db.rounds.Publish(round)
+	return round, nil
+}
+
+// SubscribeRounds is part of idb.RoundNotifier.
+func (db *IndexerDb) SubscribeRounds(ctx context.Context) <-chan uint64 {
+	db.listenRounds()
+	return db.rounds.SubscribeRounds(ctx)
+}
+
+// listenRounds starts listening for rounds committed by any process sharing
+// the database, the first time it is called. Without a listener only rounds
+// committed by this process are published, and CurrentRound always queries
+// the database.
+func (db *IndexerDb) listenRounds() {
+	if db.connection == "" {
+		return
+	}
+
+	db.rounds.listenOnce.Do(func() {
+		logEvent := func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				db.log.WithError(err).Warnf("round listener event %d", event)
+			}
+		}
+		listener := pq.NewListener(db.connection, time.Second, time.Minute, logEvent)
+		if err := listener.Listen(roundChannel); err != nil {
+			db.log.WithError(err).Error("unable to listen for round notifications")
+			listener.Close()
+			return
+		}
+		db.rounds.setListening()
+		go db.receiveRounds(listener)
+	})
+}
+
+// receiveRounds publishes the rounds received by the listener.
+func (db *IndexerDb) receiveRounds(listener *pq.Listener) {
+	for notification := range listener.Notify {
+		// A nil notification means the connection was re-established, and
+		// notifications may have been missed meanwhile.
+		if notification == nil {
+			if round, err := db.GetMaxRoundAccounted(); err == nil {
+				db.rounds.Publish(round)
+			}
+			continue
+		}
+
+		round, err := strconv.ParseUint(notification.Extra, 10, 64)
+		if err != nil {
+			db.log.WithError(err).Warnf("invalid round notification '%s'", notification.Extra)
+			continue
+		}
+		db.rounds.Publish(round)
+	}
+}
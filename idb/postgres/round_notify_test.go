@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundNotifierListening(t *testing.T) {
+	n := makeRoundNotifier()
+	n.Publish(5)
+
+	// Without a listener the round published by this process may be stale.
+	_, ok := n.latest()
+	assert.False(t, ok)
+
+	n.setListening()
+	round, ok := n.latest()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(5), round)
+}
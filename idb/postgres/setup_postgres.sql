@@ -53,6 +53,17 @@ CREATE TABLE IF NOT EXISTS account (
   account_data jsonb -- trimmed AccountData that only contains auth addr and keyreg info
 );
 
+-- For listing the accounts with the largest balances
+CREATE INDEX IF NOT EXISTS account_by_balance ON account ( microalgos, addr );
+
+-- For searching accounts by participation status and participation key expiry
+CREATE INDEX IF NOT EXISTS account_by_participation ON account ( (coalesce((account_data ->> 'onl')::int, 0)), ((account_data ->> 'voteLst')::bigint) );
+
+-- For searching accounts by signature type, creation round and close round
+CREATE INDEX IF NOT EXISTS account_by_keytype ON account ( keytype );
+CREATE INDEX IF NOT EXISTS account_by_created_at ON account ( created_at );
+CREATE INDEX IF NOT EXISTS account_by_closed_at ON account ( closed_at ) WHERE closed_at IS NOT NULL;
+
 -- data.basics.AccountData Assets[asset id] AssetHolding{}
 CREATE TABLE IF NOT EXISTS account_asset (
   addr bytea NOT NULL, -- [32]byte
@@ -68,6 +79,9 @@ CREATE TABLE IF NOT EXISTS account_asset (
 -- For account lookup
 CREATE INDEX IF NOT EXISTS account_asset_by_addr ON account_asset ( addr );
 
+-- For listing the largest holders of an asset
+CREATE INDEX IF NOT EXISTS account_asset_by_amount ON account_asset ( assetid, amount, addr );
+
 -- Optional, to make queries of all asset balances fast /v2/assets/<assetid>/balances
 -- CREATE INDEX CONCURRENTLY IF NOT EXISTS account_asset_asset ON account_asset (assetid, addr ASC);
 
@@ -75,16 +89,21 @@ CREATE INDEX IF NOT EXISTS account_asset_by_addr ON account_asset ( addr );
 CREATE TABLE IF NOT EXISTS asset (
   index bigint PRIMARY KEY,
   creator_addr bytea NOT NULL,
-  params jsonb NOT NULL, -- data.basics.AssetParams -- TODO index some fields?
+  params jsonb NOT NULL, -- data.basics.AssetParams
   deleted bool NOT NULL, -- whether or not it is currently deleted
   created_at bigint NOT NULL DEFAULT 0, -- round that the asset was created
   closed_at bigint -- round that the asset was closed; cannot be recreated because the index is unique
 );
 
+-- For searching assets by role addresses, metadata hash and URL prefix
+CREATE INDEX IF NOT EXISTS asset_params_gin ON asset USING gin ( params jsonb_path_ops );
+CREATE INDEX IF NOT EXISTS asset_by_url ON asset ( (params ->> 'au') text_pattern_ops );
+-- The trigram indexes of the asset name and unit are created when pg_trgm is available, see createTrigramIndexes.
+
 -- For account lookup
 CREATE INDEX IF NOT EXISTS asset_by_creator_addr ON asset ( creator_addr );
 
--- subsumes ledger/accountdb.go accounttotals and acctrounds
+-- subsumes ledger/accountdb.go acctrounds, the accounttotals are in stake_totals
 -- "state":{online, onlinerewardunits, offline, offlinerewardunits, notparticipating, notparticipatingrewardunits, rewardslevel, round bigint}
 CREATE TABLE IF NOT EXISTS metastate (
   k text primary key,
@@ -118,3 +137,119 @@ CREATE TABLE IF NOT EXISTS account_app (
 
 -- For account lookup
 CREATE INDEX IF NOT EXISTS account_app_by_addr ON account_app ( addr );
+
+-- webhook subscriptions and the last round queued for each of them
+CREATE TABLE IF NOT EXISTS webhook_subscription (
+  name text PRIMARY KEY,
+  round bigint NOT NULL
+);
+
+-- webhook notifications waiting to be delivered
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+  id bigserial PRIMARY KEY,
+  subscription text NOT NULL,
+  round bigint NOT NULL,
+  intra integer NOT NULL,
+  payload bytea NOT NULL,
+  attempts integer NOT NULL DEFAULT 0,
+  next_attempt timestamp with time zone NOT NULL DEFAULT now(),
+  last_error text,
+  UNIQUE (subscription, round, intra)
+);
+
+-- For finding the deliveries which are due
+CREATE INDEX IF NOT EXISTS webhook_delivery_next_attempt ON webhook_delivery ( next_attempt );
+
+-- append only log of what each round changed in each account
+CREATE TABLE IF NOT EXISTS account_change (
+  addr bytea NOT NULL,
+  round bigint NOT NULL,
+  changes jsonb NOT NULL,
+  PRIMARY KEY (addr, round)
+);
+
+-- number of accounts holding and opted in to each asset, maintained with the accounting
+CREATE TABLE IF NOT EXISTS asset_stats (
+  assetid bigint PRIMARY KEY,
+  holders bigint NOT NULL,
+  optins bigint NOT NULL
+);
+
+-- number and total amount of each asset's transfers per round
+CREATE TABLE IF NOT EXISTS asset_transfer_stats (
+  assetid bigint NOT NULL,
+  round bigint NOT NULL,
+  transfers bigint NOT NULL,
+  volume numeric NOT NULL, -- may exceed a uint64
+  PRIMARY KEY (assetid, round)
+);
+
+-- transactions imported on each UTC day, by block time
+CREATE TABLE IF NOT EXISTS txn_stats_day (
+  day date PRIMARY KEY,
+  min_round bigint NOT NULL,
+  max_round bigint NOT NULL,
+  active_addresses bigint NOT NULL
+);
+
+-- number and fees of the transactions of each type on each day
+CREATE TABLE IF NOT EXISTS txn_stats (
+  day date NOT NULL,
+  typeenum smallint NOT NULL,
+  txns bigint NOT NULL,
+  fees numeric NOT NULL,
+  PRIMARY KEY (day, typeenum)
+);
+
+-- addresses taking part in a transaction on each day, for counting active addresses
+CREATE TABLE IF NOT EXISTS txn_stats_address (
+  day date NOT NULL,
+  addr bytea NOT NULL,
+  PRIMARY KEY (day, addr)
+);
+
+-- number of accounts, assets, apps and opt ins which are not deleted, maintained with the accounting
+CREATE TABLE IF NOT EXISTS network_totals (
+  name text PRIMARY KEY,
+  count bigint NOT NULL
+);
+
+-- ledger/accountdb.go accounttotals of the non-deleted accounts by participation status, a row is
+-- written for each round which changes them, maintained with the accounting
+CREATE TABLE IF NOT EXISTS stake_totals (
+  round bigint PRIMARY KEY,
+  online bigint NOT NULL,
+  online_reward_units bigint NOT NULL,
+  online_accounts bigint NOT NULL,
+  offline bigint NOT NULL,
+  offline_reward_units bigint NOT NULL,
+  offline_accounts bigint NOT NULL,
+  not_participating bigint NOT NULL,
+  not_participating_reward_units bigint NOT NULL,
+  not_participating_accounts bigint NOT NULL
+);
+
+-- The latest multisig composition or logic sig program of msig and lsig accounts, recorded when
+-- the signature type of the account changes, maintained with the accounting
+CREATE TABLE IF NOT EXISTS account_signer (
+  addr bytea PRIMARY KEY,
+  round bigint NOT NULL, -- latest round that the composition or program changed
+  msig_version smallint,
+  msig_threshold smallint,
+  msig_keys bytea[], -- subsignature public keys
+  program bytea,
+  program_hash bytea
+);
+CREATE INDEX IF NOT EXISTS account_signer_by_msig_key ON account_signer USING gin ( msig_keys );
+
+-- Every change of the authorized address of an account, maintained with the accounting
+CREATE TABLE IF NOT EXISTS account_rekey (
+  addr bytea NOT NULL,
+  round bigint NOT NULL,
+  intra smallint NOT NULL,
+  txid bytea NOT NULL, -- base32 of [32]byte hash
+  old_auth bytea, -- NULL when the account signed for itself
+  new_auth bytea, -- NULL when the account is rekeyed back to itself
+  PRIMARY KEY (addr, round, intra)
+);
+CREATE INDEX IF NOT EXISTS account_rekey_by_new_auth ON account_rekey ( new_auth ) WHERE new_auth IS NOT NULL;
@@ -0,0 +1,160 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/algorand/indexer/idb"
+)
+
+// Participation status of account_data "onl", go-algorand basics.Status.
+const (
+	statusOffline          = 0
+	statusOnline           = 1
+	statusNotParticipating = 2
+)
+
+// computeStakeTotals records the stake totals of the accounts as of the
+// latest round accounted. The reward units of an account are the whole Algos
+// of its balance.
+const computeStakeTotals = `INSERT INTO stake_totals (round, online, online_reward_units, online_accounts, offline, offline_reward_units, offline_accounts, not_participating, not_participating_reward_units, not_participating_accounts)
+SELECT coalesce((SELECT (v->>'account_round')::bigint FROM metastate WHERE k = 'state'), 0),
+coalesce(sum(microalgos) FILTER (WHERE status = 1), 0), coalesce(sum(microalgos / 1000000) FILTER (WHERE status = 1), 0), count(*) FILTER (WHERE status = 1),
+coalesce(sum(microalgos) FILTER (WHERE status = 0), 0), coalesce(sum(microalgos / 1000000) FILTER (WHERE status = 0), 0), count(*) FILTER (WHERE status = 0),
+coalesce(sum(microalgos) FILTER (WHERE status = 2), 0), coalesce(sum(microalgos / 1000000) FILTER (WHERE status = 2), 0), count(*) FILTER (WHERE status = 2)
+FROM (SELECT microalgos, coalesce((account_data->>'onl')::int, 0) AS status FROM account WHERE NOT deleted) a
+ON CONFLICT (round) DO UPDATE SET online = EXCLUDED.online, online_reward_units = EXCLUDED.online_reward_units, online_accounts = EXCLUDED.online_accounts, offline = EXCLUDED.offline, offline_reward_units = EXCLUDED.offline_reward_units, offline_accounts = EXCLUDED.offline_accounts, not_participating = EXCLUDED.not_participating, not_participating_reward_units = EXCLUDED.not_participating_reward_units, not_participating_accounts = EXCLUDED.not_participating_accounts`
+
+type stakeSum struct {
+	money       int64
+	rewardUnits int64
+	accounts    int64
+}
+
+// stakeSums are indexed by participation status.
+type stakeSums [3]stakeSum
+
+func (s stakeSums) sub(other stakeSums) stakeSums {
+	var delta stakeSums
+	for i := range s {
+		delta[i] = stakeSum{
+			money:       s[i].money - other[i].money,
+			rewardUnits: s[i].rewardUnits - other[i].rewardUnits,
+			accounts:    s[i].accounts - other[i].accounts,
+		}
+	}
+	return delta
+}
+
+func (s stakeSums) isZero() bool {
+	return s == stakeSums{}
+}
+
+// makeStakeAddrs returns the accounts whose balance or participation status a
+// round may change. The stake totals are maintained by summing their stake
+// before and after the round is applied.
+func makeStakeAddrs(updates *idb.RoundUpdates) [][]byte {
+	seen := make(map[[32]byte]bool, len(updates.AlgoUpdates)+len(updates.AccountDataUpdates))
+	addrs := make([][]byte, 0, len(seen))
+	add := func(addr [32]byte) {
+		if !seen[addr] {
+			seen[addr] = true
+			addrs = append(addrs, addr[:])
+		}
+	}
+	for addr := range updates.AlgoUpdates {
+		add(addr)
+	}
+	for addr := range updates.AccountDataUpdates {
+		add(addr)
+	}
+	return addrs
+}
+
+func sumStake(tx *sql.Tx, addrs [][]byte) (stakeSums, error) {
+	var sums stakeSums
+	if len(addrs) == 0 {
+		return sums, nil
+	}
+	rows, err := tx.Query(`SELECT coalesce((account_data->>'onl')::int, 0), coalesce(sum(microalgos), 0), coalesce(sum(microalgos / 1000000), 0), count(*) FROM account WHERE addr = ANY($1) AND NOT deleted GROUP BY 1`, pq.Array(addrs))
+	if err != nil {
+		return sums, fmt.Errorf("sum stake, %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var status int
+		var sum stakeSum
+		err = rows.Scan(&status, &sum.money, &sum.rewardUnits, &sum.accounts)
+		if err != nil {
+			return sums, fmt.Errorf("sum stake, %v", err)
+		}
+		if status >= 0 && status < len(sums) {
+			sums[status] = sum
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return sums, fmt.Errorf("sum stake, %v", err)
+	}
+	return sums, nil
+}
+
+// writeStakeTotals records the stake totals of a round when the difference
+// between the stake before and after the round is not zero.
+func writeStakeTotals(tx *sql.Tx, round uint64, before, after stakeSums) error {
+	delta := after.sub(before)
+	if delta.isZero() {
+		return nil
+	}
+	online, offline, notParticipating := delta[statusOnline], delta[statusOffline], delta[statusNotParticipating]
+	_, err := tx.Exec(`INSERT INTO stake_totals (round, online, online_reward_units, online_accounts, offline, offline_reward_units, offline_accounts, not_participating, not_participating_reward_units, not_participating_accounts)
+SELECT $1, online + $2, online_reward_units + $3, online_accounts + $4, offline + $5, offline_reward_units + $6, offline_accounts + $7, not_participating + $8, not_participating_reward_units + $9, not_participating_accounts + $10
+FROM (SELECT * FROM stake_totals WHERE round <= $1 ORDER BY round DESC LIMIT 1) t
+ON CONFLICT (round) DO UPDATE SET online = EXCLUDED.online, online_reward_units = EXCLUDED.online_reward_units, online_accounts = EXCLUDED.online_accounts, offline = EXCLUDED.offline, offline_reward_units = EXCLUDED.offline_reward_units, offline_accounts = EXCLUDED.offline_accounts, not_participating = EXCLUDED.not_participating, not_participating_reward_units = EXCLUDED.not_participating_reward_units, not_participating_accounts = EXCLUDED.not_participating_accounts`,
+		round,
+		online.money, online.rewardUnits, online.accounts,
+		offline.money, offline.rewardUnits, offline.accounts,
+		notParticipating.money, notParticipating.rewardUnits, notParticipating.accounts)
+	if err != nil {
+		return fmt.Errorf("stake totals, %v", err)
+	}
+	return nil
+}
+
+// StakeTotals is part of idb.IndexerDB
+func (db *IndexerDb) StakeTotals(ctx context.Context, query idb.StakeTotalsQuery) (idb.StakeTotals, uint64, error) {
+	tx, err := db.beginReadTx(ctx)
+	if err != nil {
+		return idb.StakeTotals{}, 0, err
+	}
+	defer tx.Rollback()
+
+	round, err := db.getMaxRoundAccounted(tx)
+	if err != nil {
+		return idb.StakeTotals{}, round, err
+	}
+
+	// The totals of a round are those of the last round which changed them.
+	totalsRound := round
+	if query.Round != nil && *query.Round < round {
+		totalsRound = *query.Round
+	}
+
+	var totals idb.StakeTotals
+	row := tx.QueryRowContext(ctx, `SELECT round, online, online_reward_units, online_accounts, offline, offline_reward_units, offline_accounts, not_participating, not_participating_reward_units, not_participating_accounts FROM stake_totals WHERE round <= $1 ORDER BY round DESC LIMIT 1`, totalsRound)
+	err = row.Scan(&totals.Round,
+		&totals.Online.Money, &totals.Online.RewardUnits, &totals.Online.Accounts,
+		&totals.Offline.Money, &totals.Offline.RewardUnits, &totals.Offline.Accounts,
+		&totals.NotParticipating.Money, &totals.NotParticipating.RewardUnits, &totals.NotParticipating.Accounts)
+	if err == sql.ErrNoRows {
+		return idb.StakeTotals{}, round, idb.ErrorStakeTotalsNotFound
+	}
+	if err != nil {
+		return idb.StakeTotals{}, round, db.queryError(ctx, idb.QueryAccounts, err)
+	}
+	return totals, round, nil
+}
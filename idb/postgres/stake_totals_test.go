@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/util/test"
+)
+
+func TestMakeStakeAddrs(t *testing.T) {
+	var updates idb.RoundUpdates
+	updates.Clear()
+	updates.AlgoUpdates[test.AccountA] = &idb.AlgoUpdate{Balance: 1}
+	updates.AccountDataUpdates[test.AccountA] = map[string]idb.AccountDataUpdate{"onl": {Value: 1}}
+	updates.AccountDataUpdates[test.AccountB] = map[string]idb.AccountDataUpdate{"onl": {Value: 0}}
+
+	assert.ElementsMatch(t, [][]byte{test.AccountA[:], test.AccountB[:]}, makeStakeAddrs(&updates))
+}
+
+func TestStakeSumsSub(t *testing.T) {
+	before := stakeSums{
+		statusOffline: {money: 5000000, rewardUnits: 5, accounts: 1},
+	}
+	after := stakeSums{
+		statusOffline: {money: 0, rewardUnits: 0, accounts: 0},
+		statusOnline:  {money: 4999000, rewardUnits: 4, accounts: 1},
+	}
+
+	delta := after.sub(before)
+	assert.Equal(t, stakeSums{
+		statusOnline:  {money: 4999000, rewardUnits: 4, accounts: 1},
+		statusOffline: {money: -5000000, rewardUnits: -5, accounts: -1},
+	}, delta)
+	assert.False(t, delta.isZero())
+	assert.True(t, after.sub(after).isZero())
+}
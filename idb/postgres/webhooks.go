@@ -0,0 +1,114 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/algorand/indexer/idb"
+)
+
+// WebhookCursor is part of idb.WebhookQueue.
+func (db *IndexerDb) WebhookCursor(subscription string) (uint64, bool, error) {
+	var round uint64
+	err := db.db.QueryRow("SELECT round FROM webhook_subscription WHERE name = $1", subscription).Scan(&round)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("WebhookCursor() err: %w", err)
+	}
+	return round, true, nil
+}
+
+// EnqueueWebhooks is part of idb.WebhookQueue.
+func (db *IndexerDb) EnqueueWebhooks(subscription string, round uint64, deliveries []idb.WebhookDelivery) error {
+	f := func(ctx context.Context, tx *sql.Tx) error {
+		defer tx.Rollback()
+
+		insert, err := tx.PrepareContext(ctx,
+			`INSERT INTO webhook_delivery (subscription, round, intra, payload) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (subscription, round, intra) DO NOTHING`)
+		if err != nil {
+			return fmt.Errorf("prepare insert, %w", err)
+		}
+		defer insert.Close()
+
+		for _, delivery := range deliveries {
+			_, err := insert.ExecContext(ctx, subscription, delivery.Round, delivery.Intra, delivery.Payload)
+			if err != nil {
+				return fmt.Errorf("insert delivery, %w", err)
+			}
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO webhook_subscription (name, round) VALUES ($1, $2)
+			ON CONFLICT (name) DO UPDATE SET round = GREATEST(webhook_subscription.round, EXCLUDED.round)`,
+			subscription, round)
+		if err != nil {
+			return fmt.Errorf("update cursor, %w", err)
+		}
+		return tx.Commit()
+	}
+	if err := db.txWithRetry(context.Background(), serializable, f); err != nil {
+		return fmt.Errorf("EnqueueWebhooks() err: %w", err)
+	}
+	return nil
+}
+
+// DueWebhooks is part of idb.WebhookQueue.
+func (db *IndexerDb) DueWebhooks(subscriptions []string, now time.Time, limit uint64) ([]idb.WebhookDelivery, error) {
+	// held is the first delivery of each subscription which waits for a retry.
+	rows, err := db.db.Query(
+		`WITH held AS (
+			SELECT subscription, min(id) AS id FROM webhook_delivery
+			WHERE subscription = ANY($1) AND next_attempt > $2 GROUP BY subscription)
+		SELECT d.id, d.subscription, d.round, d.intra, d.payload, d.attempts
+		FROM webhook_delivery d LEFT JOIN held h ON h.subscription = d.subscription
+		WHERE d.subscription = ANY($1) AND d.next_attempt <= $2 AND (h.id IS NULL OR d.id < h.id)
+		ORDER BY d.id LIMIT $3`,
+		pq.Array(subscriptions), now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("DueWebhooks() err: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []idb.WebhookDelivery
+	for rows.Next() {
+		var delivery idb.WebhookDelivery
+		err := rows.Scan(&delivery.ID, &delivery.Subscription, &delivery.Round, &delivery.Intra,
+			&delivery.Payload, &delivery.Attempts)
+		if err != nil {
+			return nil, fmt.Errorf("DueWebhooks() scan err: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("DueWebhooks() err: %w", err)
+	}
+	return deliveries, nil
+}
+
+// WebhookDelivered is part of idb.WebhookQueue.
+func (db *IndexerDb) WebhookDelivered(id uint64) error {
+	if _, err := db.db.Exec("DELETE FROM webhook_delivery WHERE id = $1", id); err != nil {
+		return fmt.Errorf("WebhookDelivered() err: %w", err)
+	}
+	return nil
+}
+
+// WebhookFailed is part of idb.WebhookQueue.
+func (db *IndexerDb) WebhookFailed(id uint64, next time.Time, message string) error {
+	_, err := db.db.Exec(
+		"UPDATE webhook_delivery SET attempts = attempts + 1, next_attempt = $2, last_error = $3 WHERE id = $1",
+		id, next, message)
+	if err != nil {
+		return fmt.Errorf("WebhookFailed() err: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// +build !nopostgres
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// writerLockKey identifies the advisory lock held by the writer, it is shared
+// by every indexer using the database.
+const writerLockKey int64 = 0x696e646578657231
+
+// writerCheckInterval is how often candidates try to take the writer lock,
+// and how often the writer checks that it still holds the lock.
+const writerCheckInterval = 5 * time.Second
+
+// writerCheckRetries is how many checks of the writer lock in a row may time
+// out, for example while the database is busy, before the lock is given up.
+const writerCheckRetries = 3
+
+// ElectWriter is part of idb.WriterElector. The writer holds a session level
+// advisory lock on a dedicated connection, which postgres releases when the
+// writer's process or connection dies so that another candidate takes over.
+func (db *IndexerDb) ElectWriter(ctx context.Context) (<-chan struct{}, error) {
+	if !db.opts.ElectWriter {
+		return nil, fmt.Errorf("ElectWriter() the database was not opened as a writer candidate")
+	}
+
+	for {
+		conn, err := db.tryWriterLock(ctx)
+		if err != nil {
+			db.log.WithError(err).Warn("unable to check the writer lock")
+		}
+		if conn != nil {
+			if err := db.becomeWriter(); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			lost := make(chan struct{})
+			go db.watchWriterLock(conn, lost)
+			return lost, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(writerCheckInterval):
+		}
+	}
+}
+
+// tryWriterLock returns the connection holding the writer lock if it was
+// acquired, or nil if another process holds it.
+func (db *IndexerDb) tryWriterLock(ctx context.Context) (*sql.Conn, error) {
+	conn, err := db.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked bool
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, writerLockKey).Scan(&locked)
+	if err != nil || !locked {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// becomeWriter initializes the database, which runs any migrations, and
+// allows writes.
+func (db *IndexerDb) becomeWriter() error {
+	db.writerMu.Lock()
+	defer db.writerMu.Unlock()
+
+	if err := db.init(db.opts); err != nil {
+		return fmt.Errorf("initializing postgres: %v", err)
+	}
+	db.readonly = false
+	return nil
+}
+
+// watchWriterLock closes lost once the connection holding the writer lock
+// stops responding, at which point another candidate may take the lock. A
+// check which times out is retried since the session, and so the lock, may
+// still be alive. Any other error means the connection is gone.
+func (db *IndexerDb) watchWriterLock(conn *sql.Conn, lost chan<- struct{}) {
+	defer close(lost)
+	defer conn.Close()
+
+	timeouts := 0
+	for {
+		time.Sleep(writerCheckInterval)
+
+		ctx, cancel := context.WithTimeout(context.Background(), writerCheckInterval)
+		_, err := conn.ExecContext(ctx, `SELECT 1`)
+		timedOut := ctx.Err() != nil
+		cancel()
+		switch {
+		case err == nil:
+			timeouts = 0
+		case timedOut && timeouts+1 < writerCheckRetries:
+			timeouts++
+			db.log.WithError(err).Warnf("checking the writer lock timed out, retrying (%d/%d)", timeouts, writerCheckRetries)
+		default:
+			db.log.WithError(err).Error("lost the connection holding the writer lock")
+			return
+		}
+	}
+}
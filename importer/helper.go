@@ -16,9 +16,11 @@ import (
 
 	"github.com/algorand/go-algorand-sdk/client/v2/algod"
 	"github.com/algorand/go-algorand-sdk/encoding/json"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/algorand/indexer/accounting"
+	"github.com/algorand/indexer/exporters"
 	"github.com/algorand/indexer/idb"
 	"github.com/algorand/indexer/types"
 )
@@ -48,6 +50,9 @@ type ImportHelper struct {
 	// DefaultFrozenCache is a persistent cache of default frozen values.
 	DefaultFrozenCache map[uint64]bool
 
+	// Exporter receives each round once its accounting is committed, if set.
+	Exporter exporters.Exporter
+
 	Log *log.Logger
 }
 
@@ -99,7 +104,7 @@ func (h *ImportHelper) Import(db idb.IndexerDb, args []string) {
 	if h.NumRoundsLimit != 0 {
 		filter.RoundLimit = &h.NumRoundsLimit
 	}
-	accountingRounds, txnCount := updateAccounting(db, h.DefaultFrozenCache, filter, h.Log)
+	accountingRounds, txnCount := updateAccounting(db, h.DefaultFrozenCache, filter, h.Log, h.Exporter)
 
 	accountingdone := time.Now()
 	if accountingRounds > 0 {
@@ -252,12 +257,13 @@ func InitialImport(db idb.IndexerDb, genesisJSONPath string, client *algod.Clien
 	return true
 }
 
-// UpdateAccounting triggers an accounting update.
-func UpdateAccounting(db idb.IndexerDb, frozenCache map[uint64]bool, filter idb.UpdateFilter, l *log.Logger) (rounds, txnCount int) {
-	return updateAccounting(db, frozenCache, filter, l)
+// UpdateAccounting triggers an accounting update. Each committed round is
+// exported to exporter when it is not nil.
+func UpdateAccounting(db idb.IndexerDb, frozenCache map[uint64]bool, filter idb.UpdateFilter, l *log.Logger, exporter exporters.Exporter) (rounds, txnCount int) {
+	return updateAccounting(db, frozenCache, filter, l, exporter)
 }
 
-func updateAccounting(db idb.IndexerDb, frozenCache map[uint64]bool, filter idb.UpdateFilter, l *log.Logger) (rounds, txnCount int) {
+func updateAccounting(db idb.IndexerDb, frozenCache map[uint64]bool, filter idb.UpdateFilter, l *log.Logger, exporter exporters.Exporter) (rounds, txnCount int) {
 	rounds = 0
 	txnCount = 0
 	lastlog := time.Now()
@@ -268,16 +274,34 @@ func updateAccounting(db idb.IndexerDb, frozenCache map[uint64]bool, filter idb.
 	lastRoundsSeen := roundsSeen
 	txnForRound := 0
 	var blockHeaderPtr *types.BlockHeader = nil
+	var roundTxns []exporters.Txn
+	commitRound := func() {
+		err := db.CommitRoundAccounting(act.RoundUpdates, currentRound, blockHeaderPtr)
+		maybeFail(err, l, "failed to commit round accounting")
+		if exporter == nil {
+			return
+		}
+		// The round is already committed, so a failed export can't be retried.
+		err = exporter.Export(&exporters.Round{
+			Round:       currentRound,
+			BlockHeader: blockHeaderPtr,
+			Txns:        roundTxns,
+			Updates:     &act.RoundUpdates,
+		})
+		if err != nil {
+			l.WithError(err).Errorf("failed to export round %d", currentRound)
+		}
+	}
 	for txn := range txns {
 		maybeFail(txn.Error, l, "updateAccounting txn fetch, %v", txn.Error)
 		if txn.Round != currentRound {
 			if blockHeaderPtr != nil && txnForRound > 0 {
-				err := db.CommitRoundAccounting(act.RoundUpdates, currentRound, blockHeaderPtr)
-				maybeFail(err, l, "failed to commit round accounting")
+				commitRound()
 			}
 
 			// initialize accounting for next round
 			txnForRound = 0
+			roundTxns = roundTxns[:0]
 			prevRound := currentRound
 			roundsSeen++
 			currentRound = txn.Round
@@ -308,14 +332,19 @@ func updateAccounting(db idb.IndexerDb, frozenCache map[uint64]bool, filter idb.
 		}
 		err := act.AddTransaction(&txn)
 		maybeFail(err, l, "txn accounting r=%d i=%d, %v", txn.Round, txn.Intra, err)
+		if exporter != nil {
+			var stxn types.SignedTxnWithAD
+			err = msgpack.Decode(txn.TxnBytes, &stxn)
+			maybeFail(err, l, "txn decode r=%d i=%d, %v", txn.Round, txn.Intra, err)
+			roundTxns = append(roundTxns, exporters.Txn{Intra: txn.Intra, Txn: stxn})
+		}
 		txnCount++
 		txnForRound++
 	}
 
 	// Commit the final round
 	if blockHeaderPtr != nil && txnForRound > 0 {
-		err := db.CommitRoundAccounting(act.RoundUpdates, currentRound, blockHeaderPtr)
-		maybeFail(err, l, "failed to commit round accounting")
+		commitRound()
 	}
 
 	rounds += roundsSeen
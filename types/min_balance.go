@@ -0,0 +1,46 @@
+package types
+
+import "math"
+
+// MinBalanceTotals are what an account's minimum balance depends on, the
+// assets it holds and the applications it created or opted in to.
+type MinBalanceTotals struct {
+	Assets           uint64
+	AppParams        uint64
+	AppLocalStates   uint64
+	ExtraAppPages    uint64
+	SchemaUints      uint64
+	SchemaByteSlices uint64
+}
+
+func mulSaturate(a, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint64/a {
+		return math.MaxUint64
+	}
+	return a * b
+}
+
+func addSaturate(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}
+
+// MinBalance computes the minimum balance of an account like go-algorand
+// data/basics/userBalance.go MinBalance.
+func MinBalance(proto ConsensusParams, totals MinBalanceTotals) uint64 {
+	min := proto.MinBalance
+	min = addSaturate(min, mulSaturate(proto.MinBalance, totals.Assets))
+	min = addSaturate(min, mulSaturate(proto.AppFlatParamsMinBalance, totals.AppParams))
+	min = addSaturate(min, mulSaturate(proto.AppFlatOptInMinBalance, totals.AppLocalStates))
+
+	// The schemas of the created applications and of the opted in local states.
+	entries := addSaturate(totals.SchemaUints, totals.SchemaByteSlices)
+	min = addSaturate(min, mulSaturate(proto.SchemaMinBalancePerEntry, entries))
+	min = addSaturate(min, mulSaturate(proto.SchemaUintMinBalance, totals.SchemaUints))
+	min = addSaturate(min, mulSaturate(proto.SchemaBytesMinBalance, totals.SchemaByteSlices))
+
+	min = addSaturate(min, mulSaturate(proto.AppFlatParamsMinBalance, totals.ExtraAppPages))
+	return min
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinBalance(t *testing.T) {
+	proto := ConsensusParams{
+		MinBalance:               100000,
+		AppFlatParamsMinBalance:  100000,
+		AppFlatOptInMinBalance:   100000,
+		SchemaMinBalancePerEntry: 25000,
+		SchemaUintMinBalance:     3500,
+		SchemaBytesMinBalance:    25000,
+	}
+
+	assert.Equal(t, uint64(100000), MinBalance(proto, MinBalanceTotals{}))
+	assert.Equal(t, uint64(300000), MinBalance(proto, MinBalanceTotals{Assets: 2}))
+
+	// One created app with 1 uint and 1 byte slice of global state and an
+	// extra page, opted in to another with 2 uints of local state.
+	totals := MinBalanceTotals{
+		AppParams:        1,
+		ExtraAppPages:    1,
+		AppLocalStates:   1,
+		SchemaUints:      3,
+		SchemaByteSlices: 1,
+	}
+	expected := uint64(100000 + 100000 + 100000 + 100000 + 4*25000 + 3*3500 + 25000)
+	assert.Equal(t, expected, MinBalance(proto, totals))
+
+	assert.Equal(t, uint64(math.MaxUint64), MinBalance(proto, MinBalanceTotals{Assets: math.MaxUint64}))
+}
@@ -0,0 +1,80 @@
+package util
+
+import (
+	"context"
+	"sync"
+)
+
+// RoundBroadcaster tracks the latest committed round and fans it out to
+// subscribers. It is safe for concurrent use.
+type RoundBroadcaster struct {
+	mu          sync.Mutex
+	round       uint64
+	known       bool
+	subscribers map[chan uint64]struct{}
+}
+
+// MakeRoundBroadcaster constructs a RoundBroadcaster.
+func MakeRoundBroadcaster() *RoundBroadcaster {
+	return &RoundBroadcaster{
+		subscribers: make(map[chan uint64]struct{}),
+	}
+}
+
+// Publish records a committed round. Rounds older than the latest are ignored,
+// since rounds may be reported by several sources and arrive out of order.
+func (b *RoundBroadcaster) Publish(round uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.known && round <= b.round {
+		return
+	}
+	b.round = round
+	b.known = true
+
+	for ch := range b.subscribers {
+		// Replace the pending round of a slow subscriber with the latest one.
+		select {
+		case ch <- round:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- round
+		}
+	}
+}
+
+// Latest returns the latest published round, and false if none was published.
+func (b *RoundBroadcaster) Latest() (uint64, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.round, b.known
+}
+
+// SubscribeRounds returns a channel receiving the rounds published until ctx is
+// done. A subscriber which falls behind only receives the latest round.
+func (b *RoundBroadcaster) SubscribeRounds(ctx context.Context) <-chan uint64 {
+	ch := make(chan uint64, 1)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+	}()
+	return ch
+}
+
+// numSubscribers returns the number of active subscribers.
+func (b *RoundBroadcaster) numSubscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers)
+}
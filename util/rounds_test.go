@@ -0,0 +1,41 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundBroadcaster(t *testing.T) {
+	b := MakeRoundBroadcaster()
+
+	_, ok := b.Latest()
+	assert.False(t, ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rounds := b.SubscribeRounds(ctx)
+
+	// A slow subscriber only receives the latest round.
+	b.Publish(5)
+	b.Publish(6)
+	assert.Equal(t, uint64(6), <-rounds)
+
+	// Old rounds are ignored.
+	b.Publish(4)
+	round, ok := b.Latest()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(6), round)
+	select {
+	case r := <-rounds:
+		t.Fatalf("unexpected round %d", r)
+	default:
+	}
+
+	// Cancelled subscribers are removed.
+	cancel()
+	assert.Eventually(t, func() bool {
+		return b.numSubscribers() == 0
+	}, time.Second, 10*time.Millisecond)
+}